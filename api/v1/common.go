@@ -1,6 +1,7 @@
 package v1
 
 import (
+	autoscaling "k8s.io/api/autoscaling/v2"
 	core "k8s.io/api/core/v1"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,6 +46,12 @@ type MonitoringConfig struct {
 	// Requires metrics to be enabled.
 	// +optional
 	ServiceMonitor ServiceMonitorConfig `json:"serviceMonitor,omitempty"`
+
+	// Prometheus alerting rules configuration.
+	// Controls whether the operator creates a PrometheusRule resource
+	// with curated alerts for the component.
+	// +optional
+	PrometheusRule PrometheusRuleConfig `json:"prometheusRule,omitempty"`
 }
 
 // MetricsConfig configures the metrics endpoint exposed by component
@@ -74,6 +81,46 @@ type MonitoringWithTLogConfig struct {
 	Tuf ServiceReference `json:"tuf,omitempty"`
 }
 
+// PrometheusRuleConfig configures the creation of a Prometheus
+// PrometheusRule resource with curated alerts for the component.
+type PrometheusRuleConfig struct {
+	// Enable creation of the PrometheusRule resource.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Overrides of the curated alerts, matched by alert name.
+	// +listType=map
+	// +listMapKey=alert
+	// +optional
+	Alerts []AlertOverride `json:"alerts,omitempty"`
+}
+
+// AlertOverride overrides the defaults of a curated alert.
+type AlertOverride struct {
+	// Name of the curated alert, e.g. RekorHigh5xxRate.
+	// +required
+	Alert string `json:"alert"`
+
+	// Threshold compared by the alert expression. The unit depends on the alert
+	// (a ratio, seconds, days or a count).
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+
+	// How long the alert condition must hold before the alert fires.
+	// +optional
+	For *metav1.Duration `json:"for,omitempty"`
+}
+
+// NetworkPolicyConfig configures generation of Kubernetes NetworkPolicies for the component.
+type NetworkPolicyConfig struct {
+	// If set to true, the Operator creates NetworkPolicies that only admit traffic
+	// from the components, ingress controllers and monitoring stack that need to reach
+	// the component's pods.
+	//+optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
 // ServiceReference identifies a component service either by in-cluster CR
 // reference or by an external URL.
 // +kubebuilder:validation:XValidation:rule="!(has(self.ref) && has(self.url) && size(self.url) > 0)",message="ref and url are mutually exclusive"
@@ -327,4 +374,115 @@ type PodRequirements struct {
 	Affinity    *core.Affinity             `json:"affinity,omitempty"`
 	Resources   *core.ResourceRequirements `json:"resources,omitempty"`
 	Tolerations []core.Toleration          `json:"tolerations,omitempty"`
+	// Describes how pods are spread across topology domains. When empty and more than one
+	// replica is requested, Deployment pods are spread across zones on a best-effort basis.
+	//+optional
+	//+listType=atomic
+	TopologySpreadConstraints []core.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Node labels the pods must match to be scheduled.
+	//+optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Name of the PriorityClass assigned to the pods.
+	//+optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Name of the RuntimeClass used to run the pods.
+	//+optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+}
+
+// CertificateStatus describes the validity period of a certificate held by a component.
+type CertificateStatus struct {
+	// Name of the certificate within the component. Certificates of a chain are suffixed with their index.
+	Name string `json:"name"`
+	// Subject of the certificate.
+	//+optional
+	Subject string `json:"subject,omitempty"`
+	// Hex encoded serial number of the certificate.
+	//+optional
+	SerialNumber string      `json:"serialNumber,omitempty"`
+	NotBefore    metav1.Time `json:"notBefore"`
+	NotAfter     metav1.Time `json:"notAfter"`
+}
+
+// CertificateRenewal configures the renewal of a certificate generated by the operator.
+type CertificateRenewal struct {
+	// If set to true, the operator issues a new certificate before the current one expires.
+	//+optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// How long before the expiry the certificate is renewed. The previous certificate stays valid,
+	// and therefore trusted, during this overlap window.
+	//+optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+	// How long the previous certificate is published next to the renewed one, so relying parties pick up
+	// the renewed trust material before the previous one is withdrawn. Defaults to renewBefore, the previous
+	// certificate is published until it expires.
+	//+optional
+	Overlap *metav1.Duration `json:"overlap,omitempty"`
+}
+
+// KeyAlgorithm identifies the algorithm and size of a private key generated by the operator.
+type KeyAlgorithm string
+
+const (
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ecdsa-p256"
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ecdsa-p384"
+	KeyAlgorithmECDSAP521 KeyAlgorithm = "ecdsa-p521"
+	KeyAlgorithmRSA2048   KeyAlgorithm = "rsa-2048"
+	KeyAlgorithmRSA3072   KeyAlgorithm = "rsa-3072"
+	KeyAlgorithmRSA4096   KeyAlgorithm = "rsa-4096"
+	KeyAlgorithmEd25519   KeyAlgorithm = "ed25519"
+)
+
+// NameConstraints defines the X.509 name constraints extension of a CA certificate.
+type NameConstraints struct {
+	//+optional
+	// +listType=atomic
+	PermittedDNSDomains []string `json:"permittedDNSDomains,omitempty"`
+	//+optional
+	// +listType=atomic
+	ExcludedDNSDomains []string `json:"excludedDNSDomains,omitempty"`
+	//+optional
+	// +listType=atomic
+	PermittedEmailAddresses []string `json:"permittedEmailAddresses,omitempty"`
+	//+optional
+	// +listType=atomic
+	ExcludedEmailAddresses []string `json:"excludedEmailAddresses,omitempty"`
+	//+optional
+	// +listType=atomic
+	PermittedURIDomains []string `json:"permittedURIDomains,omitempty"`
+	//+optional
+	// +listType=atomic
+	ExcludedURIDomains []string `json:"excludedURIDomains,omitempty"`
+}
+
+// Autoscaling configures a HorizontalPodAutoscaler for the component deployment.
+// +kubebuilder:validation:XValidation:rule="!has(self.enabled) || !self.enabled || has(self.maxReplicas)",message="maxReplicas is required when autoscaling is enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || !has(self.maxReplicas) || self.minReplicas <= self.maxReplicas",message="minReplicas must not exceed maxReplicas"
+type Autoscaling struct {
+	// If set to true, the Operator creates a HorizontalPodAutoscaler for the deployment
+	// and stops managing its replicas. The replicas field is ignored.
+	//+optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Lower limit for the number of replicas.
+	//+optional
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum:=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Upper limit for the number of replicas.
+	//+optional
+	//+kubebuilder:validation:Minimum:=1
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+	// Target average CPU utilization, in percent of the requested CPU.
+	//+optional
+	//+kubebuilder:validation:Minimum:=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// Target average memory utilization, in percent of the requested memory.
+	//+optional
+	//+kubebuilder:validation:Minimum:=1
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+	// Additional metrics used to calculate the desired replica count, e.g. pod metrics
+	// scraped from the component's Prometheus endpoint and served by a custom metrics adapter.
+	//+optional
+	// +listType=atomic
+	Metrics []autoscaling.MetricSpec `json:"metrics,omitempty"`
 }
@@ -35,6 +35,12 @@ func (s *Pvc) SetDefaults() {
 	setDefaultSlice(&s.AccessModes, []PersistentVolumeAccessMode{"ReadWriteOnce"})
 }
 
+func (s *CertificateRenewal) SetDefaults() {
+	setDefault(&s.Enabled, ptr.To(false))
+	setDefault(&s.RenewBefore, &metav1.Duration{Duration: 30 * 24 * time.Hour})
+	setDefault(&s.Overlap, &metav1.Duration{Duration: s.RenewBefore.Duration})
+}
+
 func (s *PodRequirements) SetDefaults() {
 	setDefault(&s.Replicas, ptr.To(int32(1)))
 }
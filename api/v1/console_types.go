@@ -28,14 +28,98 @@ type ConsoleSpec struct {
 	UI ConsoleUI `json:"ui,omitempty"`
 	// Configuration for Console Api service
 	Api ConsoleAPI `json:"api,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
 
 	// ConfigMap with additional bundle of trusted CA
 	//+optional
 	TrustedCA *LocalObjectReference `json:"trustedCA,omitempty"`
+
+	// Authentication of Console users. When set, the UI is served through an
+	// operator-managed auth proxy and the API is reachable from the UI only.
+	//+optional
+	Auth *ConsoleAuth `json:"auth,omitempty"`
+}
+
+// ConsoleAuthType is the provider of the Console auth proxy.
+// +kubebuilder:validation:Enum:=OIDC;OpenShift
+type ConsoleAuthType string
+
+const (
+	// ConsoleAuthOIDC authenticates users against an OIDC issuer.
+	ConsoleAuthOIDC ConsoleAuthType = "OIDC"
+	// ConsoleAuthOpenShift authenticates users with the OpenShift OAuth server.
+	ConsoleAuthOpenShift ConsoleAuthType = "OpenShift"
+)
+
+// ConsoleAuth configures the auth proxy in front of the Console UI.
+// +kubebuilder:validation:XValidation:rule="self.type != 'OIDC' || has(self.oidc)",message="oidc must be set for the OIDC auth type"
+type ConsoleAuth struct {
+	// Authentication provider.
+	//+required
+	Type ConsoleAuthType `json:"type"`
+	// OIDC provider configuration.
+	//+optional
+	OIDC *ConsoleOIDCAuth `json:"oidc,omitempty"`
+	// OpenShift OAuth configuration. Only supported on OpenShift.
+	//+optional
+	OpenShift *ConsoleOpenShiftAuth `json:"openshift,omitempty"`
+}
+
+type ConsoleOIDCAuth struct {
+	// Issuer URL of the OIDC provider.
+	//+required
+	//+kubebuilder:validation:Pattern:="^https?://.+"
+	IssuerURL string `json:"issuerURL"`
+	// Client ID registered with the OIDC provider.
+	//+required
+	//+kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientID"`
+	// Reference to the secret key holding the client secret.
+	//+required
+	ClientSecretRef SecretKeySelector `json:"clientSecretRef"`
+	// Groups allowed to access the Console. Empty allows every authenticated user.
+	//+optional
+	//+listType=set
+	AllowedGroups []string `json:"allowedGroups,omitempty"`
+	// Claim holding the groups of the user.
+	//+optional
+	//+kubebuilder:default:=groups
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+}
+
+type ConsoleOpenShiftAuth struct {
+	// SubjectAccessReview the user has to pass to access the Console.
+	// Defaults to the "get" verb on this Console resource.
+	//+optional
+	SubjectAccessReview *ConsoleSubjectAccessReview `json:"subjectAccessReview,omitempty"`
+}
+
+// ConsoleSubjectAccessReview describes a resource access checked for the logged-in user
+// in the namespace of the Console.
+type ConsoleSubjectAccessReview struct {
+	// API group of the resource.
+	//+optional
+	Group string `json:"group,omitempty"`
+	// Resource type, e.g. "consoles".
+	//+required
+	//+kubebuilder:validation:MinLength=1
+	Resource string `json:"resource"`
+	// Name of the resource. Empty checks all resources of the type.
+	//+optional
+	Name string `json:"name,omitempty"`
+	// Verb, e.g. "get".
+	//+required
+	//+kubebuilder:validation:MinLength=1
+	Verb string `json:"verb"`
 }
 
 type ConsoleUI struct {
 	PodRequirements `json:",inline"`
+	// Autoscaling configuration
+	//+optional
+	Autoscaling Autoscaling `json:"autoscaling,omitempty"`
 	// Define whether you want to export service or not
 	Ingress Ingress `json:"ingress,omitempty"`
 	// Rekor service configuration
@@ -46,6 +130,9 @@ type ConsoleUI struct {
 
 type ConsoleAPI struct {
 	PodRequirements `json:",inline"`
+	// Autoscaling configuration
+	//+optional
+	Autoscaling Autoscaling `json:"autoscaling,omitempty"`
 	// TUF service configuration
 	//+optional
 	//+kubebuilder:validation:XValidation:rule="!has(self.url) || size(self.url) == 0 || self.url.matches('^[a-zA-Z][a-zA-Z0-9+.-]*://[^/].*$')",message="url must follow the pattern scheme://host[:port][/path]"
@@ -67,6 +154,11 @@ type ConsoleUIStatus struct {
 type ConsoleStatus struct {
 	Api ConsoleAPIStatus `json:"api,omitempty"`
 	UI  ConsoleUIStatus  `json:"ui,omitempty"`
+	// Validity of the certificates held by the component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
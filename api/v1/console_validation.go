@@ -0,0 +1,12 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *ConsoleSpec) validate(v *specValidator, path *field.Path) {
+	if s.Auth != nil && s.Auth.OIDC != nil {
+		v.secretKey(path.Child("auth", "oidc", "clientSecretRef"), &s.Auth.OIDC.ClientSecretRef)
+	}
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+}
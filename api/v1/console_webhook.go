@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type ConsoleDefaulter struct{}
 
+// ConsoleValidator performs the semantic checks of the Console spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type ConsoleValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-console,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=consoles,verbs=create;update,versions=v1,name=mconsole.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-console,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=consoles,verbs=create;update,versions=v1,name=vconsole.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupConsoleWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &Console{}).
 		WithDefaulter(&ConsoleDefaulter{}).
+		WithValidator(&ConsoleValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,23 @@ func (d *ConsoleDefaulter) Default(ctx context.Context, obj *Console) error {
 	obj.Spec.SetDefaults()
 	return nil
 }
+
+func (v *ConsoleValidator) ValidateCreate(ctx context.Context, obj *Console) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Console", obj.Name)
+}
+
+func (v *ConsoleValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *Console) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Console", newObj.Name)
+}
+
+func (v *ConsoleValidator) ValidateDelete(context.Context, *Console) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -14,4 +14,5 @@ func (s *CTlogSpec) SetDefaults() {
 
 func (s *CTlogSigner) SetDefaults() {
 	setDefault(&s.Type, CTlogSignerTypeFile)
+	setDefault(&s.KeyAlgorithm, KeyAlgorithmECDSAP256)
 }
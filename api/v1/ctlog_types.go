@@ -22,6 +22,7 @@ import (
 )
 
 // CTlogSpec defines the desired state of CTlog component
+// +kubebuilder:validation:XValidation:rule=(!has(self.treeID) || !has(self.treeRef)),message=treeID and treeRef are mutually exclusive
 type CTlogSpec struct {
 	PodRequirements      `json:",inline"`
 	ServiceAccountConfig `json:",inline"`
@@ -30,6 +31,10 @@ type CTlogSpec struct {
 	//+optional
 	//+kubebuilder:validation:Minimum=1
 	TreeID *int64 `json:"treeID,omitempty"`
+	// Reference to a TrillianTree in the same namespace providing the ID of Merkle tree.
+	// Mutually exclusive with treeID.
+	//+optional
+	TreeRef *LocalObjectReference `json:"treeRef,omitempty"`
 
 	// Signer configuration
 	//+required
@@ -46,6 +51,12 @@ type CTlogSpec struct {
 
 	//Enable Service monitors for ctlog
 	Monitoring MonitoringWithTLogConfig `json:"monitoring,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// Autoscaling configuration
+	//+optional
+	Autoscaling Autoscaling `json:"autoscaling,omitempty"`
 
 	// Trillian service configuration
 	Trillian ServiceReference `json:"trillian,omitempty"`
@@ -91,6 +102,11 @@ type CTlogSigner struct {
 	// Configuration for file-based signer
 	//+optional
 	File *CTlogFile `json:"file,omitempty"`
+	// Algorithm of the signer private key generated by the operator.
+	// Changing it does not replace an existing key.
+	//+optional
+	//+kubebuilder:validation:Enum=ecdsa-p256;ecdsa-p384;ecdsa-p521;rsa-3072;rsa-4096
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
 }
 
 // CTlogFile defines the desired state of the CTlog file-based signer
@@ -134,6 +150,11 @@ type CTlogStatus struct {
 	// Url is the CTlog endpoint URL including the log prefix path,
 	// e.g. http://ctlog.namespace.svc/trusted-artifact-signer.
 	Url string `json:"url,omitempty"`
+	// Validity of the certificates held by the component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
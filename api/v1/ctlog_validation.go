@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *CTlogSpec) validate(v *specValidator, path *field.Path) {
+	s.Signer.validate(v, path.Child("signer"))
+	for i := range s.RootCertificates {
+		v.certificateChain(path.Child("rootCertificates").Index(i), &s.RootCertificates[i])
+	}
+	v.secret(path.Child("serverConfigRef"), s.ServerConfigRef)
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+	v.auth(path.Child("auth"), s.Auth)
+}
+
+func (s *CTlogSpec) validateUpdate(v *specValidator, path *field.Path, old *CTlogSpec) {
+	if old.TreeID != nil && (s.TreeID == nil || *s.TreeID != *old.TreeID) {
+		v.warn(path.Child("treeID"), "the log is served from a different tree, the entries of the tree %d are no longer served", *old.TreeID)
+	}
+	if old.Signer.File != nil && s.Signer.File != nil && secretKeySelectorChanged(old.Signer.File.PrivateKeyRef, s.Signer.File.PrivateKeyRef) {
+		v.warn(path.Child("signer", "file", "privateKeyRef"), "the signer key is replaced, clients must trust the new public key published by TUF")
+	}
+}
+
+func (s *CTlogSigner) validate(v *specValidator, path *field.Path) {
+	if s.File == nil || s.File.PrivateKeyRef == nil {
+		v.keyAlgorithm(path.Child("keyAlgorithm"), s.KeyAlgorithm)
+		return
+	}
+	filePath := path.Child("file")
+	key := v.privateKey(filePath.Child("privateKeyRef"), s.File.PrivateKeyRef,
+		filePath.Child("privateKeyPasswordRef"), s.File.PrivateKeyPasswordRef) //nolint:staticcheck
+	if s.File.PublicKeyRef == nil {
+		return
+	}
+	p := filePath.Child("publicKeyRef")
+	public := v.publicKey(p, s.File.PublicKeyRef.Name, v.secretKey(p, s.File.PublicKeyRef))
+	v.publicKeyPair(p, s.File.PublicKeyRef, key, public)
+}
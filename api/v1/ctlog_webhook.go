@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type CTlogDefaulter struct{}
 
+// CTlogValidator performs the semantic checks of the CTlog spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type CTlogValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-ctlog,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=ctlogs,verbs=create;update,versions=v1,name=mctlog.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-ctlog,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=ctlogs,verbs=create;update,versions=v1,name=vctlog.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupCTlogWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &CTlog{}).
 		WithDefaulter(&CTlogDefaulter{}).
+		WithValidator(&CTlogValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,24 @@ func (d *CTlogDefaulter) Default(ctx context.Context, obj *CTlog) error {
 	obj.Spec.SetDefaults()
 	return nil
 }
+
+func (v *CTlogValidator) ValidateCreate(ctx context.Context, obj *CTlog) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("CTlog", obj.Name)
+}
+
+func (v *CTlogValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *CTlog) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	newObj.Spec.validateUpdate(sv, field.NewPath("spec"), &oldObj.Spec)
+	return sv.result("CTlog", newObj.Name)
+}
+
+func (v *CTlogValidator) ValidateDelete(context.Context, *CTlog) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -1,5 +1,12 @@
 package v1
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
 func (s *FulcioSpec) SetDefaults() {
 	s.PodRequirements.SetDefaults()
 	s.PodExtensions.SetDefaults()
@@ -10,4 +17,15 @@ func (s *FulcioSpec) SetDefaults() {
 
 func (s *FulcioSigner) SetDefaults() {
 	setDefault(&s.Type, FulcioSignerTypeFile)
+	setDefault(&s.KeyAlgorithm, KeyAlgorithmECDSAP384)
+	if s.CertificateChain.IntermediateCA != nil {
+		s.CertificateChain.IntermediateCA.SetDefaults()
+	}
+}
+
+func (s *FulcioIntermediateCA) SetDefaults() {
+	setDefault(&s.Validity, &metav1.Duration{Duration: 3 * 365 * 24 * time.Hour})
+	setDefault(&s.MaxPathLen, ptr.To(int32(0)))
+	setDefault(&s.KeyAlgorithm, KeyAlgorithmECDSAP384)
+	s.Renewal.SetDefaults()
 }
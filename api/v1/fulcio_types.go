@@ -22,6 +22,11 @@ type FulcioSpec struct {
 	// Define whether you want to export service or not
 	//+optional
 	Ingress Ingress `json:"ingress,omitempty"`
+	// Define whether you want to export the Fulcio gRPC API or not.
+	// The gRPC API is published on its own host. On OpenShift, the Route uses "reencrypt"
+	// termination and Fulcio serves its gRPC port with a service serving certificate.
+	//+optional
+	GrpcIngress Ingress `json:"grpcIngress,omitempty"`
 	// Ctlog service configuration
 	//+optional
 	Ctlog ServiceReference `json:"ctlog,omitempty"`
@@ -34,6 +39,12 @@ type FulcioSpec struct {
 	//Enable Service monitors for fulcio
 	//+optional
 	Monitoring MonitoringConfig `json:"monitoring,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// Autoscaling configuration
+	//+optional
+	Autoscaling Autoscaling `json:"autoscaling,omitempty"`
 	// ConfigMap with additional bundle of trusted CA
 	//+optional
 	TrustedCA     *LocalObjectReference `json:"trustedCA,omitempty"`
@@ -41,10 +52,38 @@ type FulcioSpec struct {
 	// Authentication configuration for the signer backend.
 	//+optional
 	Auth *Auth `json:"auth,omitempty"`
+	// Preview of the certificate extensions rendered from ciIssuerMetadata templates and sample claims.
+	// The result is published in status.templatePreview.
+	//+optional
+	TemplatePreview *FulcioTemplatePreview `json:"templatePreview,omitempty"`
+	// Periodic discovery check of the OIDC issuers
+	//+optional
+	IssuerHealthCheck FulcioIssuerHealthCheck `json:"issuerHealthCheck,omitempty"`
+}
+
+// FulcioIssuerHealthCheck configures the periodic fetch of the OpenID configuration and JWKS of the OIDC issuers.
+type FulcioIssuerHealthCheck struct {
+	// If false, the OIDC issuers are not checked.
+	//+kubebuilder:default:=true
+	//+optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Time between checks of an OIDC issuer (default 10m).
+	//+optional
+	//+kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m') && duration(self) <= duration('24h')",message=Interval must be between 1m and 24h
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// FulcioTemplatePreview configures the rendering of ciIssuerMetadata templates with sample claims.
+type FulcioTemplatePreview struct {
+	// Reference to the ConfigMap with sample claims. The keys are issuerName values of ciIssuerMetadata
+	// entries, the values are JSON objects with the claims of an OIDC token of the issuer.
+	//+required
+	ClaimsRef LocalObjectReference `json:"claimsRef"`
 }
 
 // FulcioSigner defines the desired state of the Fulcio Signer
 // +kubebuilder:validation:XValidation:rule="self.type != 'file' || !has(self.certificateChain.certificateChainRef) || (has(self.file) && has(self.file.privateKeyRef))",message="file.privateKeyRef cannot be empty when certificateChain.certificateChainRef is provided"
+// +kubebuilder:validation:XValidation:rule=(!has(self.certificateChain.intermediateCA) || !has(self.file) || !has(self.file.privateKeyRef)),message=intermediateCA cannot be used together with file.privateKeyRef
 type FulcioSigner struct {
 	// Type of the signer backend
 	//+kubebuilder:validation:Enum=file
@@ -56,6 +95,11 @@ type FulcioSigner struct {
 	// Configuration for file-based signer
 	//+optional
 	File *FulcioFile `json:"file,omitempty"`
+	// Algorithm of the CA private key generated by the operator. With an intermediate CA,
+	// it is the algorithm of the generated root key. Changing it does not replace an existing key.
+	//+optional
+	//+kubebuilder:validation:Enum=ecdsa-p256;ecdsa-p384;ecdsa-p521;rsa-3072;rsa-4096
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
 }
 
 // FulcioFile defines the desired state of the Fulcio file-based signer
@@ -73,6 +117,7 @@ type FulcioFile struct {
 
 // FulcioCertificateChain defines the certificate chain configuration for Fulcio CA
 // +kubebuilder:validation:XValidation:rule=(has(self.certificateChainRef) || has(self.organizationName) && self.organizationName != ""),message=organizationName cannot be empty
+// +kubebuilder:validation:XValidation:rule=(!has(self.intermediateCA) || !has(self.certificateChainRef)),message=intermediateCA cannot be used together with certificateChainRef
 type FulcioCertificateChain struct {
 	// Reference to CA certificate chain
 	//+optional
@@ -86,6 +131,55 @@ type FulcioCertificateChain struct {
 	OrganizationName string `json:"organizationName,omitempty"`
 	//+optional
 	OrganizationEmail string `json:"organizationEmail,omitempty"`
+	// Configuration of an intermediate CA issued by the root CA. When set, Fulcio signs
+	// certificates with the intermediate key and the root key is only used to issue the intermediate.
+	//+optional
+	IntermediateCA *FulcioIntermediateCA `json:"intermediateCA,omitempty"`
+}
+
+// FulcioIntermediateCA defines the intermediate CA generated by the operator.
+// +kubebuilder:validation:XValidation:rule="has(self.rootCertificateRef) == has(self.rootPrivateKeyRef)",message=rootCertificateRef and rootPrivateKeyRef must be set together
+type FulcioIntermediateCA struct {
+	// Reference to the certificate of an external root CA.
+	// If not provided, the operator generates the root CA from the certificate chain subject.
+	//+optional
+	RootCertificateRef *SecretKeySelector `json:"rootCertificateRef,omitempty"`
+	// Reference to the private key of the external root CA. The key is only read to issue the
+	// intermediate certificate and the secret can be removed afterwards.
+	//+optional
+	RootPrivateKeyRef *SecretKeySelector `json:"rootPrivateKeyRef,omitempty"`
+	// CommonName specifies the common name for the intermediate certificate.
+	// If not provided, the common name of the certificate chain is used.
+	//+optional
+	CommonName string `json:"commonName,omitempty"`
+	// If not provided, the organization name of the certificate chain is used.
+	//+optional
+	OrganizationName string `json:"organizationName,omitempty"`
+	//+optional
+	OrganizationEmail string `json:"organizationEmail,omitempty"`
+	// Validity period of the intermediate certificate. It never exceeds the validity of the root certificate.
+	//+optional
+	Validity *metav1.Duration `json:"validity,omitempty"`
+	// Maximum number of intermediate certificates that may follow this one in a certification path.
+	//+optional
+	//+kubebuilder:validation:Minimum=0
+	MaxPathLen *int32 `json:"maxPathLen,omitempty"`
+	// Algorithm of the generated intermediate private key.
+	//+optional
+	//+kubebuilder:validation:Enum=ecdsa-p256;ecdsa-p384;ecdsa-p521;rsa-2048;rsa-3072;rsa-4096
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+	// Name constraints restricting the identities the intermediate can certify.
+	//+optional
+	NameConstraints *NameConstraints `json:"nameConstraints,omitempty"`
+	// Renewal of the intermediate certificate. Renewal requires the root private key, either referenced by
+	// rootPrivateKeyRef or stored by the operator with storeRootPrivateKey.
+	//+optional
+	Renewal CertificateRenewal `json:"renewal,omitempty"`
+	// If set to true, the private key of the root CA generated by the operator is kept in a separate secret, which
+	// is never mounted into the Fulcio pods, to renew the intermediate from the same root. By default the generated
+	// root private key is discarded once the intermediate is issued.
+	//+optional
+	StoreRootPrivateKey *bool `json:"storeRootPrivateKey,omitempty"`
 }
 
 // FulcioConfig configuration of OIDC issuers
@@ -93,6 +187,7 @@ type FulcioCertificateChain struct {
 // NOTE: the below validation (and a similar one for MetaIssuers) would be great to have, but unfortunately it can't be used because compiling it yields:
 // "Forbidden: estimated rule cost exceeds budget by factor of more than 100x". It is turned off for now, maybe this can be fixed in the future.
 // Note that the error message also suggests to use MaxItems/MaxLength on the involved arrays/strings, but that doesn't seem to work either.
+// The CIProvider references are checked by the validating webhook instead.
 // kubebuilder:validation:XValidation:rule="!has(self.oidcIssuers) || has(self.oidcIssuers) && self.oidcIssuers.all(i, (!has(i.ciProvider) || (has(i.ciProvider) && i.ciProvider in self.ciIssuerMetadata.map(n, n.issuerName))))",message=All CIProvider values of OIDCIssuers must be present in CIIssuerMetadata
 type FulcioConfig struct {
 	// OIDC Configuration
@@ -214,6 +309,40 @@ type Extensions struct {
 	SourceRepositoryVisibilityAtSigning string `json:"sourceRepositoryVisibilityAtSigning,omitempty"` // 1.3.6.1.4.1.57264.1.22
 }
 
+// FulcioTemplatePreviewResult contains the certificate extensions rendered for a ciIssuerMetadata entry.
+type FulcioTemplatePreviewResult struct {
+	// Name of the issuer
+	IssuerName string `json:"issuerName"`
+	// Rendered certificate extensions
+	//+optional
+	Extensions Extensions `json:"extensions,omitempty"`
+	// Rendered Subject Alternative Name
+	//+optional
+	SubjectAlternativeName string `json:"subjectAlternativeName,omitempty"`
+	// Error of the claims parsing or the template execution. Fulcio refuses to issue
+	// a certificate for a token with the same claims.
+	//+optional
+	Error string `json:"error,omitempty"`
+}
+
+// FulcioOIDCIssuerStatus is the result of the last discovery check of an OIDC issuer.
+type FulcioOIDCIssuerStatus struct {
+	// Issuer as configured in spec.config.oidcIssuers
+	Issuer string `json:"issuer"`
+	// URL of the fetched OpenID configuration
+	IssuerURL string `json:"issuerURL"`
+	// True if the OpenID configuration and the JWKS of the issuer were fetched
+	Reachable bool `json:"reachable"`
+	// Number of keys in the JWKS of the issuer
+	//+optional
+	KeyCount int32 `json:"keyCount,omitempty"`
+	// Error of the last check
+	//+optional
+	Error string `json:"error,omitempty"`
+	// Time of the last check
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+}
+
 type FulcioCertStatus struct {
 	PrivateKeyRef         *SecretKeySelector `json:"privateKeyRef,omitempty"`
 	PrivateKeyPasswordRef *SecretKeySelector `json:"privateKeyPasswordRef,omitempty"`
@@ -225,10 +354,28 @@ type FulcioStatus struct {
 	ServerConfigRef *LocalObjectReference `json:"serverConfigRef,omitempty"`
 	Certificate     *FulcioCertStatus     `json:"certificate,omitempty"`
 	Url             string                `json:"url,omitempty"`
+	// Address of the Fulcio gRPC API in the host:port form.
+	// +optional
+	GrpcUrl string `json:"grpcUrl,omitempty"`
 	// PEM-encoded certificate chain (trust bundle) resolved from the running Fulcio service API.
 	// Contains the signing certificate followed by any intermediate and root CA certificates.
 	// +optional
 	CertificateChain string `json:"certificateChain,omitempty"`
+	// Validity of the certificates held by the component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
+	// Certificate extensions rendered from the sample claims of spec.templatePreview.
+	// +listType=map
+	// +listMapKey=issuerName
+	// +optional
+	TemplatePreview []FulcioTemplatePreviewResult `json:"templatePreview,omitempty"`
+	// Result of the discovery checks of the OIDC issuers.
+	// +listType=map
+	// +listMapKey=issuer
+	// +optional
+	OIDCIssuers []FulcioOIDCIssuerStatus `json:"oidcIssuers,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
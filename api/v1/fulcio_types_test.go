@@ -145,6 +145,33 @@ var _ = Describe("Fulcio", func() {
 					To(MatchError(ContainSubstring("privateKeyRef cannot be empty")))
 			})
 
+			It("intermediate CA with certificate chain", func() {
+				invalidObject := generateMinimalFulcio("intermediate-chain-invalid")
+				invalidObject.Spec.Signer.CertificateChain.IntermediateCA = &FulcioIntermediateCA{}
+				invalidObject.Spec.Signer.CertificateChain.CertificateChainRef = &SecretKeySelector{
+					Key:                  "key",
+					LocalObjectReference: LocalObjectReference{Name: "name"},
+				}
+
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("intermediateCA cannot be used together with certificateChainRef")))
+			})
+
+			It("intermediate CA root references", func() {
+				invalidObject := generateMinimalFulcio("intermediate-root-invalid")
+				invalidObject.Spec.Signer.CertificateChain.IntermediateCA = &FulcioIntermediateCA{
+					RootCertificateRef: &SecretKeySelector{
+						Key:                  "cert",
+						LocalObjectReference: LocalObjectReference{Name: "root"},
+					},
+				}
+
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("rootCertificateRef and rootPrivateKeyRef must be set together")))
+			})
+
 			It("config is not empty", func() {
 				invalidObject := generateMinimalFulcio("config-invalid")
 				invalidObject.Spec.Config.OIDCIssuers = []OIDCIssuer{}
@@ -330,6 +357,10 @@ func generateMinimalFulcio(name string) *Fulcio {
 						Issuer:   "https://meta2.example.com",
 					},
 				},
+				// referenced by the CIProvider of the OIDC issuers
+				CIIssuerMetadata: []CIIssuerMetadata{
+					{IssuerName: "foo"},
+				},
 			},
 			Signer: FulcioSigner{
 				Type: "file",
@@ -343,28 +374,26 @@ func generateMinimalFulcio(name string) *Fulcio {
 }
 
 func addCIIssuerMetadata(config *Fulcio) *Fulcio {
-	config.Spec.Config.CIIssuerMetadata = []CIIssuerMetadata{
-		{
-			IssuerName:                     "gitlab-ci",
-			DefaultTemplateValues:          map[string]string{"url": "https://gitlab.com"},
-			SubjectAlternativeNameTemplate: "https://{{ .ci_config_ref_uri }}",
-			ExtensionTemplates: Extensions{
-				BuildSignerURI:                      "https://{{ .ci_config_ref_uri }}",
-				BuildSignerDigest:                   "ci_config_sha",
-				RunnerEnvironment:                   "runner_environment",
-				SourceRepositoryURI:                 "{{ .url }}/{{ .project_path }}",
-				SourceRepositoryDigest:              "sha",
-				SourceRepositoryRef:                 "refs/{{if eq .ref_type \"branch\"}}heads/{{ else }}tags/{{end}}{{ .ref }}",
-				SourceRepositoryIdentifier:          "project_id",
-				SourceRepositoryOwnerURI:            "{{ .url }}/{{ .namespace_path }}",
-				SourceRepositoryOwnerIdentifier:     "namespace_id",
-				BuildConfigURI:                      "https://{{ .ci_config_ref_uri }}",
-				BuildConfigDigest:                   "ci_config_sha",
-				BuildTrigger:                        "pipeline_source",
-				RunInvocationURI:                    "{{ .url }}/{{ .project_path }}/-/jobs/{{ .job_id }}",
-				SourceRepositoryVisibilityAtSigning: "project_visibility",
-			},
+	config.Spec.Config.CIIssuerMetadata = append(config.Spec.Config.CIIssuerMetadata, CIIssuerMetadata{
+		IssuerName:                     "gitlab-ci",
+		DefaultTemplateValues:          map[string]string{"url": "https://gitlab.com"},
+		SubjectAlternativeNameTemplate: "https://{{ .ci_config_ref_uri }}",
+		ExtensionTemplates: Extensions{
+			BuildSignerURI:                      "https://{{ .ci_config_ref_uri }}",
+			BuildSignerDigest:                   "ci_config_sha",
+			RunnerEnvironment:                   "runner_environment",
+			SourceRepositoryURI:                 "{{ .url }}/{{ .project_path }}",
+			SourceRepositoryDigest:              "sha",
+			SourceRepositoryRef:                 "refs/{{if eq .ref_type \"branch\"}}heads/{{ else }}tags/{{end}}{{ .ref }}",
+			SourceRepositoryIdentifier:          "project_id",
+			SourceRepositoryOwnerURI:            "{{ .url }}/{{ .namespace_path }}",
+			SourceRepositoryOwnerIdentifier:     "namespace_id",
+			BuildConfigURI:                      "https://{{ .ci_config_ref_uri }}",
+			BuildConfigDigest:                   "ci_config_sha",
+			BuildTrigger:                        "pipeline_source",
+			RunInvocationURI:                    "{{ .url }}/{{ .project_path }}/-/jobs/{{ .job_id }}",
+			SourceRepositoryVisibilityAtSigning: "project_visibility",
 		},
-	}
+	})
 	return config
 }
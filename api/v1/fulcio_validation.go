@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func (s *FulcioSpec) validate(v *specValidator, path *field.Path) {
+	s.Config.validate(v, path.Child("config"))
+	s.Signer.validate(v, path.Child("signer"))
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+	v.auth(path.Child("auth"), s.Auth)
+	if s.TemplatePreview != nil {
+		v.configMap(path.Child("templatePreview", "claimsRef"), &s.TemplatePreview.ClaimsRef)
+	}
+}
+
+func (s *FulcioSpec) validateUpdate(v *specValidator, path *field.Path, old *FulcioSpec) {
+	signer, oldSigner := s.Signer, old.Signer
+	chain, oldChain := signer.CertificateChain, oldSigner.CertificateChain
+	switch {
+	case secretKeySelectorChanged(oldChain.CertificateChainRef, chain.CertificateChainRef):
+		v.warn(path.Child("signer", "certificateChain", "certificateChainRef"),
+			"the CA certificate is replaced, clients must trust the new certificate chain published by TUF")
+	case (oldChain.IntermediateCA == nil) != (chain.IntermediateCA == nil):
+		v.warn(path.Child("signer", "certificateChain", "intermediateCA"),
+			"the CA certificate is replaced, clients must trust the new certificate chain published by TUF")
+	}
+	if oldSigner.File != nil && signer.File != nil && secretKeySelectorChanged(oldSigner.File.PrivateKeyRef, signer.File.PrivateKeyRef) {
+		v.warn(path.Child("signer", "file", "privateKeyRef"), "the CA private key is replaced")
+	}
+}
+
+func (c *FulcioConfig) validate(v *specValidator, path *field.Path) {
+	providers := make(map[string]bool, len(c.CIIssuerMetadata))
+	for i, metadata := range c.CIIssuerMetadata {
+		providers[metadata.IssuerName] = true
+		metadata.validate(v, path.Child("ciIssuerMetadata").Index(i))
+	}
+
+	validateIssuers := func(path *field.Path, issuers []OIDCIssuer) {
+		for i, issuer := range issuers {
+			p := path.Index(i).Child("ciProvider")
+			switch {
+			case issuer.CIProvider != "" && !providers[issuer.CIProvider]:
+				v.invalid(p, issuer.CIProvider, "must match the issuerName of a ciIssuerMetadata entry")
+			case issuer.Type == "ci-provider" && issuer.CIProvider == "":
+				v.errs = append(v.errs, field.Required(p, "required by the ci-provider issuer type"))
+			}
+		}
+	}
+	validateIssuers(path.Child("oidcIssuers"), c.OIDCIssuers)
+	validateIssuers(path.Child("metaIssuers"), c.MetaIssuers)
+	for i, issuer := range c.MetaIssuers {
+		validateMetaIssuer(v, path.Child("metaIssuers").Index(i).Child("issuer"), issuer.Issuer)
+	}
+}
+
+// validateMetaIssuer checks the issuer pattern, the issuers matching it are only known when Fulcio receives a token.
+func validateMetaIssuer(v *specValidator, path *field.Path, pattern string) {
+	u, err := url.Parse(strings.ReplaceAll(pattern, "*", "x"))
+	switch {
+	case err != nil:
+		v.invalid(path, pattern, err.Error())
+	case (u.Scheme != "https" && u.Scheme != "http") || u.Host == "":
+		v.invalid(path, pattern, "must be an http or https URL with a host")
+	}
+}
+
+// validate parses the templates the same way Fulcio does when it loads the configuration.
+func (m *CIIssuerMetadata) validate(v *specValidator, path *field.Path) {
+	extensions := reflect.ValueOf(m.ExtensionTemplates)
+	for i := 0; i < extensions.NumField(); i++ {
+		value := extensions.Field(i).String()
+		if value == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(extensions.Type().Field(i).Tag.Get("json"), ",")
+		validateTemplate(v, path.Child("extensionTemplates", name), value)
+	}
+	validateTemplate(v, path.Child("subjectAlternativeNameTemplate"), m.SubjectAlternativeNameTemplate)
+}
+
+func validateTemplate(v *specValidator, path *field.Path, value string) {
+	if _, err := template.New("").Option("missingkey=error").Parse(value); err != nil {
+		v.invalid(path, value, err.Error())
+	}
+}
+
+func (s *FulcioSigner) validate(v *specValidator, path *field.Path) {
+	chainPath := path.Child("certificateChain")
+	v.keyAlgorithm(path.Child("keyAlgorithm"), s.KeyAlgorithm)
+
+	if s.File != nil && s.File.PrivateKeyRef != nil {
+		keyPath := path.Child("file", "privateKeyRef")
+		key := v.privateKey(keyPath, s.File.PrivateKeyRef,
+			path.Child("file", "privateKeyPasswordRef"), s.File.PrivateKeyPasswordRef) //nolint:staticcheck
+		cert := v.certificateChain(chainPath.Child("certificateChainRef"), s.CertificateChain.CertificateChainRef)
+		v.keyPair(keyPath, s.File.PrivateKeyRef, key, cert)
+	}
+
+	if intermediate := s.CertificateChain.IntermediateCA; intermediate != nil {
+		intermediatePath := chainPath.Child("intermediateCA")
+		v.keyAlgorithm(intermediatePath.Child("keyAlgorithm"), intermediate.KeyAlgorithm)
+		if intermediate.RootPrivateKeyRef != nil {
+			keyPath := intermediatePath.Child("rootPrivateKeyRef")
+			key := v.privateKey(keyPath, intermediate.RootPrivateKeyRef, nil, nil)
+			cert := v.certificateChain(intermediatePath.Child("rootCertificateRef"), intermediate.RootCertificateRef)
+			v.keyPair(keyPath, intermediate.RootPrivateKeyRef, key, cert)
+		} else if ptr.Deref(intermediate.Renewal.Enabled, false) && !ptr.Deref(intermediate.StoreRootPrivateKey, false) {
+			v.warn(intermediatePath.Child("renewal", "enabled"),
+				"the generated root private key is not stored, set storeRootPrivateKey to renew the intermediate")
+		}
+	}
+}
@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type FulcioDefaulter struct{}
 
+// FulcioValidator performs the semantic checks of the Fulcio spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type FulcioValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-fulcio,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=fulcios,verbs=create;update,versions=v1,name=mfulcio.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-fulcio,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=fulcios,verbs=create;update,versions=v1,name=vfulcio.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupFulcioWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &Fulcio{}).
 		WithDefaulter(&FulcioDefaulter{}).
+		WithValidator(&FulcioValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,24 @@ func (d *FulcioDefaulter) Default(ctx context.Context, obj *Fulcio) error {
 	obj.Spec.SetDefaults()
 	return nil
 }
+
+func (v *FulcioValidator) ValidateCreate(ctx context.Context, obj *Fulcio) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Fulcio", obj.Name)
+}
+
+func (v *FulcioValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *Fulcio) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	newObj.Spec.validateUpdate(sv, field.NewPath("spec"), &oldObj.Spec)
+	return sv.result("Fulcio", newObj.Name)
+}
+
+func (v *FulcioValidator) ValidateDelete(context.Context, *Fulcio) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -46,9 +46,11 @@ func addKnownTypes(s *runtime.Scheme) error {
 		&CTlog{}, &CTlogList{},
 		&Fulcio{}, &FulcioList{},
 		&Rekor{}, &RekorList{},
+		&RekorV2{}, &RekorV2List{},
 		&Securesign{}, &SecuresignList{},
 		&TimestampAuthority{}, &TimestampAuthorityList{},
 		&Trillian{}, &TrillianList{},
+		&TrillianTree{}, &TrillianTreeList{},
 		&Tuf{}, &TufList{},
 	)
 	metav1.AddToGroupVersion(s, GroupVersion)
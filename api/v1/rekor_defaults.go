@@ -28,9 +28,11 @@ func (s *RekorAttestations) SetDefaults() {
 
 func (s *RekorSigner) SetDefaults() {
 	setDefault(&s.Type, RekorSignerTypeSecret)
+	setDefault(&s.KeyAlgorithm, KeyAlgorithmECDSAP256)
 }
 
 func (s *SearchIndex) SetDefaults() {
+	s.PodRequirements.SetDefaults()
 	setDefault(&s.Create, ptr.To(true))
 }
 
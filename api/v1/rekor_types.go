@@ -23,6 +23,7 @@ import (
 )
 
 // RekorSpec defines the desired state of Rekor
+// +kubebuilder:validation:XValidation:rule=(!has(self.treeID) || !has(self.treeRef)),message=treeID and treeRef are mutually exclusive
 type RekorSpec struct {
 	PodRequirements      `json:",inline"`
 	ServiceAccountConfig `json:",inline"`
@@ -30,12 +31,22 @@ type RekorSpec struct {
 	// If it is unset, the operator will create new Merkle tree in the Trillian backend
 	//+optional
 	TreeID *int64 `json:"treeID,omitempty"`
+	// Reference to a TrillianTree in the same namespace providing the ID of Merkle tree.
+	// Mutually exclusive with treeID.
+	//+optional
+	TreeRef *LocalObjectReference `json:"treeRef,omitempty"`
 	// Trillian service configuration
 	Trillian ServiceReference `json:"trillian,omitempty"`
 	// Define whether you want to export service or not
 	Ingress Ingress `json:"ingress,omitempty"`
 	//Enable Service monitors for rekor
 	Monitoring MonitoringWithTLogConfig `json:"monitoring,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// Autoscaling configuration
+	//+optional
+	Autoscaling Autoscaling `json:"autoscaling,omitempty"`
 	// Signer configuration
 	Signer RekorSigner `json:"signer,omitempty"`
 	// Attestations configuration
@@ -121,13 +132,23 @@ type RekorSigner struct {
 	// When type is "secret", this field can be left empty — the operator will automatically generate a signer key.
 	// +optional
 	KeyRef *SecretKeySelector `json:"keyRef,omitempty"`
+
+	// Algorithm of the signer private key generated by the operator.
+	// Changing it does not replace an existing key.
+	//+optional
+	//+kubebuilder:validation:Enum=ecdsa-p256;ecdsa-p384;ecdsa-p521;rsa-3072;rsa-4096;ed25519
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
 }
 
 // SearchIndex define search index connection
 // +kubebuilder:validation:XValidation:rule=(!has(self.create) || !(self.create == true) || !has(self.provider) || self.provider == ""),message=Provider can be specified only with external db (create=false)
 // +kubebuilder:validation:XValidation:rule=(!has(self.create) || !(self.create == false) || self.provider != ""),message=Provider must be defined with external db (create=false)
 // +kubebuilder:validation:XValidation:rule=(!(has(self.provider) && self.provider != "") || (self.url != "")),message=URL must be provided if provider is specified
+// +kubebuilder:validation:XValidation:rule="!has(self.replicas) || self.replicas <= 1",message="the managed database runs a single replica"
 type SearchIndex struct {
+	// Scheduling and resources of the managed Redis database. Redis keeps the index in the pod and runs
+	// a single replica.
+	PodRequirements `json:",inline"`
 	// Create Database if a database. If create=true provider and url fields are not taken into account, otherwise url field must be specified.
 	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
 	Create *bool `json:"create,omitempty"`
@@ -199,6 +220,11 @@ type RekorStatus struct {
 	// The ID of a Trillian tree that stores the log data.
 	// +kubebuilder:validation:Type=number
 	TreeID *int64 `json:"treeID,omitempty"`
+	// Validity of the certificates held by the component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
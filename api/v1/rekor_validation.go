@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"slices"
+
+	fipsutil "github.com/securesign/operator/internal/utils/fips"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *RekorSpec) validate(v *specValidator, path *field.Path) {
+	s.Signer.validate(v, path.Child("signer"))
+	s.SearchIndex.TLS.validate(v, path.Child("searchIndex", "tls"))
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+	v.auth(path.Child("auth"), s.Auth)
+
+	seen := make(map[int64]bool, len(s.Sharding))
+	for i, shard := range s.Sharding {
+		p := path.Child("sharding").Index(i)
+		switch {
+		case seen[shard.TreeID]:
+			v.errs = append(v.errs, field.Duplicate(p.Child("treeID"), shard.TreeID))
+		case s.TreeID != nil && *s.TreeID == shard.TreeID:
+			v.invalid(p.Child("treeID"), shard.TreeID, "the active tree can't be an inactive shard")
+		}
+		seen[shard.TreeID] = true
+		if shard.EncodedPublicKey != "" {
+			validateEncodedPublicKey(v, p.Child("encodedPublicKey"), shard.EncodedPublicKey)
+		}
+	}
+}
+
+func (s *RekorSpec) validateUpdate(v *specValidator, path *field.Path, old *RekorSpec) {
+	if old.TreeID != nil && (s.TreeID == nil || *s.TreeID != *old.TreeID) &&
+		!slices.ContainsFunc(s.Sharding, func(shard RekorLogRange) bool { return shard.TreeID == *old.TreeID }) {
+		v.warn(path.Child("treeID"), "the previous tree %d is not listed in spec.sharding, its entries are no longer served", *old.TreeID)
+	}
+	if secretKeySelectorChanged(old.Signer.KeyRef, s.Signer.KeyRef) || old.Signer.Type != s.Signer.Type {
+		v.warn(path.Child("signer"), "the signer key is replaced, clients must trust the new public key published by TUF")
+	}
+}
+
+func (s *RekorSigner) validate(v *specValidator, path *field.Path) {
+	if s.Type == RekorSignerTypeSecret && s.KeyRef == nil {
+		v.keyAlgorithm(path.Child("keyAlgorithm"), s.KeyAlgorithm)
+	}
+	v.privateKey(path.Child("keyRef"), s.KeyRef, nil, nil)
+}
+
+func (t *TLS) validate(v *specValidator, path *field.Path) {
+	if t.CertRef == nil {
+		v.secretKey(path.Child("privateKeyRef"), t.PrivateKeyRef)
+		return
+	}
+	key := v.privateKey(path.Child("privateKeyRef"), t.PrivateKeyRef, nil, nil)
+	cert := v.certificateChain(path.Child("certificateRef"), t.CertRef)
+	v.keyPair(path.Child("privateKeyRef"), t.PrivateKeyRef, key, cert)
+}
+
+// validateEncodedPublicKey accepts a base64 encoded PEM or DER public key, like Rekor does for inactive shards.
+func validateEncodedPublicKey(v *specValidator, path *field.Path, value string) {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		v.invalid(path, value, fmt.Sprintf("invalid base64: %v", err))
+		return
+	}
+	if block, _ := pem.Decode(decoded); block != nil {
+		v.publicKey(path, value, decoded)
+		return
+	}
+	if fipsutil.Enabled() {
+		if err := fipsutil.ValidatePublicKeyDER(decoded); err != nil {
+			v.invalid(path, value, err.Error())
+		}
+		return
+	}
+	if _, err := x509.ParsePKIXPublicKey(decoded); err != nil {
+		v.invalid(path, value, fmt.Sprintf("invalid public key: %v", err))
+	}
+}
@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type RekorDefaulter struct{}
 
+// RekorValidator performs the semantic checks of the Rekor spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type RekorValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-rekor,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=rekors,verbs=create;update,versions=v1,name=mrekor.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-rekor,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=rekors,verbs=create;update,versions=v1,name=vrekor.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupRekorWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &Rekor{}).
 		WithDefaulter(&RekorDefaulter{}).
+		WithValidator(&RekorValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,24 @@ func (d *RekorDefaulter) Default(ctx context.Context, obj *Rekor) error {
 	obj.Spec.SetDefaults()
 	return nil
 }
+
+func (v *RekorValidator) ValidateCreate(ctx context.Context, obj *Rekor) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Rekor", obj.Name)
+}
+
+func (v *RekorValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *Rekor) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	newObj.Spec.validateUpdate(sv, field.NewPath("spec"), &oldObj.Spec)
+	return sv.result("Rekor", newObj.Name)
+}
+
+func (v *RekorValidator) ValidateDelete(context.Context, *Rekor) (admission.Warnings, error) {
+	return nil, nil
+}
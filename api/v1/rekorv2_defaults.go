@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func (s *RekorV2Spec) SetDefaults() {
+	s.PodRequirements.SetDefaults()
+	s.Monitoring.SetDefaults()
+	s.Ingress.SetDefaults()
+	s.Storage.SetDefaults()
+	s.Signer.SetDefaults()
+	setDefault(&s.CheckpointInterval, &metav1.Duration{Duration: time.Second})
+	setDefault(&s.MaxRequestBodySize, ptr.To(int64(4194304)))
+}
+
+func (s *RekorV2Storage) SetDefaults() {
+	setDefault(&s.Type, RekorV2StoragePOSIX)
+	if s.Type == RekorV2StoragePOSIX {
+		s.Pvc.SetDefaults()
+	}
+}
+
+func (s *RekorV2Signer) SetDefaults() {
+	setDefault(&s.KeyAlgorithm, KeyAlgorithmECDSAP256)
+}
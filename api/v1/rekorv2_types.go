@@ -0,0 +1,210 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	RekorV2StoragePOSIX = "posix"
+	RekorV2StorageS3    = "s3"
+)
+
+// RekorV2Spec defines the desired state of the tile-based Rekor v2 transparency log
+// +kubebuilder:validation:XValidation:rule="!has(self.replicas) || self.replicas <= 1 || (has(self.storage) && has(self.storage.type) && self.storage.type == 's3')",message="POSIX storage supports a single replica, use the s3 storage to scale Rekor v2"
+type RekorV2Spec struct {
+	PodRequirements      `json:",inline"`
+	ServiceAccountConfig `json:",inline"`
+	// Public hostname of the log, used as the checkpoint origin.
+	// Defaults to the ingress host or to the service hostname. The origin is part of every
+	// checkpoint and can't be changed once the log is created.
+	//+optional
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	Hostname string `json:"hostname,omitempty"`
+	// Storage backend of the log tiles and checkpoints
+	Storage RekorV2Storage `json:"storage,omitempty"`
+	// Signer configuration
+	Signer RekorV2Signer `json:"signer,omitempty"`
+	// Interval of publishing a new checkpoint.
+	//+optional
+	//+kubebuilder:validation:XValidation:rule="duration(self) >= duration('100ms') && duration(self) <= duration('1m')",message=Interval must be between 100ms and 1m
+	CheckpointInterval *metav1.Duration `json:"checkpointInterval,omitempty"`
+	// Define whether you want to export service or not
+	Ingress Ingress `json:"ingress,omitempty"`
+	// Enable Service monitors for Rekor v2
+	Monitoring MonitoringConfig `json:"monitoring,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// ConfigMap with additional bundle of trusted CA
+	//+optional
+	TrustedCA *LocalObjectReference `json:"trustedCA,omitempty"`
+	// Configuration for authentication for key management services and storage
+	//+optional
+	Auth *Auth `json:"auth,omitempty"`
+	// MaxRequestBodySize sets the maximum size in bytes for HTTP request body. Passed as --max-request-body-size.
+	//+optional
+	MaxRequestBodySize *int64 `json:"maxRequestBodySize,omitempty"`
+}
+
+// RekorV2Storage selects the storage backend of the log.
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 's3' || has(self.s3)",message="s3 is required when type is 's3'"
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'posix' || !has(self.s3)",message="s3 should not be configured when type is 'posix'"
+type RekorV2Storage struct {
+	// Type of the storage backend. The posix backend stores the log on a persistent volume
+	// and serves the tiles from the Rekor v2 pod, the s3 backend stores the tiles in
+	// an S3-compatible bucket and the coordination state in MySQL.
+	//+kubebuilder:validation:Enum=posix;s3
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	//+optional
+	Type string `json:"type,omitempty"`
+	// PVC configuration of the posix backend
+	//+optional
+	Pvc Pvc `json:"pvc,omitempty"`
+	// Configuration of the s3 backend
+	//+optional
+	S3 *RekorV2S3Storage `json:"s3,omitempty"`
+}
+
+// RekorV2S3Storage configures the S3-compatible bucket and the MySQL database of the s3 backend.
+type RekorV2S3Storage struct {
+	// Name of the bucket storing the tiles and checkpoints.
+	//+required
+	//+kubebuilder:validation:MinLength=1
+	Bucket string `json:"bucket"`
+	// Endpoint of an S3-compatible service. Empty uses AWS S3.
+	//+optional
+	//+kubebuilder:validation:Pattern:="^https?://.+"
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region of the bucket.
+	//+optional
+	Region string `json:"region,omitempty"`
+	// Secret with the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY keys.
+	// Empty uses the default credential chain, e.g. environment from spec.auth.
+	//+optional
+	CredentialsRef *LocalObjectReference `json:"credentialsRef,omitempty"`
+	// Reference to the MySQL DSN, e.g. user:password@tcp(mysql:3306)/rekor
+	//+required
+	DatabaseRef SecretKeySelector `json:"databaseRef"`
+	// Public URL of the bucket the clients read the tiles from.
+	// Defaults to the bucket URL derived from the endpoint.
+	//+optional
+	//+kubebuilder:validation:Pattern:="^https?://.+"
+	TilesURL string `json:"tilesURL,omitempty"`
+}
+
+// RekorV2Signer defines the checkpoint signer of the Rekor v2 log.
+// +kubebuilder:validation:XValidation:rule="!has(self.kms) || !has(self.keyRef)",message="kms and keyRef are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.kms) || has(self.publicKeyRef)",message="publicKeyRef is required with kms"
+type RekorV2Signer struct {
+	// Configuration for KMS-based signer.
+	//+optional
+	Kms *KMS `json:"kms,omitempty"`
+	// Reference to the signer private key.
+	// When neither keyRef nor kms is set, the operator generates a signer key.
+	//+optional
+	KeyRef *SecretKeySelector `json:"keyRef,omitempty"`
+	// Password to decrypt the signer private key
+	//+optional
+	PasswordRef *SecretKeySelector `json:"passwordRef,omitempty"`
+	// Reference to the signer public key. Defaults to the "public" key of the keyRef secret.
+	//+optional
+	PublicKeyRef *SecretKeySelector `json:"publicKeyRef,omitempty"`
+	// Algorithm of the signer private key generated by the operator.
+	// Changing it does not replace an existing key.
+	//+optional
+	//+kubebuilder:validation:Enum=ecdsa-p256;ecdsa-p384;ecdsa-p521;ed25519
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
+}
+
+// RekorV2Status defines the observed state of the Rekor v2 transparency log
+type RekorV2Status struct {
+	// Reference to the resolved signer private key.
+	PrivateKeyRef *SecretKeySelector `json:"privateKeyRef,omitempty"`
+	// Reference to the password of the signer private key.
+	PasswordRef *SecretKeySelector `json:"passwordRef,omitempty"`
+	// Reference to the signer public key.
+	PublicKeyRef *SecretKeySelector `json:"publicKeyRef,omitempty"`
+	// PEM-encoded public key of the checkpoint signer.
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+	// Checkpoint origin of the log.
+	Origin  string `json:"origin,omitempty"`
+	PvcName string `json:"pvcName,omitempty"`
+	Url     string `json:"url,omitempty"`
+	// Url the clients read the tiles and checkpoints from.
+	TilesUrl string `json:"tilesUrl,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="The component status"
+//+kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`,description="The component url"
+//+kubebuilder:printcolumn:name="Origin",type=string,JSONPath=`.status.origin`,description="The checkpoint origin",priority=1
+
+// RekorV2 is the Schema for the rekorv2s API
+type RekorV2 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RekorV2Spec   `json:"spec,omitempty"`
+	Status RekorV2Status `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RekorV2List contains a list of RekorV2
+type RekorV2List struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RekorV2 `json:"items"`
+}
+
+func (i *RekorV2) GetConditions() []metav1.Condition {
+	return i.Status.Conditions
+}
+
+func (i *RekorV2) SetCondition(newCondition metav1.Condition) {
+	meta.SetStatusCondition(&i.Status.Conditions, newCondition)
+}
+
+func (i *RekorV2) GetTrustedCA() *LocalObjectReference {
+	if i.Spec.TrustedCA != nil {
+		return i.Spec.TrustedCA
+	}
+
+	if v, ok := i.GetAnnotations()["rhtas.redhat.com/trusted-ca"]; ok {
+		return &LocalObjectReference{
+			Name: v,
+		}
+	}
+
+	return nil
+}
+
+func (i *RekorV2) GetServiceURL() string {
+	return i.Status.Url
+}
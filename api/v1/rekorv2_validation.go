@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *RekorV2Spec) validate(v *specValidator, path *field.Path) {
+	s.Signer.validate(v, path.Child("signer"))
+	if s3 := s.Storage.S3; s3 != nil {
+		p := path.Child("storage", "s3")
+		v.secretKey(p.Child("databaseRef"), &s3.DatabaseRef)
+		v.secret(p.Child("credentialsRef"), s3.CredentialsRef)
+	}
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+	v.auth(path.Child("auth"), s.Auth)
+}
+
+func (s *RekorV2Spec) validateUpdate(v *specValidator, path *field.Path, old *RekorV2Spec) {
+	if secretKeySelectorChanged(old.Signer.KeyRef, s.Signer.KeyRef) || (old.Signer.Kms == nil) != (s.Signer.Kms == nil) {
+		v.warn(path.Child("signer"), "the checkpoint signer is replaced, clients must trust the new public key published by TUF")
+	}
+}
+
+func (s *RekorV2Signer) validate(v *specValidator, path *field.Path) {
+	if s.KeyRef == nil && s.Kms == nil {
+		v.keyAlgorithm(path.Child("keyAlgorithm"), s.KeyAlgorithm)
+	}
+	key := v.privateKey(path.Child("keyRef"), s.KeyRef, path.Child("passwordRef"), s.PasswordRef)
+	if s.PublicKeyRef != nil {
+		p := path.Child("publicKeyRef")
+		v.publicKeyPair(p, s.PublicKeyRef, key, v.publicKey(p, s.PublicKeyRef.Name, v.secretKey(p, s.PublicKeyRef)))
+	}
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+type RekorV2Defaulter struct{}
+
+// RekorV2Validator performs the semantic checks of the RekorV2 spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type RekorV2Validator struct {
+	reader client.Reader
+}
+
+//+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-rekorv2,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=rekorv2s,verbs=create;update,versions=v1,name=mrekorv2.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-rekorv2,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=rekorv2s,verbs=create;update,versions=v1,name=vrekorv2.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+
+func SetupRekorV2WebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &RekorV2{}).
+		WithDefaulter(&RekorV2Defaulter{}).
+		WithValidator(&RekorV2Validator{reader: mgr.GetAPIReader()}).
+		Complete()
+}
+
+func (d *RekorV2Defaulter) Default(ctx context.Context, obj *RekorV2) error {
+	logf.FromContext(ctx).WithName("RekorV2").Info("setting defaults", "name", obj.Name)
+	obj.Spec.SetDefaults()
+	return nil
+}
+
+func (v *RekorV2Validator) ValidateCreate(ctx context.Context, obj *RekorV2) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("RekorV2", obj.Name)
+}
+
+func (v *RekorV2Validator) ValidateUpdate(ctx context.Context, oldObj, newObj *RekorV2) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	newObj.Spec.validateUpdate(sv, field.NewPath("spec"), &oldObj.Spec)
+	return sv.result("RekorV2", newObj.Name)
+}
+
+func (v *RekorV2Validator) ValidateDelete(context.Context, *RekorV2) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -21,17 +21,42 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	RekorVersionV1 = "v1"
+	RekorVersionV2 = "v2"
+)
+
 // SecuresignSpec defines the desired state of Securesign
 // +kubebuilder:validation:XValidation:rule="has(self.fulcio.config.oidcIssuers) || has(self.fulcio.config.metaIssuers)",message="At least one OIDC issuer or meta issuer must be configured in fulcio.config"
 // +kubebuilder:validation:XValidation:rule="!has(self.tuf.replicas) || !(self.tuf.replicas > 1) || (has(self.tuf.pvc.accessModes) && 'ReadWriteMany' in self.tuf.pvc.accessModes)",message="For tuf deployments with more than 1 replica, pvc.accessModes must include 'ReadWriteMany'."
 // +kubebuilder:validation:XValidation:rule="(has(self.rekor.attestations.enabled) && !self.rekor.attestations.enabled) || !self.rekor.attestations.url.startsWith('file://') || !has(self.rekor.replicas) || !(self.rekor.replicas > 1) || (has(self.rekor.attestations.pvc.accessModes) && 'ReadWriteMany' in self.rekor.attestations.pvc.accessModes)",message="When rich attestation storage is enabled, and it's URL starts with 'file://', then rekor pvc.accessModes must contain 'ReadWriteMany' for replicas greater than 1."
+// +kubebuilder:validation:XValidation:rule="!has(self.rekorVersion) || self.rekorVersion != 'v2' || !has(self.console) || (has(self.console.ui) && has(self.console.ui.rekor) && (has(self.console.ui.rekor.url) || has(self.console.ui.rekor.ref)))",message="The console requires the search API of Rekor v1, console.ui.rekor must reference a Rekor v1 with the v2 rekorVersion."
 type SecuresignSpec struct {
-	Rekor              RekorSpec               `json:"rekor,omitempty"`
+	// Version of the Rekor transparency log. The v2 version deploys the tile-based Rekor v2
+	// configured by rekorV2 instead of the Trillian-backed Rekor configured by rekor.
+	//+kubebuilder:validation:Enum=v1;v2
+	//+kubebuilder:default:=v1
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	//+optional
+	RekorVersion string    `json:"rekorVersion,omitempty"`
+	Rekor        RekorSpec `json:"rekor,omitempty"`
+	// Rekor v2 configuration, used with the v2 rekorVersion.
+	//+optional
+	RekorV2            RekorV2Spec             `json:"rekorV2,omitempty"`
 	Fulcio             FulcioSpec              `json:"fulcio"`
 	Trillian           TrillianSpec            `json:"trillian,omitempty"`
 	Tuf                TufSpec                 `json:"tuf,omitempty"`
 	Ctlog              CTlogSpec               `json:"ctlog,omitempty"`
 	TimestampAuthority *TimestampAuthoritySpec `json:"tsa,omitempty"`
+	// Console configuration. The console is created only when defined.
+	// Empty Rekor and TUF references are wired to the components of this Securesign.
+	// With the v2 rekorVersion the Rekor reference must point to a Rekor v1.
+	//+optional
+	Console *ConsoleSpec `json:"console,omitempty"`
+	// Default NetworkPolicy configuration for all components.
+	// A component setting takes precedence over this value.
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
 }
 
 // SecuresignStatus defines the observed state of Securesign
@@ -41,11 +66,42 @@ type SecuresignStatus struct {
 	// +patchStrategy=merge
 	// +patchMergeKey=type
 	// +optional
-	Conditions   []metav1.Condition     `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
-	RekorStatus  SecuresignRekorStatus  `json:"rekor,omitempty"`
-	FulcioStatus SecuresignFulcioStatus `json:"fulcio,omitempty"`
-	TufStatus    SecuresignTufStatus    `json:"tuf,omitempty"`
-	TSAStatus    SecuresignTSAStatus    `json:"tsa,omitempty"`
+	Conditions    []metav1.Condition      `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+	RekorStatus   SecuresignRekorStatus   `json:"rekor,omitempty"`
+	FulcioStatus  SecuresignFulcioStatus  `json:"fulcio,omitempty"`
+	TufStatus     SecuresignTufStatus     `json:"tuf,omitempty"`
+	TSAStatus     SecuresignTSAStatus     `json:"tsa,omitempty"`
+	ConsoleStatus SecuresignConsoleStatus `json:"console,omitempty"`
+	// Components reports the observed state of each child resource.
+	// +listType=map
+	// +listMapKey=kind
+	// +optional
+	Components []SecuresignComponentStatus `json:"components,omitempty"`
+}
+
+// SecuresignComponentStatus is the observed state of a child resource created by the Securesign.
+type SecuresignComponentStatus struct {
+	// Kind of the child resource.
+	Kind string `json:"kind"`
+	// Name of the child resource.
+	Name string `json:"name"`
+	// Url of the component service.
+	// +optional
+	Url string `json:"url,omitempty"`
+	// Status of the child Ready condition.
+	Ready metav1.ConditionStatus `json:"ready"`
+	// Reason of the child Ready condition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message of the child Ready condition.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime of the child Ready condition.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// ObservedGeneration is the child generation the Ready condition was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 type SecuresignRekorStatus struct {
@@ -53,7 +109,8 @@ type SecuresignRekorStatus struct {
 }
 
 type SecuresignFulcioStatus struct {
-	Url string `json:"url,omitempty"`
+	Url     string `json:"url,omitempty"`
+	GrpcUrl string `json:"grpcUrl,omitempty"`
 }
 
 type SecuresignTufStatus struct {
@@ -64,6 +121,10 @@ type SecuresignTSAStatus struct {
 	Url string `json:"url,omitempty"`
 }
 
+type SecuresignConsoleStatus struct {
+	Url string `json:"url,omitempty"`
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:storageversion
@@ -71,6 +132,8 @@ type SecuresignTSAStatus struct {
 //+kubebuilder:printcolumn:name="Rekor URL",type=string,JSONPath=`.status.rekor.url`,description="The rekor url"
 //+kubebuilder:printcolumn:name="Fulcio URL",type=string,JSONPath=`.status.fulcio.url`,description="The fulcio url"
 //+kubebuilder:printcolumn:name="Tuf URL",type=string,JSONPath=`.status.tuf.url`,description="The tuf url"
+//+kubebuilder:printcolumn:name="TSA URL",type=string,JSONPath=`.status.tsa.url`,description="The tsa url",priority=1
+//+kubebuilder:printcolumn:name="Console URL",type=string,JSONPath=`.status.console.url`,description="The console url",priority=1
 
 // Securesign is the Schema for the securesigns API
 type Securesign struct {
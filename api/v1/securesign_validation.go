@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *SecuresignSpec) validate(v *specValidator, path *field.Path) {
+	if s.RekorVersion == RekorVersionV2 {
+		s.RekorV2.validate(v, path.Child("rekorV2"))
+	} else {
+		s.Rekor.validate(v, path.Child("rekor"))
+	}
+	s.Fulcio.validate(v, path.Child("fulcio"))
+	s.Trillian.validate(v, path.Child("trillian"))
+	s.Tuf.validate(v, path.Child("tuf"))
+	s.Ctlog.validate(v, path.Child("ctlog"))
+	if s.TimestampAuthority != nil {
+		s.TimestampAuthority.validate(v, path.Child("tsa"))
+	}
+	if s.Console != nil {
+		s.Console.validate(v, path.Child("console"))
+		if rekor := s.Console.UI.Rekor; s.RekorVersion == RekorVersionV2 && rekor.Ref == nil && rekor.URL == "" {
+			v.invalid(path.Child("console", "ui", "rekor"), "", "the console requires the search API of Rekor v1, reference a Rekor v1 with the v2 rekorVersion")
+		}
+	}
+}
+
+func (s *SecuresignSpec) validateUpdate(v *specValidator, path *field.Path, old *SecuresignSpec) {
+	if s.RekorVersion == RekorVersionV2 {
+		s.RekorV2.validateUpdate(v, path.Child("rekorV2"), &old.RekorV2)
+	} else {
+		s.Rekor.validateUpdate(v, path.Child("rekor"), &old.Rekor)
+	}
+	s.Fulcio.validateUpdate(v, path.Child("fulcio"), &old.Fulcio)
+	s.Ctlog.validateUpdate(v, path.Child("ctlog"), &old.Ctlog)
+	if s.TimestampAuthority != nil && old.TimestampAuthority != nil {
+		s.TimestampAuthority.validateUpdate(v, path.Child("tsa"), old.TimestampAuthority)
+	}
+}
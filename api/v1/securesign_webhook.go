@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type SecuresignDefaulter struct{}
 
+// SecuresignValidator performs the semantic checks of the Securesign spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type SecuresignValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-securesign,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=securesigns,verbs=create;update,versions=v1,name=msecuresign.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-securesign,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=securesigns,verbs=create;update,versions=v1,name=vsecuresign.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupSecuresignWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &Securesign{}).
 		WithDefaulter(&SecuresignDefaulter{}).
+		WithValidator(&SecuresignValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,24 @@ func (d *SecuresignDefaulter) Default(ctx context.Context, obj *Securesign) erro
 	obj.SetDefaults()
 	return nil
 }
+
+func (v *SecuresignValidator) ValidateCreate(ctx context.Context, obj *Securesign) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Securesign", obj.Name)
+}
+
+func (v *SecuresignValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *Securesign) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	newObj.Spec.validateUpdate(sv, field.NewPath("spec"), &oldObj.Spec)
+	return sv.result("Securesign", newObj.Name)
+}
+
+func (v *SecuresignValidator) ValidateDelete(context.Context, *Securesign) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -95,10 +95,13 @@ var _ = BeforeSuite(func() {
 	Expect(SetupCTlogWebhookWithManager(mgr)).To(Succeed())
 	Expect(SetupFulcioWebhookWithManager(mgr)).To(Succeed())
 	Expect(SetupRekorWebhookWithManager(mgr)).To(Succeed())
+	Expect(SetupRekorV2WebhookWithManager(mgr)).To(Succeed())
 	Expect(SetupSecuresignWebhookWithManager(mgr)).To(Succeed())
 	Expect(SetupTimestampAuthorityWebhookWithManager(mgr)).To(Succeed())
 	Expect(SetupTrillianWebhookWithManager(mgr)).To(Succeed())
+	Expect(SetupTrillianTreeWebhookWithManager(mgr)).To(Succeed())
 	Expect(SetupTufWebhookWithManager(mgr)).To(Succeed())
+	Expect(SetupConsoleWebhookWithManager(mgr)).To(Succeed())
 
 	go func() {
 		defer GinkgoRecover()
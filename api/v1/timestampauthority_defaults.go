@@ -8,9 +8,15 @@ func (s *TimestampAuthoritySpec) SetDefaults() {
 	s.Monitoring.SetDefaults()
 	s.Ingress.SetDefaults()
 	s.NTPMonitoring.SetDefaults()
+	s.Signer.SetDefaults()
 	setDefault(&s.MaxRequestBodySize, ptr.To(int64(1048576)))
 }
 
+func (s *TimestampAuthoritySigner) SetDefaults() {
+	setDefault(&s.KeyAlgorithm, KeyAlgorithmECDSAP384)
+	s.CertificateChain.Renewal.SetDefaults()
+}
+
 func (s *NTPMonitoring) SetDefaults() {
 	setDefault(&s.Enabled, ptr.To(true))
 }
@@ -34,6 +34,12 @@ type TimestampAuthoritySpec struct {
 	Signer TimestampAuthoritySigner `json:"signer"`
 	//Enable Service monitors for Timestamp Authority
 	Monitoring MonitoringConfig `json:"monitoring,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
+	// Autoscaling configuration
+	//+optional
+	Autoscaling Autoscaling `json:"autoscaling,omitempty"`
 	//ConfigMap with additional bundle of trusted CA
 	//+optional
 	TrustedCA *LocalObjectReference `json:"trustedCA,omitempty"`
@@ -65,6 +71,11 @@ type TimestampAuthoritySigner struct {
 	//Configuration for Tink based signer
 	//+optional
 	Tink *Tink `json:"tink,omitempty"`
+	//Algorithm of the private keys of the certificate chain generated by the operator.
+	//Changing it does not replace existing keys.
+	//+optional
+	//+kubebuilder:validation:Enum=ecdsa-p256;ecdsa-p384;ecdsa-p521;rsa-3072;rsa-4096
+	KeyAlgorithm KeyAlgorithm `json:"keyAlgorithm,omitempty"`
 }
 
 // Certificate chain config
@@ -84,6 +95,9 @@ type CertificateChain struct {
 	//Leaf Certificate Authority Config
 	//+optional
 	LeafCA *TsaCertificateAuthority `json:"leafCA,omitempty"`
+	//Renewal of the leaf certificate of the chain generated by the operator.
+	//+optional
+	Renewal CertificateRenewal `json:"renewal,omitempty"`
 }
 
 // TSA Certificate Authority configuration
@@ -124,6 +138,11 @@ type NTPMonitoring struct {
 	Enabled *bool `json:"enabled,omitempty"`
 	//Configuration for Network time protocol monitoring
 	Config *NtpMonitoringConfig `json:"config,omitempty"`
+	//Take pods with a clock out of sync out of the service endpoints. The pods get a readiness gate
+	//the operator sets from the NTP monitoring metrics of the pod.
+	//+kubebuilder:default:=false
+	//+optional
+	ReadinessGate *bool `json:"readinessGate,omitempty"`
 }
 
 type NtpMonitoringConfig struct {
@@ -172,6 +191,39 @@ type FileSignerStatus struct {
 	PrivateKeyRef *SecretKeySelector `json:"privateKeyRef,omitempty"`
 }
 
+// TimestampAuthorityTimeSyncStatus is the time synchronization of the TSA pods reported by their NTP monitoring.
+type TimestampAuthorityTimeSyncStatus struct {
+	// Number of NTP servers that answered a pod since the previous check
+	HealthyServers int32 `json:"healthyServers"`
+	// Number of NTP servers that must agree with the local time of a pod
+	//+optional
+	ServerThreshold int32 `json:"serverThreshold,omitempty"`
+	// Time synchronization of each running pod
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Pods []TimestampAuthorityPodTimeSync `json:"pods,omitempty"`
+	// Time of the last check
+	LastCheckTime metav1.Time `json:"lastCheckTime"`
+}
+
+// TimestampAuthorityPodTimeSync is the time synchronization of a TSA pod.
+type TimestampAuthorityPodTimeSync struct {
+	// Name of the pod
+	Name string `json:"name"`
+	// True if the NTP servers confirmed the local time of the pod since the previous check
+	Synchronized bool `json:"synchronized"`
+	// Number of NTP servers that answered the pod since the previous check
+	//+optional
+	HealthyServers int32 `json:"healthyServers,omitempty"`
+	// Offset of the pod clock from the operator clock, with a resolution of one second
+	//+optional
+	Drift *metav1.Duration `json:"drift,omitempty"`
+	// Error of the last check
+	//+optional
+	Error string `json:"error,omitempty"`
+}
+
 // TimestampAuthorityStatus defines the observed state of TimestampAuthority
 type TimestampAuthorityStatus struct {
 	NtpConfigRef *LocalObjectReference           `json:"ntpConfigRef,omitempty"`
@@ -182,6 +234,14 @@ type TimestampAuthorityStatus struct {
 	// PEM-encoded certificate chain resolved from the running TSA service API.
 	// +optional
 	CertificateChain string `json:"certificateChain,omitempty"`
+	// Validity of the certificates held by the component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
+	// Time synchronization of the pods, scraped from their NTP monitoring metrics.
+	// +optional
+	TimeSynchronization *TimestampAuthorityTimeSyncStatus `json:"timeSynchronization,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
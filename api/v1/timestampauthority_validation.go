@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *TimestampAuthoritySpec) validate(v *specValidator, path *field.Path) {
+	s.Signer.validate(v, path.Child("signer"))
+	if s.NTPMonitoring.Config != nil {
+		v.configMap(path.Child("ntpMonitoring", "config", "ntpConfigRef"), s.NTPMonitoring.Config.NtpConfigRef)
+	}
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+	v.auth(path.Child("auth"), s.Auth)
+}
+
+func (s *TimestampAuthoritySpec) validateUpdate(v *specValidator, path *field.Path, old *TimestampAuthoritySpec) {
+	if secretKeySelectorChanged(old.Signer.CertificateChain.CertificateChainRef, s.Signer.CertificateChain.CertificateChainRef) {
+		v.warn(path.Child("signer", "certificateChain", "certificateChainRef"),
+			"the certificate chain is replaced, clients must trust the new certificate chain published by TUF")
+	}
+}
+
+func (s *TimestampAuthoritySigner) validate(v *specValidator, path *field.Path) {
+	chainRef := s.CertificateChain.CertificateChainRef
+	if chainRef == nil {
+		v.keyAlgorithm(path.Child("keyAlgorithm"), s.KeyAlgorithm)
+		return
+	}
+	cert := v.certificateChain(path.Child("certificateChain", "certificateChainRef"), chainRef)
+	switch {
+	case s.File != nil:
+		p := path.Child("file", "privateKeyRef")
+		v.keyPair(p, s.File.PrivateKeyRef, v.privateKey(p, s.File.PrivateKeyRef, nil, nil), cert)
+	case s.Tink != nil:
+		v.secretKey(path.Child("tink", "keysetRef"), s.Tink.KeysetRef)
+	}
+}
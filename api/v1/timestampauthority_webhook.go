@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type TimestampAuthorityDefaulter struct{}
 
+// TimestampAuthorityValidator performs the semantic checks of the TimestampAuthority spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type TimestampAuthorityValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-timestampauthority,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=timestampauthorities,verbs=create;update,versions=v1,name=mtimestampauthority.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-timestampauthority,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=timestampauthorities,verbs=create;update,versions=v1,name=vtimestampauthority.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupTimestampAuthorityWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &TimestampAuthority{}).
 		WithDefaulter(&TimestampAuthorityDefaulter{}).
+		WithValidator(&TimestampAuthorityValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,24 @@ func (d *TimestampAuthorityDefaulter) Default(ctx context.Context, obj *Timestam
 	obj.Spec.SetDefaults()
 	return nil
 }
+
+func (v *TimestampAuthorityValidator) ValidateCreate(ctx context.Context, obj *TimestampAuthority) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("TimestampAuthority", obj.Name)
+}
+
+func (v *TimestampAuthorityValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *TimestampAuthority) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	newObj.Spec.validateUpdate(sv, field.NewPath("spec"), &oldObj.Spec)
+	return sv.result("TimestampAuthority", newObj.Name)
+}
+
+func (v *TimestampAuthorityValidator) ValidateDelete(context.Context, *TimestampAuthority) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -12,6 +12,7 @@ func (s *TrillianSpec) SetDefaults() {
 }
 
 func (s *TrillianDB) SetDefaults() {
+	s.PodRequirements.SetDefaults()
 	setDefault(&s.Create, ptr.To(true))
 	s.Pvc.SetDefaults()
 	setDefault(&s.Provider, "mysql")
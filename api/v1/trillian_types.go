@@ -28,6 +28,9 @@ type TrillianSpec struct {
 	Db TrillianDB `json:"database,omitempty"`
 	// Enable Monitoring for Logsigner and Logserver
 	Monitoring MonitoringConfig `json:"monitoring,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
 	// Configuration for Trillian log server service
 	LogServer TrillianLogServer `json:"server,omitempty"`
 	// Configuration for Trillian log signer service
@@ -37,6 +40,10 @@ type TrillianSpec struct {
 	//+optional
 	TrustedCA *LocalObjectReference `json:"trustedCA,omitempty"`
 
+	// Quota limits the number of leaves waiting for the sequencing.
+	//+optional
+	Quota *TrillianQuota `json:"quota,omitempty"`
+
 	// MaxRecvMessageSize sets the maximum size in bytes for incoming gRPC messages handled by the Trillian logserver and logsigner.
 	//+optional
 	//+kubebuilder:validation:Minimum=0
@@ -47,7 +54,7 @@ type TrillianSpec struct {
 	PodExtensions `json:",inline"`
 }
 
-// trillianService is the shared base for TrillianLogServer and TrillianLogSigner.
+// trillianService is the shared base for TrillianLogServer.
 // Type definitions are used instead of struct embedding to avoid controller-gen deepcopy issues.
 type trillianService struct {
 	PodRequirements `json:",inline"`
@@ -58,10 +65,56 @@ type trillianService struct {
 
 type TrillianLogServer trillianService
 
-type TrillianLogSigner trillianService
+type TrillianLogSigner struct {
+	PodRequirements `json:",inline"`
+	// Configuration for enabling TLS (Transport Layer Security) encryption for manged service.
+	//+optional
+	TLS TLS `json:"tls,omitempty"`
+	// Sequencing configuration. Unset values keep the Trillian defaults.
+	//+optional
+	Sequencer *TrillianSequencer `json:"sequencer,omitempty"`
+}
+
+// TrillianSequencer configures how the log signer integrates queued leaves into the trees.
+type TrillianSequencer struct {
+	// Maximum number of leaves integrated into a tree in a single sequencing pass (Trillian default 1000).
+	//+optional
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=100000
+	BatchSize *int32 `json:"batchSize,omitempty"`
+	// Time between sequencing passes through all trees (Trillian default 100ms).
+	//+optional
+	//+kubebuilder:validation:XValidation:rule="duration(self) >= duration('10ms') && duration(self) <= duration('1m')",message=Interval must be between 10ms and 1m
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Number of sequencer workers running in parallel (Trillian default 10).
+	//+optional
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:Maximum=100
+	NumSequencers *int32 `json:"numSequencers,omitempty"`
+	// Time elapsed before submitted leaves are eligible for sequencing (Trillian default 0s).
+	//+optional
+	//+kubebuilder:validation:XValidation:rule="duration(self) >= duration('0s') && duration(self) <= duration('1h')",message=Guard window must be between 0s and 1h
+	GuardWindow *metav1.Duration `json:"guardWindow,omitempty"`
+}
+
+// TrillianQuota configures the quota system of the log server and log signer.
+type TrillianQuota struct {
+	// If false, the quota is not enforced and leaves are never rejected.
+	//+kubebuilder:default:=true
+	Enabled *bool `json:"enabled,omitempty"`
+	// Maximum number of unsequenced leaves before new leaves are rejected (Trillian default 500000).
+	//+optional
+	//+kubebuilder:validation:Minimum=1000
+	//+kubebuilder:validation:Maximum=100000000
+	MaxUnsequencedRows *int32 `json:"maxUnsequencedRows,omitempty"`
+}
 
 // +kubebuilder:validation:XValidation:rule="!has(self.create) || self.create != true || !has(self.provider) || self.provider == 'mysql'",message="When database is managed by the operator (create=true) provider must be mysql"
+// +kubebuilder:validation:XValidation:rule="!has(self.replicas) || self.replicas <= 1",message="the managed database runs a single replica"
 type TrillianDB struct {
+	// Scheduling and resources of the managed database. The database keeps its data on a ReadWriteOnce
+	// volume and runs a single replica.
+	PodRequirements `json:",inline"`
 	// Create Database if a database is not created one must be defined using the DatabaseSecret field
 	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
 	Create *bool `json:"create,omitempty"`
@@ -95,6 +148,11 @@ type TrillianStatus struct {
 	Db        TrillianDBStatus      `json:"database,omitempty"`
 	LogServer TrillianServiceStatus `json:"server,omitempty"`
 	LogSigner TrillianServiceStatus `json:"signer,omitempty"`
+	// Validity of the certificates held by the component.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Certificates []CertificateStatus `json:"certificates,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"math"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -187,6 +188,40 @@ var _ = Describe("Trillian", func() {
 					Expect(k8sClient.Create(context.Background(), validObject)).To(Succeed())
 				})
 			})
+
+			When("sequencer", func() {
+				It("valid", func() {
+					validObject := generateMinimalTrillian("sequencer-valid")
+					validObject.Spec.LogSigner.Sequencer = &TrillianSequencer{
+						BatchSize:     ptr.To(int32(5000)),
+						Interval:      &metav1.Duration{Duration: 50 * time.Millisecond},
+						NumSequencers: ptr.To(int32(20)),
+						GuardWindow:   &metav1.Duration{Duration: time.Second},
+					}
+					Expect(k8sClient.Create(context.Background(), validObject)).To(Succeed())
+				})
+
+				It("batch size out of range", func() {
+					invalidObject := generateMinimalTrillian("sequencer-batch-size")
+					invalidObject.Spec.LogSigner.Sequencer = &TrillianSequencer{BatchSize: ptr.To(int32(0))}
+					Expect(k8sClient.Create(context.Background(), invalidObject)).
+						To(MatchError(ContainSubstring("spec.signer.sequencer.batchSize in body should be greater than or equal to 1")))
+				})
+
+				It("interval out of range", func() {
+					invalidObject := generateMinimalTrillian("sequencer-interval")
+					invalidObject.Spec.LogSigner.Sequencer = &TrillianSequencer{Interval: &metav1.Duration{Duration: time.Millisecond}}
+					Expect(k8sClient.Create(context.Background(), invalidObject)).
+						To(MatchError(ContainSubstring("Interval must be between 10ms and 1m")))
+				})
+			})
+
+			It("quota max unsequenced rows out of range", func() {
+				invalidObject := generateMinimalTrillian("quota-rows")
+				invalidObject.Spec.Quota = &TrillianQuota{MaxUnsequencedRows: ptr.To(int32(10))}
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("spec.quota.maxUnsequencedRows in body should be greater than or equal to 1000")))
+			})
 		})
 
 		Context("CR is fully populated", func() {
@@ -0,0 +1,13 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *TrillianSpec) validate(v *specValidator, path *field.Path) {
+	s.Db.TLS.validate(v, path.Child("database", "tls"))
+	s.LogServer.TLS.validate(v, path.Child("server", "tls"))
+	s.LogSigner.TLS.validate(v, path.Child("signer", "tls"))
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+	v.auth(path.Child("auth"), s.Auth)
+}
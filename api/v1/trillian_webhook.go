@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type TrillianDefaulter struct{}
 
+// TrillianValidator performs the semantic checks of the Trillian spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type TrillianValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-trillian,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=trillians,verbs=create;update,versions=v1,name=mtrillian.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-trillian,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=trillians,verbs=create;update,versions=v1,name=vtrillian.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupTrillianWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &Trillian{}).
 		WithDefaulter(&TrillianDefaulter{}).
+		WithValidator(&TrillianValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,23 @@ func (d *TrillianDefaulter) Default(ctx context.Context, obj *Trillian) error {
 	obj.Spec.SetDefaults()
 	return nil
 }
+
+func (v *TrillianValidator) ValidateCreate(ctx context.Context, obj *Trillian) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Trillian", obj.Name)
+}
+
+func (v *TrillianValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *Trillian) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Trillian", newObj.Name)
+}
+
+func (v *TrillianValidator) ValidateDelete(context.Context, *Trillian) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrillianTreeType is the type of Merkle tree.
+// +kubebuilder:validation:Enum:=LOG;PREORDERED_LOG
+type TrillianTreeType string
+
+const (
+	// TrillianTreeTypeLog is a tree of a log, the leaves are sequenced by Trillian.
+	TrillianTreeTypeLog TrillianTreeType = "LOG"
+	// TrillianTreeTypePreorderedLog is a tree of a log mirror, the leaves are sequenced by the client.
+	TrillianTreeTypePreorderedLog TrillianTreeType = "PREORDERED_LOG"
+)
+
+// TrillianTreeState is the state of Merkle tree.
+// +kubebuilder:validation:Enum:=ACTIVE;FROZEN;DRAINING
+type TrillianTreeState string
+
+const (
+	// TrillianTreeStateActive accepts new leaves.
+	TrillianTreeStateActive TrillianTreeState = "ACTIVE"
+	// TrillianTreeStateFrozen is read-only.
+	TrillianTreeStateFrozen TrillianTreeState = "FROZEN"
+	// TrillianTreeStateDraining integrates the queued leaves but does not accept new ones. Move to FROZEN once drained.
+	TrillianTreeStateDraining TrillianTreeState = "DRAINING"
+)
+
+// TrillianTreeDeletionPolicy defines what happens with Merkle tree when the TrillianTree is deleted.
+// +kubebuilder:validation:Enum:=Retain;Delete
+type TrillianTreeDeletionPolicy string
+
+const (
+	// TrillianTreeRetain keeps the tree in Trillian.
+	TrillianTreeRetain TrillianTreeDeletionPolicy = "Retain"
+	// TrillianTreeDelete (soft) deletes the tree in Trillian.
+	TrillianTreeDelete TrillianTreeDeletionPolicy = "Delete"
+)
+
+// TrillianTreeSpec defines the desired state of Merkle tree in the Trillian backend
+type TrillianTreeSpec struct {
+	// Trillian service configuration
+	Trillian ServiceReference `json:"trillian,omitempty"`
+	// ID of an existing Merkle tree to manage.
+	// If it is unset, the operator will create new Merkle tree in the Trillian backend
+	//+optional
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	TreeID *int64 `json:"treeID,omitempty"`
+	// Human-readable name of the tree. Defaults to the resource name truncated to 20 characters.
+	//+optional
+	//+kubebuilder:validation:MaxLength=20
+	DisplayName string `json:"displayName,omitempty"`
+	// Type of the tree. A PREORDERED_LOG tree can be changed to LOG only while it is FROZEN.
+	//+kubebuilder:default:=LOG
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf || (oldSelf == 'PREORDERED_LOG' && self == 'LOG')),message=Only PREORDERED_LOG tree can be changed to LOG
+	TreeType TrillianTreeType `json:"treeType,omitempty"`
+	// Desired state of the tree.
+	//+kubebuilder:default:=ACTIVE
+	State TrillianTreeState `json:"state,omitempty"`
+	// Interval after which a new signed root is produced even if there are no new leaves. Zero disables the periodic signing.
+	//+kubebuilder:default:="1h"
+	MaxRootDuration *metav1.Duration `json:"maxRootDuration,omitempty"`
+	// Whether the tree is deleted in Trillian when the TrillianTree is deleted.
+	//+kubebuilder:default:=Retain
+	DeletionPolicy TrillianTreeDeletionPolicy `json:"deletionPolicy,omitempty"`
+	// ConfigMap with additional bundle of trusted CA
+	//+optional
+	TrustedCA *LocalObjectReference `json:"trustedCA,omitempty"`
+}
+
+// TrillianTreeStatus defines the observed state of Merkle tree in the Trillian backend
+type TrillianTreeStatus struct {
+	// ID of the managed Merkle tree.
+	TreeID *int64 `json:"treeID,omitempty"`
+	// Current state of the tree.
+	State TrillianTreeState `json:"state,omitempty"`
+	// Number of leaves in the latest signed log root.
+	Size *int64 `json:"size,omitempty"`
+	// Hex-encoded root hash of the latest signed log root.
+	RootHash string `json:"rootHash,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Tree ID",type=string,JSONPath=`.status.treeID`,description="The ID of the Merkle tree"
+//+kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`,description="The state of the Merkle tree"
+//+kubebuilder:printcolumn:name="Size",type=integer,JSONPath=`.status.size`,description="The number of leaves in the Merkle tree"
+//+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="The component status"
+
+// TrillianTree is the Schema for the trilliantrees API
+type TrillianTree struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrillianTreeSpec   `json:"spec,omitempty"`
+	Status TrillianTreeStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TrillianTreeList contains a list of TrillianTree
+type TrillianTreeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrillianTree `json:"items"`
+}
+
+func (i *TrillianTree) GetConditions() []metav1.Condition {
+	return i.Status.Conditions
+}
+
+func (i *TrillianTree) SetCondition(newCondition metav1.Condition) {
+	meta.SetStatusCondition(&i.Status.Conditions, newCondition)
+}
+
+func (i *TrillianTree) GetTrustedCA() *LocalObjectReference {
+	if i.Spec.TrustedCA != nil {
+		return i.Spec.TrustedCA
+	}
+
+	if v, ok := i.GetAnnotations()["rhtas.redhat.com/trusted-ca"]; ok {
+		return &LocalObjectReference{
+			Name: v,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *TrillianTreeSpec) validate(v *specValidator, path *field.Path) {
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+}
+
+func (s *TrillianTreeSpec) validateCreate(v *specValidator, path *field.Path) {
+	if s.DeletionPolicy == TrillianTreeDelete {
+		v.warn(path.Child("deletionPolicy"), "the Merkle tree and its entries are deleted from Trillian together with the TrillianTree")
+	}
+}
+
+func (s *TrillianTreeSpec) validateUpdate(v *specValidator, path *field.Path, old *TrillianTreeSpec) {
+	if s.DeletionPolicy == TrillianTreeDelete && old.DeletionPolicy != TrillianTreeDelete {
+		v.warn(path.Child("deletionPolicy"), "the Merkle tree and its entries are deleted from Trillian together with the TrillianTree")
+	}
+	if s.State != old.State {
+		switch s.State {
+		case TrillianTreeStateFrozen:
+			v.warn(path.Child("state"), "the tree stops accepting new entries, the logs using it become read-only")
+		case TrillianTreeStateDraining:
+			v.warn(path.Child("state"), "the tree stops accepting new entries, the queued entries are still integrated")
+		}
+	}
+	if s.TreeType != old.TreeType {
+		v.warn(path.Child("treeType"), "the tree can't be changed back to %s", old.TreeType)
+	}
+}
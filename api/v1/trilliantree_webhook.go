@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// TrillianTreeValidator warns about the TrillianTree changes that affect the data of the Merkle tree.
+// +kubebuilder:object:generate=false
+type TrillianTreeValidator struct {
+	reader client.Reader
+}
+
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-trilliantree,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=trilliantrees,verbs=create;update,versions=v1,name=vtrilliantree.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+
+func SetupTrillianTreeWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &TrillianTree{}).
+		WithValidator(&TrillianTreeValidator{reader: mgr.GetAPIReader()}).
+		Complete()
+}
+
+func (v *TrillianTreeValidator) ValidateCreate(ctx context.Context, obj *TrillianTree) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	obj.Spec.validateCreate(sv, field.NewPath("spec"))
+	return sv.result("TrillianTree", obj.Name)
+}
+
+func (v *TrillianTreeValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *TrillianTree) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	newObj.Spec.validateUpdate(sv, field.NewPath("spec"), &oldObj.Spec)
+	return sv.result("TrillianTree", newObj.Name)
+}
+
+func (v *TrillianTreeValidator) ValidateDelete(context.Context, *TrillianTree) (admission.Warnings, error) {
+	return nil, nil
+}
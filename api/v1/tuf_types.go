@@ -42,6 +42,9 @@ type TufSpec struct {
 	// Pvc configuration of the persistent storage claim for deployment in the cluster.
 	// You can use ReadWriteOnce accessMode if you don't have suitable storage provider but your deployment will not support HA mode
 	Pvc Pvc `json:"pvc,omitempty"`
+	// NetworkPolicy configuration
+	//+optional
+	NetworkPolicy NetworkPolicyConfig `json:"networkPolicy,omitempty"`
 	// Ctlog service and trust material binding.
 	//+optional
 	// At most one entry is allowed today; this ceiling is deliberate and temporary,
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (s *TufSpec) validate(v *specValidator, path *field.Path) {
+	validateTrustRootBindings(v, path.Child("ctlog"), s.Ctlog)
+	validateTrustRootBindings(v, path.Child("rekor"), s.Rekor)
+	if s.Tsa != nil {
+		validateTrustRootBindings(v, path.Child("tsa"), *s.Tsa)
+	}
+	for i := range s.Fulcio {
+		v.cryptoMaterial(path.Child("fulcio").Index(i).Child("secretRef"), s.Fulcio[i].SecretRef)
+	}
+	v.configMap(path.Child("trustedCA"), s.TrustedCA)
+}
+
+func validateTrustRootBindings(v *specValidator, path *field.Path, bindings []TrustRootBinding) {
+	for i := range bindings {
+		v.cryptoMaterial(path.Index(i).Child("secretRef"), bindings[i].SecretRef)
+	}
+}
@@ -3,17 +3,29 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type TufDefaulter struct{}
 
+// TufValidator performs the semantic checks of the Tuf spec that can't be expressed in CEL.
+// +kubebuilder:object:generate=false
+type TufValidator struct {
+	reader client.Reader
+}
+
 //+kubebuilder:webhook:path=/mutate-rhtas-redhat-com-v1-tuf,mutating=true,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=tufs,verbs=create;update,versions=v1,name=mtuf.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
+//+kubebuilder:webhook:path=/validate-rhtas-redhat-com-v1-tuf,mutating=false,failurePolicy=fail,sideEffects=None,groups=rhtas.redhat.com,resources=tufs,verbs=create;update,versions=v1,name=vtuf.rhtas.redhat.com,admissionReviewVersions=v1,matchPolicy=Equivalent
 
 func SetupTufWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr, &Tuf{}).
 		WithDefaulter(&TufDefaulter{}).
+		WithValidator(&TufValidator{reader: mgr.GetAPIReader()}).
 		Complete()
 }
 
@@ -22,3 +34,23 @@ func (d *TufDefaulter) Default(ctx context.Context, obj *Tuf) error {
 	obj.Spec.SetDefaults()
 	return nil
 }
+
+func (v *TufValidator) ValidateCreate(ctx context.Context, obj *Tuf) (admission.Warnings, error) {
+	sv := newSpecValidator(ctx, v.reader, obj.Namespace)
+	obj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Tuf", obj.Name)
+}
+
+func (v *TufValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *Tuf) (admission.Warnings, error) {
+	// metadata updates must pass, e.g. removing the finalizers of an object with invalid references
+	if equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec) {
+		return nil, nil
+	}
+	sv := newSpecValidator(ctx, v.reader, newObj.Namespace)
+	newObj.Spec.validate(sv, field.NewPath("spec"))
+	return sv.result("Tuf", newObj.Name)
+}
+
+func (v *TufValidator) ValidateDelete(context.Context, *Tuf) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,436 @@
+package v1
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	fipsutil "github.com/securesign/operator/internal/utils/fips"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func newTestCertificate(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func newTestPublicKey(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func testSecret(name string, data map[string][]byte) *core.Secret {
+	return &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Data:       data,
+	}
+}
+
+func testReader(objects ...client.Object) client.Reader {
+	return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+}
+
+func selector(name, key string) *SecretKeySelector {
+	return &SecretKeySelector{LocalObjectReference: LocalObjectReference{Name: name}, Key: key}
+}
+
+func testFulcio() *Fulcio {
+	return &Fulcio{
+		ObjectMeta: metav1.ObjectMeta{Name: "fulcio", Namespace: "default"},
+		Spec: FulcioSpec{
+			Config: FulcioConfig{
+				OIDCIssuers: []OIDCIssuer{
+					{Issuer: "https://gitlab.com", ClientID: "sigstore", Type: "ci-provider", CIProvider: "gitlab-pipeline"},
+				},
+				CIIssuerMetadata: []CIIssuerMetadata{
+					{IssuerName: "gitlab-pipeline", SubjectAlternativeNameTemplate: "https://{{ .ci_config_ref_uri }}"},
+				},
+			},
+			Signer: FulcioSigner{
+				Type: FulcioSignerTypeFile,
+				CertificateChain: FulcioCertificateChain{
+					CertificateChainRef: selector("fulcio-ca", "cert"),
+				},
+				File: &FulcioFile{PrivateKeyRef: selector("fulcio-ca", "key")},
+			},
+		},
+	}
+}
+
+func TestFulcioValidator_CIProvider(t *testing.T) {
+	g := NewWithT(t)
+	v := &FulcioValidator{}
+
+	instance := testFulcio()
+	instance.Spec.Signer = FulcioSigner{}
+	_, err := v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	instance.Spec.Config.MetaIssuers = []OIDCIssuer{
+		{Issuer: "https://*.example.com", ClientID: "sigstore", Type: "email", CIProvider: "github-workflow"},
+	}
+	instance.Spec.Config.OIDCIssuers[0].CIProvider = ""
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err).To(MatchError(ContainSubstring("spec.config.metaIssuers[0].ciProvider")))
+	g.Expect(err).To(MatchError(ContainSubstring("spec.config.oidcIssuers[0].ciProvider: Required value")))
+}
+
+func TestFulcioValidator_MetaIssuerPattern(t *testing.T) {
+	g := NewWithT(t)
+	instance := testFulcio()
+	instance.Spec.Signer = FulcioSigner{}
+	instance.Spec.Config.MetaIssuers = []OIDCIssuer{
+		{Issuer: "https://oidc.eks.*.amazonaws.com/id/*", ClientID: "sigstore", Type: "kubernetes"},
+		{Issuer: "oidc.*.example.com", ClientID: "sigstore", Type: "kubernetes"},
+	}
+
+	_, err := (&FulcioValidator{}).ValidateCreate(t.Context(), instance)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err).ToNot(MatchError(ContainSubstring("spec.config.metaIssuers[0].issuer")))
+	g.Expect(err).To(MatchError(ContainSubstring("spec.config.metaIssuers[1].issuer")))
+}
+
+func TestFulcioValidator_Templates(t *testing.T) {
+	g := NewWithT(t)
+	instance := testFulcio()
+	instance.Spec.Signer = FulcioSigner{}
+	instance.Spec.Config.CIIssuerMetadata[0].ExtensionTemplates.BuildSignerURI = "{{ .url }"
+	instance.Spec.Config.CIIssuerMetadata[0].SubjectAlternativeNameTemplate = "{{ if .ref }}"
+
+	_, err := (&FulcioValidator{}).ValidateCreate(t.Context(), instance)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err).To(MatchError(ContainSubstring("spec.config.ciIssuerMetadata[0].extensionTemplates.buildSignerURI")))
+	g.Expect(err).To(MatchError(ContainSubstring("spec.config.ciIssuerMetadata[0].subjectAlternativeNameTemplate")))
+}
+
+func TestFulcioValidator_KeyPair(t *testing.T) {
+	key, keyPEM := newTestKey(t)
+	other, _ := newTestKey(t)
+
+	tests := []struct {
+		name   string
+		data   map[string][]byte
+		verify func(Gomega, []string, error)
+	}{
+		{
+			name: "matching key",
+			data: map[string][]byte{"key": keyPEM, "cert": newTestCertificate(t, key)},
+			verify: func(g Gomega, warnings []string, err error) {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+			},
+		},
+		{
+			name: "key of different certificate",
+			data: map[string][]byte{"key": keyPEM, "cert": newTestCertificate(t, other)},
+			verify: func(g Gomega, _ []string, err error) {
+				g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+				g.Expect(err).To(MatchError(ContainSubstring("private key does not match the public key of certificate")))
+			},
+		},
+		{
+			name: "invalid key",
+			data: map[string][]byte{"key": []byte("key"), "cert": newTestCertificate(t, key)},
+			verify: func(g Gomega, _ []string, err error) {
+				g.Expect(err).To(MatchError(ContainSubstring("spec.signer.file.privateKeyRef")))
+			},
+		},
+		{
+			name: "missing key",
+			data: map[string][]byte{"cert": newTestCertificate(t, key)},
+			verify: func(g Gomega, warnings []string, err error) {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(warnings).To(ConsistOf(`spec.signer.file.privateKeyRef: key "key" not found in secret "fulcio-ca"`))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			v := &FulcioValidator{reader: testReader(testSecret("fulcio-ca", tt.data))}
+			warnings, err := v.ValidateCreate(t.Context(), testFulcio())
+			tt.verify(g, warnings, err)
+		})
+	}
+}
+
+func TestFulcioValidator_MissingSecret(t *testing.T) {
+	g := NewWithT(t)
+	v := &FulcioValidator{reader: testReader()}
+
+	warnings, err := v.ValidateCreate(t.Context(), testFulcio())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(ConsistOf(
+		`spec.signer.file.privateKeyRef: secret "fulcio-ca" not found`,
+		`spec.signer.certificateChain.certificateChainRef: secret "fulcio-ca" not found`,
+	))
+}
+
+func TestFulcioValidator_IntermediateRenewal(t *testing.T) {
+	g := NewWithT(t)
+	instance := testFulcio()
+	instance.Spec.Signer = FulcioSigner{
+		CertificateChain: FulcioCertificateChain{
+			OrganizationName: "RH",
+			IntermediateCA:   &FulcioIntermediateCA{Renewal: CertificateRenewal{Enabled: ptr.To(true)}},
+		},
+	}
+
+	warnings, err := (&FulcioValidator{}).ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(ConsistOf(ContainSubstring("spec.signer.certificateChain.intermediateCA.renewal.enabled")))
+
+	instance.Spec.Signer.CertificateChain.IntermediateCA.StoreRootPrivateKey = ptr.To(true)
+	warnings, err = (&FulcioValidator{}).ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+}
+
+func TestFulcioValidator_UpdateWithoutSpecChange(t *testing.T) {
+	g := NewWithT(t)
+	v := &FulcioValidator{reader: testReader(testSecret("fulcio-ca", map[string][]byte{"key": []byte("invalid")}))}
+
+	instance := testFulcio()
+	_, err := v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).To(HaveOccurred())
+
+	updated := instance.DeepCopy()
+	updated.Finalizers = nil
+	warnings, err := v.ValidateUpdate(t.Context(), instance, updated)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+}
+
+func TestFulcioValidator_FIPS(t *testing.T) {
+	original := fipsutil.Enabled
+	fipsutil.Enabled = func() bool { return true }
+	t.Cleanup(func() { fipsutil.Enabled = original })
+
+	g := NewWithT(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024) //nolint:gosec
+	g.Expect(err).ToNot(HaveOccurred())
+	v := &FulcioValidator{reader: testReader(testSecret("fulcio-ca", map[string][]byte{
+		"key": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+	}))}
+
+	instance := testFulcio()
+	instance.Spec.Signer.File.PrivateKeyPasswordRef = selector("fulcio-ca", "password") //nolint:staticcheck
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).To(MatchError(ContainSubstring("spec.signer.file.privateKeyPasswordRef: Forbidden")))
+
+	instance.Spec.Signer.File.PrivateKeyPasswordRef = nil //nolint:staticcheck
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).To(MatchError(ContainSubstring("spec.signer.file.privateKeyRef")))
+}
+
+func TestRekorValidator_Sharding(t *testing.T) {
+	g := NewWithT(t)
+	key, _ := newTestKey(t)
+	v := &RekorValidator{}
+
+	instance := &Rekor{
+		ObjectMeta: metav1.ObjectMeta{Name: "rekor", Namespace: "default"},
+		Spec: RekorSpec{
+			TreeID: ptr.To(int64(2)),
+			Sharding: []RekorLogRange{
+				{TreeID: 1, TreeLength: 10, EncodedPublicKey: base64.StdEncoding.EncodeToString(newTestPublicKey(t, key))},
+			},
+		},
+	}
+	_, err := v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	instance.Spec.Sharding = append(instance.Spec.Sharding,
+		RekorLogRange{TreeID: 2, EncodedPublicKey: base64.StdEncoding.EncodeToString([]byte("invalid"))},
+		RekorLogRange{TreeID: 1},
+	)
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	g.Expect(err).To(MatchError(ContainSubstring("spec.sharding[1].treeID")))
+	g.Expect(err).To(MatchError(ContainSubstring("spec.sharding[1].encodedPublicKey")))
+	g.Expect(err).To(MatchError(ContainSubstring("spec.sharding[2].treeID: Duplicate value")))
+}
+
+func TestRekorValidator_TreeChange(t *testing.T) {
+	g := NewWithT(t)
+	v := &RekorValidator{}
+
+	old := &Rekor{
+		ObjectMeta: metav1.ObjectMeta{Name: "rekor", Namespace: "default"},
+		Spec:       RekorSpec{TreeID: ptr.To(int64(1))},
+	}
+	updated := old.DeepCopy()
+	updated.Spec.TreeID = ptr.To(int64(2))
+
+	warnings, err := v.ValidateUpdate(t.Context(), old, updated)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(ConsistOf(ContainSubstring("the previous tree 1 is not listed in spec.sharding")))
+
+	updated.Spec.Sharding = []RekorLogRange{{TreeID: 1, TreeLength: 10}}
+	warnings, err = v.ValidateUpdate(t.Context(), old, updated)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+}
+
+func TestCTlogValidator_PublicKey(t *testing.T) {
+	g := NewWithT(t)
+	key, keyPEM := newTestKey(t)
+	other, _ := newTestKey(t)
+
+	instance := &CTlog{
+		ObjectMeta: metav1.ObjectMeta{Name: "ctlog", Namespace: "default"},
+		Spec: CTlogSpec{
+			Signer: CTlogSigner{
+				File: &CTlogFile{
+					PrivateKeyRef: selector("ctlog-keys", "private"),
+					PublicKeyRef:  selector("ctlog-keys", "public"),
+				},
+			},
+		},
+	}
+
+	v := &CTlogValidator{reader: testReader(testSecret("ctlog-keys", map[string][]byte{
+		"private": keyPEM,
+		"public":  newTestPublicKey(t, key),
+	}))}
+	_, err := v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	v = &CTlogValidator{reader: testReader(testSecret("ctlog-keys", map[string][]byte{
+		"private": keyPEM,
+		"public":  newTestPublicKey(t, other),
+	}))}
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).To(MatchError(ContainSubstring("spec.signer.file.publicKeyRef")))
+}
+
+func TestTimestampAuthorityValidator_KeyPair(t *testing.T) {
+	g := NewWithT(t)
+	key, keyPEM := newTestKey(t)
+	other, _ := newTestKey(t)
+
+	instance := &TimestampAuthority{
+		ObjectMeta: metav1.ObjectMeta{Name: "tsa", Namespace: "default"},
+		Spec: TimestampAuthoritySpec{
+			Signer: TimestampAuthoritySigner{
+				CertificateChain: CertificateChain{CertificateChainRef: selector("tsa", "chain")},
+				File:             &File{PrivateKeyRef: selector("tsa", "key")},
+			},
+		},
+	}
+
+	// the private key must match the first (leaf) certificate of the chain
+	chain := append(newTestCertificate(t, other), newTestCertificate(t, key)...)
+	v := &TimestampAuthorityValidator{reader: testReader(testSecret("tsa", map[string][]byte{"key": keyPEM, "chain": chain}))}
+	_, err := v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).To(MatchError(ContainSubstring("spec.signer.file.privateKeyRef")))
+
+	chain = append(newTestCertificate(t, key), newTestCertificate(t, other)...)
+	v = &TimestampAuthorityValidator{reader: testReader(testSecret("tsa", map[string][]byte{"key": keyPEM, "chain": chain}))}
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestSecuresignValidator_DelegatesToComponents(t *testing.T) {
+	g := NewWithT(t)
+	v := &SecuresignValidator{reader: testReader()}
+
+	instance := &Securesign{
+		ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"},
+		Spec: SecuresignSpec{
+			Fulcio: testFulcio().Spec,
+			Rekor: RekorSpec{
+				TreeID:   ptr.To(int64(1)),
+				Sharding: []RekorLogRange{{TreeID: 1}},
+			},
+		},
+	}
+	warnings, err := v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).To(MatchError(ContainSubstring("spec.rekor.sharding[0].treeID")))
+	g.Expect(warnings).To(ContainElement(`spec.fulcio.signer.file.privateKeyRef: secret "fulcio-ca" not found`))
+
+	// Rekor v1 is not deployed with the v2 version
+	instance.Spec.RekorVersion = RekorVersionV2
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// the console is not wired to Rekor v2
+	instance.Spec.Console = &ConsoleSpec{}
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).To(MatchError(ContainSubstring("spec.console.ui.rekor")))
+	instance.Spec.Console.UI.Rekor.URL = "https://rekor.example.com"
+	_, err = v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestTrillianTreeValidator_Warnings(t *testing.T) {
+	g := NewWithT(t)
+	v := &TrillianTreeValidator{}
+
+	instance := &TrillianTree{
+		ObjectMeta: metav1.ObjectMeta{Name: "tree", Namespace: "default"},
+		Spec: TrillianTreeSpec{
+			TreeType:       TrillianTreeTypeLog,
+			State:          TrillianTreeStateActive,
+			DeletionPolicy: TrillianTreeRetain,
+		},
+	}
+	warnings, err := v.ValidateCreate(t.Context(), instance)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(BeEmpty())
+
+	updated := instance.DeepCopy()
+	updated.Spec.DeletionPolicy = TrillianTreeDelete
+	updated.Spec.State = TrillianTreeStateFrozen
+	warnings, err = v.ValidateUpdate(t.Context(), instance, updated)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(warnings).To(ConsistOf(
+		ContainSubstring("spec.deletionPolicy"),
+		ContainSubstring("spec.state"),
+	))
+}
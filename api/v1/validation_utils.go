@@ -0,0 +1,282 @@
+package v1
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	fipsutil "github.com/securesign/operator/internal/utils/fips"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// specValidator collects the errors and warnings of the semantic checks that can't be expressed in CEL.
+// A missing Secret or ConfigMap is reported as a warning only: the operator waits for it and GitOps tools
+// may apply it after the custom resource. Existing but invalid content is an error.
+// +kubebuilder:object:generate=false
+type specValidator struct {
+	ctx       context.Context
+	reader    client.Reader
+	namespace string
+	errs      field.ErrorList
+	warnings  admission.Warnings
+}
+
+func newSpecValidator(ctx context.Context, reader client.Reader, namespace string) *specValidator {
+	return &specValidator{ctx: ctx, reader: reader, namespace: namespace}
+}
+
+func (v *specValidator) warn(path *field.Path, format string, args ...any) {
+	v.warnings = append(v.warnings, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (v *specValidator) invalid(path *field.Path, value any, detail string) {
+	v.errs = append(v.errs, field.Invalid(path, value, detail))
+}
+
+// result returns the collected warnings and an Invalid error when any check failed.
+func (v *specValidator) result(kind string, name string) (admission.Warnings, error) {
+	if len(v.errs) == 0 {
+		return v.warnings, nil
+	}
+	return v.warnings, apierrors.NewInvalid(GroupVersion.WithKind(kind).GroupKind(), name, v.errs)
+}
+
+// secretKey returns the value of the referenced key, nil when it can't be read.
+func (v *specValidator) secretKey(path *field.Path, ref *SecretKeySelector) []byte {
+	if ref == nil || v.reader == nil {
+		return nil
+	}
+	secret := &core.Secret{}
+	if !v.get(path, "secret", ref.Name, secret) {
+		return nil
+	}
+	data, ok := secret.Data[ref.Key]
+	if !ok {
+		v.warn(path, "key %q not found in secret %q", ref.Key, ref.Name)
+		return nil
+	}
+	return data
+}
+
+func (v *specValidator) secret(path *field.Path, ref *LocalObjectReference) {
+	if ref == nil || v.reader == nil {
+		return
+	}
+	v.get(path, "secret", ref.Name, &core.Secret{})
+}
+
+func (v *specValidator) configMap(path *field.Path, ref *LocalObjectReference) {
+	if ref == nil || v.reader == nil {
+		return
+	}
+	v.get(path, "config map", ref.Name, &core.ConfigMap{})
+}
+
+func (v *specValidator) get(path *field.Path, kind string, name string, obj client.Object) bool {
+	err := v.reader.Get(v.ctx, client.ObjectKey{Namespace: v.namespace, Name: name}, obj)
+	switch {
+	case err == nil:
+		return true
+	case apierrors.IsNotFound(err):
+		v.warn(path, "%s %q not found", kind, name)
+	default:
+		v.warn(path, "can't read %s %q: %v", kind, name, err)
+	}
+	return false
+}
+
+func (v *specValidator) auth(path *field.Path, auth *Auth) {
+	if auth == nil {
+		return
+	}
+	for i := range auth.SecretMount {
+		v.secretKey(path.Child("secretMount").Index(i), &auth.SecretMount[i])
+	}
+}
+
+// privateKey validates the referenced PEM private key. A password protected or encrypted key is only
+// checked for presence, it is returned as nil as well as a missing or invalid key.
+func (v *specValidator) privateKey(path *field.Path, ref *SecretKeySelector, passwordPath *field.Path, passwordRef *SecretKeySelector) crypto.Signer {
+	data := v.secretKey(path, ref)
+	if passwordRef != nil {
+		if fipsutil.Enabled() {
+			v.errs = append(v.errs, field.Forbidden(passwordPath, fipsutil.ErrPasswordRefInFIPS.Error()))
+		}
+		v.secretKey(passwordPath, passwordRef)
+		return nil
+	}
+	if data == nil || isEncryptedPEM(data) {
+		return nil
+	}
+	if fipsutil.Enabled() {
+		if err := fipsutil.ValidatePrivateKeyPEM(data); err != nil {
+			v.invalid(path, ref.Name, err.Error())
+			return nil
+		}
+	}
+	key, err := parsePrivateKeyPEM(data)
+	if err != nil {
+		v.invalid(path, ref.Name, err.Error())
+		return nil
+	}
+	return key
+}
+
+// publicKey validates the PEM public key.
+func (v *specValidator) publicKey(path *field.Path, value string, data []byte) crypto.PublicKey {
+	if data == nil {
+		return nil
+	}
+	if fipsutil.Enabled() {
+		if err := fipsutil.ValidatePublicKeyPEM(data); err != nil {
+			v.invalid(path, value, err.Error())
+			return nil
+		}
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		v.invalid(path, value, "no PEM block found in public key data")
+		return nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		v.invalid(path, value, fmt.Sprintf("invalid public key: %v", err))
+		return nil
+	}
+	return key
+}
+
+// certificateChain validates the referenced PEM certificate chain and returns its first certificate.
+func (v *specValidator) certificateChain(path *field.Path, ref *SecretKeySelector) *x509.Certificate {
+	data := v.secretKey(path, ref)
+	if data == nil {
+		return nil
+	}
+	if fipsutil.Enabled() {
+		if err := fipsutil.ValidateCertificateChainPEM(data); err != nil {
+			v.invalid(path, ref.Name, err.Error())
+			return nil
+		}
+	}
+	var first *x509.Certificate
+	for rest := data; ; {
+		var block *pem.Block
+		if block, rest = pem.Decode(rest); block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			v.invalid(path, ref.Name, fmt.Sprintf("invalid certificate: %v", err))
+			return nil
+		}
+		if first == nil {
+			first = cert
+		}
+	}
+	if first == nil {
+		v.invalid(path, ref.Name, "no certificate found in PEM data")
+	}
+	return first
+}
+
+// cryptoMaterial runs the FIPS validation of PEM crypto material, the content is not checked otherwise.
+func (v *specValidator) cryptoMaterial(path *field.Path, ref *SecretKeySelector) {
+	data := v.secretKey(path, ref)
+	if data == nil || !fipsutil.Enabled() {
+		return
+	}
+	if err := fipsutil.ValidateCryptoMaterialPEM(data); err != nil {
+		v.invalid(path, ref.Name, err.Error())
+	}
+}
+
+// keyAlgorithm rejects algorithms of generated keys that are not FIPS-approved.
+func (v *specValidator) keyAlgorithm(path *field.Path, algorithm KeyAlgorithm) {
+	if !fipsutil.Enabled() {
+		return
+	}
+	if err := fipsutil.ValidateKeyAlgorithm(string(algorithm)); err != nil {
+		v.invalid(path, algorithm, err.Error())
+	}
+}
+
+// keyPair checks that the private key belongs to the public key of the certificate.
+func (v *specValidator) keyPair(path *field.Path, ref *SecretKeySelector, key crypto.Signer, cert *x509.Certificate) {
+	if key == nil || cert == nil {
+		return
+	}
+	if !publicKeysEqual(key.Public(), cert.PublicKey) {
+		v.invalid(path, ref.Name, fmt.Sprintf("private key does not match the public key of certificate %q", cert.Subject))
+	}
+}
+
+// publicKeyPair checks that the referenced public key belongs to the private key.
+func (v *specValidator) publicKeyPair(path *field.Path, ref *SecretKeySelector, key crypto.Signer, public crypto.PublicKey) {
+	if key == nil || public == nil {
+		return
+	}
+	if !publicKeysEqual(key.Public(), public) {
+		v.invalid(path, ref.Name, "public key does not match the private key")
+	}
+}
+
+func publicKeysEqual(a, b crypto.PublicKey) bool {
+	k, ok := a.(interface{ Equal(crypto.PublicKey) bool })
+	return ok && k.Equal(b)
+}
+
+// parsePrivateKeyPEM accepts PKCS#8, PKCS#1 (RSA) and SEC 1 (EC) PEM blocks.
+func parsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found in private key data")
+	}
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q of private key", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// isEncryptedPEM detects the legacy RFC 1423 and the PKCS#8 encryption of a PEM private key.
+func isEncryptedPEM(data []byte) bool {
+	block, _ := pem.Decode(data)
+	return block != nil && (strings.Contains(block.Type, "ENCRYPTED") || strings.Contains(block.Headers["Proc-Type"], "ENCRYPTED"))
+}
+
+func secretKeySelectorChanged(oldRef, newRef *SecretKeySelector) bool {
+	switch {
+	case oldRef == nil || newRef == nil:
+		return oldRef != newRef
+	default:
+		return *oldRef != *newRef
+	}
+}
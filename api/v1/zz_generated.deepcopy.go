@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -87,6 +88,31 @@ func (in *AdditionalVolumeSource) DeepCopy() *AdditionalVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertOverride) DeepCopyInto(out *AlertOverride) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.For != nil {
+		in, out := &in.For, &out.For
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertOverride.
+func (in *AlertOverride) DeepCopy() *AlertOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Auth) DeepCopyInto(out *Auth) {
 	*out = *in
@@ -114,6 +140,48 @@ func (in *Auth) DeepCopy() *Auth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaling.
+func (in *Autoscaling) DeepCopy() *Autoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(Autoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackFillRedis) DeepCopyInto(out *BackFillRedis) {
 	*out = *in
@@ -291,6 +359,11 @@ func (in *CTlogSpec) DeepCopyInto(out *CTlogSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.TreeRef != nil {
+		in, out := &in.TreeRef, &out.TreeRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
 	in.Signer.DeepCopyInto(&out.Signer)
 	if in.RootCertificates != nil {
 		in, out := &in.RootCertificates, &out.RootCertificates
@@ -299,6 +372,8 @@ func (in *CTlogSpec) DeepCopyInto(out *CTlogSpec) {
 	}
 	in.Ingress.DeepCopyInto(&out.Ingress)
 	in.Monitoring.DeepCopyInto(&out.Monitoring)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	in.Trillian.DeepCopyInto(&out.Trillian)
 	if in.ServerConfigRef != nil {
 		in, out := &in.ServerConfigRef, &out.ServerConfigRef
@@ -368,6 +443,13 @@ func (in *CTlogStatus) DeepCopyInto(out *CTlogStatus) {
 		**out = **in
 	}
 	in.TLS.DeepCopyInto(&out.TLS)
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -416,6 +498,7 @@ func (in *CertificateChain) DeepCopyInto(out *CertificateChain) {
 		*out = new(TsaCertificateAuthority)
 		**out = **in
 	}
+	in.Renewal.DeepCopyInto(&out.Renewal)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateChain.
@@ -428,6 +511,53 @@ func (in *CertificateChain) DeepCopy() *CertificateChain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRenewal) DeepCopyInto(out *CertificateRenewal) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Overlap != nil {
+		in, out := &in.Overlap, &out.Overlap
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRenewal.
+func (in *CertificateRenewal) DeepCopy() *CertificateRenewal {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRenewal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	in.NotBefore.DeepCopyInto(&out.NotBefore)
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Console) DeepCopyInto(out *Console) {
 	*out = *in
@@ -459,6 +589,7 @@ func (in *Console) DeepCopyObject() runtime.Object {
 func (in *ConsoleAPI) DeepCopyInto(out *ConsoleAPI) {
 	*out = *in
 	in.PodRequirements.DeepCopyInto(&out.PodRequirements)
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	in.Tuf.DeepCopyInto(&out.Tuf)
 	in.TLS.DeepCopyInto(&out.TLS)
 }
@@ -489,6 +620,31 @@ func (in *ConsoleAPIStatus) DeepCopy() *ConsoleAPIStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleAuth) DeepCopyInto(out *ConsoleAuth) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(ConsoleOIDCAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenShift != nil {
+		in, out := &in.OpenShift, &out.OpenShift
+		*out = new(ConsoleOpenShiftAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleAuth.
+func (in *ConsoleAuth) DeepCopy() *ConsoleAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsoleDefaulter) DeepCopyInto(out *ConsoleDefaulter) {
 	*out = *in
@@ -536,17 +692,64 @@ func (in *ConsoleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleOIDCAuth) DeepCopyInto(out *ConsoleOIDCAuth) {
+	*out = *in
+	out.ClientSecretRef = in.ClientSecretRef
+	if in.AllowedGroups != nil {
+		in, out := &in.AllowedGroups, &out.AllowedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOIDCAuth.
+func (in *ConsoleOIDCAuth) DeepCopy() *ConsoleOIDCAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleOIDCAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleOpenShiftAuth) DeepCopyInto(out *ConsoleOpenShiftAuth) {
+	*out = *in
+	if in.SubjectAccessReview != nil {
+		in, out := &in.SubjectAccessReview, &out.SubjectAccessReview
+		*out = new(ConsoleSubjectAccessReview)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleOpenShiftAuth.
+func (in *ConsoleOpenShiftAuth) DeepCopy() *ConsoleOpenShiftAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleOpenShiftAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsoleSpec) DeepCopyInto(out *ConsoleSpec) {
 	*out = *in
 	in.ServiceAccountConfig.DeepCopyInto(&out.ServiceAccountConfig)
 	in.UI.DeepCopyInto(&out.UI)
 	in.Api.DeepCopyInto(&out.Api)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	if in.TrustedCA != nil {
 		in, out := &in.TrustedCA, &out.TrustedCA
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(ConsoleAuth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleSpec.
@@ -564,6 +767,13 @@ func (in *ConsoleStatus) DeepCopyInto(out *ConsoleStatus) {
 	*out = *in
 	in.Api.DeepCopyInto(&out.Api)
 	out.UI = in.UI
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -583,10 +793,26 @@ func (in *ConsoleStatus) DeepCopy() *ConsoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsoleSubjectAccessReview) DeepCopyInto(out *ConsoleSubjectAccessReview) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsoleSubjectAccessReview.
+func (in *ConsoleSubjectAccessReview) DeepCopy() *ConsoleSubjectAccessReview {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsoleSubjectAccessReview)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsoleUI) DeepCopyInto(out *ConsoleUI) {
 	*out = *in
 	in.PodRequirements.DeepCopyInto(&out.PodRequirements)
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	in.Ingress.DeepCopyInto(&out.Ingress)
 	in.Rekor.DeepCopyInto(&out.Rekor)
 }
@@ -741,6 +967,11 @@ func (in *FulcioCertificateChain) DeepCopyInto(out *FulcioCertificateChain) {
 		*out = new(SecretKeySelector)
 		**out = **in
 	}
+	if in.IntermediateCA != nil {
+		in, out := &in.IntermediateCA, &out.IntermediateCA
+		*out = new(FulcioIntermediateCA)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioCertificateChain.
@@ -825,6 +1056,77 @@ func (in *FulcioFile) DeepCopy() *FulcioFile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulcioIntermediateCA) DeepCopyInto(out *FulcioIntermediateCA) {
+	*out = *in
+	if in.RootCertificateRef != nil {
+		in, out := &in.RootCertificateRef, &out.RootCertificateRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.RootPrivateKeyRef != nil {
+		in, out := &in.RootPrivateKeyRef, &out.RootPrivateKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Validity != nil {
+		in, out := &in.Validity, &out.Validity
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxPathLen != nil {
+		in, out := &in.MaxPathLen, &out.MaxPathLen
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NameConstraints != nil {
+		in, out := &in.NameConstraints, &out.NameConstraints
+		*out = new(NameConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Renewal.DeepCopyInto(&out.Renewal)
+	if in.StoreRootPrivateKey != nil {
+		in, out := &in.StoreRootPrivateKey, &out.StoreRootPrivateKey
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioIntermediateCA.
+func (in *FulcioIntermediateCA) DeepCopy() *FulcioIntermediateCA {
+	if in == nil {
+		return nil
+	}
+	out := new(FulcioIntermediateCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulcioIssuerHealthCheck) DeepCopyInto(out *FulcioIssuerHealthCheck) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioIssuerHealthCheck.
+func (in *FulcioIssuerHealthCheck) DeepCopy() *FulcioIssuerHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(FulcioIssuerHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FulcioList) DeepCopyInto(out *FulcioList) {
 	*out = *in
@@ -857,6 +1159,22 @@ func (in *FulcioList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulcioOIDCIssuerStatus) DeepCopyInto(out *FulcioOIDCIssuerStatus) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioOIDCIssuerStatus.
+func (in *FulcioOIDCIssuerStatus) DeepCopy() *FulcioOIDCIssuerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FulcioOIDCIssuerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FulcioSigner) DeepCopyInto(out *FulcioSigner) {
 	*out = *in
@@ -884,10 +1202,13 @@ func (in *FulcioSpec) DeepCopyInto(out *FulcioSpec) {
 	in.PodRequirements.DeepCopyInto(&out.PodRequirements)
 	in.ServiceAccountConfig.DeepCopyInto(&out.ServiceAccountConfig)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.GrpcIngress.DeepCopyInto(&out.GrpcIngress)
 	in.Ctlog.DeepCopyInto(&out.Ctlog)
 	in.Config.DeepCopyInto(&out.Config)
 	in.Signer.DeepCopyInto(&out.Signer)
 	in.Monitoring.DeepCopyInto(&out.Monitoring)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	if in.TrustedCA != nil {
 		in, out := &in.TrustedCA, &out.TrustedCA
 		*out = new(LocalObjectReference)
@@ -899,6 +1220,12 @@ func (in *FulcioSpec) DeepCopyInto(out *FulcioSpec) {
 		*out = new(Auth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TemplatePreview != nil {
+		in, out := &in.TemplatePreview, &out.TemplatePreview
+		*out = new(FulcioTemplatePreview)
+		**out = **in
+	}
+	in.IssuerHealthCheck.DeepCopyInto(&out.IssuerHealthCheck)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioSpec.
@@ -924,6 +1251,25 @@ func (in *FulcioStatus) DeepCopyInto(out *FulcioStatus) {
 		*out = new(FulcioCertStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TemplatePreview != nil {
+		in, out := &in.TemplatePreview, &out.TemplatePreview
+		*out = make([]FulcioTemplatePreviewResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.OIDCIssuers != nil {
+		in, out := &in.OIDCIssuers, &out.OIDCIssuers
+		*out = make([]FulcioOIDCIssuerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -943,6 +1289,38 @@ func (in *FulcioStatus) DeepCopy() *FulcioStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulcioTemplatePreview) DeepCopyInto(out *FulcioTemplatePreview) {
+	*out = *in
+	out.ClaimsRef = in.ClaimsRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioTemplatePreview.
+func (in *FulcioTemplatePreview) DeepCopy() *FulcioTemplatePreview {
+	if in == nil {
+		return nil
+	}
+	out := new(FulcioTemplatePreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulcioTemplatePreviewResult) DeepCopyInto(out *FulcioTemplatePreviewResult) {
+	*out = *in
+	out.Extensions = in.Extensions
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioTemplatePreviewResult.
+func (in *FulcioTemplatePreviewResult) DeepCopy() *FulcioTemplatePreviewResult {
+	if in == nil {
+		return nil
+	}
+	out := new(FulcioTemplatePreviewResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ingress) DeepCopyInto(out *Ingress) {
 	*out = *in
@@ -1086,6 +1464,7 @@ func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
 	*out = *in
 	in.Metrics.DeepCopyInto(&out.Metrics)
 	in.ServiceMonitor.DeepCopyInto(&out.ServiceMonitor)
+	in.PrometheusRule.DeepCopyInto(&out.PrometheusRule)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitoringConfig.
@@ -1129,6 +1508,11 @@ func (in *NTPMonitoring) DeepCopyInto(out *NTPMonitoring) {
 		*out = new(NtpMonitoringConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReadinessGate != nil {
+		in, out := &in.ReadinessGate, &out.ReadinessGate
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTPMonitoring.
@@ -1142,12 +1526,77 @@ func (in *NTPMonitoring) DeepCopy() *NTPMonitoring {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NtpMonitoringConfig) DeepCopyInto(out *NtpMonitoringConfig) {
+func (in *NameConstraints) DeepCopyInto(out *NameConstraints) {
 	*out = *in
-	if in.NtpConfigRef != nil {
-		in, out := &in.NtpConfigRef, &out.NtpConfigRef
-		*out = new(LocalObjectReference)
-		**out = **in
+	if in.PermittedDNSDomains != nil {
+		in, out := &in.PermittedDNSDomains, &out.PermittedDNSDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedDNSDomains != nil {
+		in, out := &in.ExcludedDNSDomains, &out.ExcludedDNSDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PermittedEmailAddresses != nil {
+		in, out := &in.PermittedEmailAddresses, &out.PermittedEmailAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedEmailAddresses != nil {
+		in, out := &in.ExcludedEmailAddresses, &out.ExcludedEmailAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PermittedURIDomains != nil {
+		in, out := &in.PermittedURIDomains, &out.PermittedURIDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedURIDomains != nil {
+		in, out := &in.ExcludedURIDomains, &out.ExcludedURIDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NameConstraints.
+func (in *NameConstraints) DeepCopy() *NameConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(NameConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyConfig) DeepCopyInto(out *NetworkPolicyConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyConfig.
+func (in *NetworkPolicyConfig) DeepCopy() *NetworkPolicyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NtpMonitoringConfig) DeepCopyInto(out *NtpMonitoringConfig) {
+	*out = *in
+	if in.NtpConfigRef != nil {
+		in, out := &in.NtpConfigRef, &out.NtpConfigRef
+		*out = new(LocalObjectReference)
+		**out = **in
 	}
 	if in.Servers != nil {
 		in, out := &in.Servers, &out.Servers
@@ -1242,6 +1691,25 @@ func (in *PodRequirements) DeepCopyInto(out *PodRequirements) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodRequirements.
@@ -1254,6 +1722,33 @@ func (in *PodRequirements) DeepCopy() *PodRequirements {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRuleConfig) DeepCopyInto(out *PrometheusRuleConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]AlertOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRuleConfig.
+func (in *PrometheusRuleConfig) DeepCopy() *PrometheusRuleConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRuleConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Pvc) DeepCopyInto(out *Pvc) {
 	*out = *in
@@ -1459,9 +1954,16 @@ func (in *RekorSpec) DeepCopyInto(out *RekorSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.TreeRef != nil {
+		in, out := &in.TreeRef, &out.TreeRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
 	in.Trillian.DeepCopyInto(&out.Trillian)
 	in.Ingress.DeepCopyInto(&out.Ingress)
 	in.Monitoring.DeepCopyInto(&out.Monitoring)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	in.Signer.DeepCopyInto(&out.Signer)
 	in.Attestations.DeepCopyInto(&out.Attestations)
 	in.SearchIndex.DeepCopyInto(&out.SearchIndex)
@@ -1519,6 +2021,13 @@ func (in *RekorStatus) DeepCopyInto(out *RekorStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -1538,9 +2047,240 @@ func (in *RekorStatus) DeepCopy() *RekorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2) DeepCopyInto(out *RekorV2) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2.
+func (in *RekorV2) DeepCopy() *RekorV2 {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RekorV2) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2Defaulter) DeepCopyInto(out *RekorV2Defaulter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2Defaulter.
+func (in *RekorV2Defaulter) DeepCopy() *RekorV2Defaulter {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2Defaulter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2List) DeepCopyInto(out *RekorV2List) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RekorV2, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2List.
+func (in *RekorV2List) DeepCopy() *RekorV2List {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2List)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RekorV2List) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2S3Storage) DeepCopyInto(out *RekorV2S3Storage) {
+	*out = *in
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	out.DatabaseRef = in.DatabaseRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2S3Storage.
+func (in *RekorV2S3Storage) DeepCopy() *RekorV2S3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2S3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2Signer) DeepCopyInto(out *RekorV2Signer) {
+	*out = *in
+	if in.Kms != nil {
+		in, out := &in.Kms, &out.Kms
+		*out = new(KMS)
+		**out = **in
+	}
+	if in.KeyRef != nil {
+		in, out := &in.KeyRef, &out.KeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PublicKeyRef != nil {
+		in, out := &in.PublicKeyRef, &out.PublicKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2Signer.
+func (in *RekorV2Signer) DeepCopy() *RekorV2Signer {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2Signer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2Spec) DeepCopyInto(out *RekorV2Spec) {
+	*out = *in
+	in.PodRequirements.DeepCopyInto(&out.PodRequirements)
+	in.ServiceAccountConfig.DeepCopyInto(&out.ServiceAccountConfig)
+	in.Storage.DeepCopyInto(&out.Storage)
+	in.Signer.DeepCopyInto(&out.Signer)
+	if in.CheckpointInterval != nil {
+		in, out := &in.CheckpointInterval, &out.CheckpointInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.Monitoring.DeepCopyInto(&out.Monitoring)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	if in.TrustedCA != nil {
+		in, out := &in.TrustedCA, &out.TrustedCA
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(Auth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxRequestBodySize != nil {
+		in, out := &in.MaxRequestBodySize, &out.MaxRequestBodySize
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2Spec.
+func (in *RekorV2Spec) DeepCopy() *RekorV2Spec {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2Spec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2Status) DeepCopyInto(out *RekorV2Status) {
+	*out = *in
+	if in.PrivateKeyRef != nil {
+		in, out := &in.PrivateKeyRef, &out.PrivateKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.PublicKeyRef != nil {
+		in, out := &in.PublicKeyRef, &out.PublicKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2Status.
+func (in *RekorV2Status) DeepCopy() *RekorV2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorV2Storage) DeepCopyInto(out *RekorV2Storage) {
+	*out = *in
+	in.Pvc.DeepCopyInto(&out.Pvc)
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(RekorV2S3Storage)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorV2Storage.
+func (in *RekorV2Storage) DeepCopy() *RekorV2Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorV2Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SearchIndex) DeepCopyInto(out *SearchIndex) {
 	*out = *in
+	in.PodRequirements.DeepCopyInto(&out.PodRequirements)
 	if in.Create != nil {
 		in, out := &in.Create, &out.Create
 		*out = new(bool)
@@ -1623,6 +2363,37 @@ func (in *Securesign) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignComponentStatus) DeepCopyInto(out *SecuresignComponentStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignComponentStatus.
+func (in *SecuresignComponentStatus) DeepCopy() *SecuresignComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignConsoleStatus) DeepCopyInto(out *SecuresignConsoleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignConsoleStatus.
+func (in *SecuresignConsoleStatus) DeepCopy() *SecuresignConsoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignConsoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecuresignDefaulter) DeepCopyInto(out *SecuresignDefaulter) {
 	*out = *in
@@ -1704,6 +2475,7 @@ func (in *SecuresignRekorStatus) DeepCopy() *SecuresignRekorStatus {
 func (in *SecuresignSpec) DeepCopyInto(out *SecuresignSpec) {
 	*out = *in
 	in.Rekor.DeepCopyInto(&out.Rekor)
+	in.RekorV2.DeepCopyInto(&out.RekorV2)
 	in.Fulcio.DeepCopyInto(&out.Fulcio)
 	in.Trillian.DeepCopyInto(&out.Trillian)
 	in.Tuf.DeepCopyInto(&out.Tuf)
@@ -1713,6 +2485,12 @@ func (in *SecuresignSpec) DeepCopyInto(out *SecuresignSpec) {
 		*out = new(TimestampAuthoritySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Console != nil {
+		in, out := &in.Console, &out.Console
+		*out = new(ConsoleSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignSpec.
@@ -1739,6 +2517,14 @@ func (in *SecuresignStatus) DeepCopyInto(out *SecuresignStatus) {
 	out.FulcioStatus = in.FulcioStatus
 	out.TufStatus = in.TufStatus
 	out.TSAStatus = in.TSAStatus
+	out.ConsoleStatus = in.ConsoleStatus
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]SecuresignComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignStatus.
@@ -1955,6 +2741,26 @@ func (in *TimestampAuthorityList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampAuthorityPodTimeSync) DeepCopyInto(out *TimestampAuthorityPodTimeSync) {
+	*out = *in
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampAuthorityPodTimeSync.
+func (in *TimestampAuthorityPodTimeSync) DeepCopy() *TimestampAuthorityPodTimeSync {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampAuthorityPodTimeSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimestampAuthoritySigner) DeepCopyInto(out *TimestampAuthoritySigner) {
 	*out = *in
@@ -2019,6 +2825,8 @@ func (in *TimestampAuthoritySpec) DeepCopyInto(out *TimestampAuthoritySpec) {
 	in.Ingress.DeepCopyInto(&out.Ingress)
 	in.Signer.DeepCopyInto(&out.Signer)
 	in.Monitoring.DeepCopyInto(&out.Monitoring)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.Autoscaling.DeepCopyInto(&out.Autoscaling)
 	if in.TrustedCA != nil {
 		in, out := &in.TrustedCA, &out.TrustedCA
 		*out = new(LocalObjectReference)
@@ -2061,6 +2869,18 @@ func (in *TimestampAuthorityStatus) DeepCopyInto(out *TimestampAuthorityStatus)
 		*out = new(TimestampAuthoritySignerStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TimeSynchronization != nil {
+		in, out := &in.TimeSynchronization, &out.TimeSynchronization
+		*out = new(TimestampAuthorityTimeSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -2080,6 +2900,29 @@ func (in *TimestampAuthorityStatus) DeepCopy() *TimestampAuthorityStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimestampAuthorityTimeSyncStatus) DeepCopyInto(out *TimestampAuthorityTimeSyncStatus) {
+	*out = *in
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]TimestampAuthorityPodTimeSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimestampAuthorityTimeSyncStatus.
+func (in *TimestampAuthorityTimeSyncStatus) DeepCopy() *TimestampAuthorityTimeSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TimestampAuthorityTimeSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tink) DeepCopyInto(out *Tink) {
 	*out = *in
@@ -2155,6 +2998,7 @@ func (in *Trillian) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrillianDB) DeepCopyInto(out *TrillianDB) {
 	*out = *in
+	in.PodRequirements.DeepCopyInto(&out.PodRequirements)
 	if in.Create != nil {
 		in, out := &in.Create, &out.Create
 		*out = new(bool)
@@ -2264,6 +3108,11 @@ func (in *TrillianLogSigner) DeepCopyInto(out *TrillianLogSigner) {
 	*out = *in
 	in.PodRequirements.DeepCopyInto(&out.PodRequirements)
 	in.TLS.DeepCopyInto(&out.TLS)
+	if in.Sequencer != nil {
+		in, out := &in.Sequencer, &out.Sequencer
+		*out = new(TrillianSequencer)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianLogSigner.
@@ -2276,6 +3125,66 @@ func (in *TrillianLogSigner) DeepCopy() *TrillianLogSigner {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianQuota) DeepCopyInto(out *TrillianQuota) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxUnsequencedRows != nil {
+		in, out := &in.MaxUnsequencedRows, &out.MaxUnsequencedRows
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianQuota.
+func (in *TrillianQuota) DeepCopy() *TrillianQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianSequencer) DeepCopyInto(out *TrillianSequencer) {
+	*out = *in
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.NumSequencers != nil {
+		in, out := &in.NumSequencers, &out.NumSequencers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GuardWindow != nil {
+		in, out := &in.GuardWindow, &out.GuardWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianSequencer.
+func (in *TrillianSequencer) DeepCopy() *TrillianSequencer {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianSequencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrillianServiceStatus) DeepCopyInto(out *TrillianServiceStatus) {
 	*out = *in
@@ -2298,6 +3207,7 @@ func (in *TrillianSpec) DeepCopyInto(out *TrillianSpec) {
 	in.ServiceAccountConfig.DeepCopyInto(&out.ServiceAccountConfig)
 	in.Db.DeepCopyInto(&out.Db)
 	in.Monitoring.DeepCopyInto(&out.Monitoring)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	in.LogServer.DeepCopyInto(&out.LogServer)
 	in.LogSigner.DeepCopyInto(&out.LogSigner)
 	if in.TrustedCA != nil {
@@ -2305,6 +3215,11 @@ func (in *TrillianSpec) DeepCopyInto(out *TrillianSpec) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(TrillianQuota)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MaxRecvMessageSize != nil {
 		in, out := &in.MaxRecvMessageSize, &out.MaxRecvMessageSize
 		*out = new(int64)
@@ -2334,6 +3249,13 @@ func (in *TrillianStatus) DeepCopyInto(out *TrillianStatus) {
 	in.Db.DeepCopyInto(&out.Db)
 	in.LogServer.DeepCopyInto(&out.LogServer)
 	in.LogSigner.DeepCopyInto(&out.LogSigner)
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -2353,6 +3275,128 @@ func (in *TrillianStatus) DeepCopy() *TrillianStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianTree) DeepCopyInto(out *TrillianTree) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTree.
+func (in *TrillianTree) DeepCopy() *TrillianTree {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianTree)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrillianTree) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianTreeList) DeepCopyInto(out *TrillianTreeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrillianTree, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTreeList.
+func (in *TrillianTreeList) DeepCopy() *TrillianTreeList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianTreeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrillianTreeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianTreeSpec) DeepCopyInto(out *TrillianTreeSpec) {
+	*out = *in
+	in.Trillian.DeepCopyInto(&out.Trillian)
+	if in.TreeID != nil {
+		in, out := &in.TreeID, &out.TreeID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxRootDuration != nil {
+		in, out := &in.MaxRootDuration, &out.MaxRootDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.TrustedCA != nil {
+		in, out := &in.TrustedCA, &out.TrustedCA
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTreeSpec.
+func (in *TrillianTreeSpec) DeepCopy() *TrillianTreeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianTreeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianTreeStatus) DeepCopyInto(out *TrillianTreeStatus) {
+	*out = *in
+	if in.TreeID != nil {
+		in, out := &in.TreeID, &out.TreeID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTreeStatus.
+func (in *TrillianTreeStatus) DeepCopy() *TrillianTreeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianTreeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrustRootBinding) DeepCopyInto(out *TrustRootBinding) {
 	*out = *in
@@ -2516,6 +3560,7 @@ func (in *TufSpec) DeepCopyInto(out *TufSpec) {
 		**out = **in
 	}
 	in.Pvc.DeepCopyInto(&out.Pvc)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	if in.Ctlog != nil {
 		in, out := &in.Ctlog, &out.Ctlog
 		*out = make([]TrustRootBinding, len(*in))
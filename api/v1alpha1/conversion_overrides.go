@@ -114,3 +114,17 @@ func Convert_v1alpha1_TufService_To_v1_ServiceReference(in *TufService, out *v1.
 func Convert_v1_ServiceReference_To_v1alpha1_TufService(in *v1.ServiceReference, out *TufService, _ apiconversion.Scope) error {
 	return serviceReferenceToAddressPort(in, &out.Address, &out.Port)
 }
+
+// PodRequirements: v1 adds scheduling fields (topology spread, node selector, priority
+// and runtime class). They are dropped here and restored by restorePodScheduling in ConvertTo.
+
+func Convert_v1_PodRequirements_To_v1alpha1_PodRequirements(in *v1.PodRequirements, out *PodRequirements, s apiconversion.Scope) error {
+	return autoConvert_v1_PodRequirements_To_v1alpha1_PodRequirements(in, out, s)
+}
+
+func restorePodScheduling(dst *v1.PodRequirements, restored v1.PodRequirements) {
+	dst.TopologySpreadConstraints = restored.TopologySpreadConstraints
+	dst.NodeSelector = restored.NodeSelector
+	dst.PriorityClassName = restored.PriorityClassName
+	dst.RuntimeClassName = restored.RuntimeClassName
+}
@@ -99,6 +99,7 @@ func (src *CTlog) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Status.PublicKey = restored.Status.PublicKey
 	dst.Spec.Monitoring.ServiceMonitor = restored.Spec.Monitoring.ServiceMonitor
+	dst.Spec.Monitoring.PrometheusRule = restored.Spec.Monitoring.PrometheusRule
 	dst.Spec.Prefix = restored.Spec.Prefix
 	if dst.Status.Url != "" && restored.Spec.Prefix != "" {
 		var err error
@@ -115,6 +116,12 @@ func (src *CTlog) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.PodExtensions = restored.Spec.PodExtensions
 	dst.Spec.Auth = restored.Spec.Auth
 	dst.Spec.Ingress = restored.Spec.Ingress
+	dst.Spec.NetworkPolicy = restored.Spec.NetworkPolicy
+	dst.Spec.Autoscaling = restored.Spec.Autoscaling
+	restorePodScheduling(&dst.Spec.PodRequirements, restored.Spec.PodRequirements)
+	dst.Status.Certificates = restored.Status.Certificates
+	dst.Spec.Signer.KeyAlgorithm = restored.Spec.Signer.KeyAlgorithm
+	dst.Spec.TreeRef = restored.Spec.TreeRef
 	return nil
 }
 
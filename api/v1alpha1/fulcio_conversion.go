@@ -151,7 +151,9 @@ func (src *Fulcio) ConvertTo(dstRaw conversion.Hub) error {
 		}
 	}
 	dst.Status.CertificateChain = restored.Status.CertificateChain
+	dst.Status.GrpcUrl = restored.Status.GrpcUrl
 	dst.Spec.Monitoring.ServiceMonitor = restored.Spec.Monitoring.ServiceMonitor
+	dst.Spec.Monitoring.PrometheusRule = restored.Spec.Monitoring.PrometheusRule
 
 	// v1alpha1 inject prefix into URL - we need to restore empty URL to allow ref resolution
 	if restored.Spec.Ctlog.URL == "" && dst.Spec.Ctlog.URL == "///trusted-artifact-signer" { //nolint:goconst
@@ -162,6 +164,17 @@ func (src *Fulcio) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.PodExtensions = restored.Spec.PodExtensions
 	dst.Spec.Auth = restored.Spec.Auth
+	dst.Spec.TemplatePreview = restored.Spec.TemplatePreview
+	dst.Spec.IssuerHealthCheck = restored.Spec.IssuerHealthCheck
+	dst.Spec.GrpcIngress = restored.Spec.GrpcIngress
+	dst.Spec.NetworkPolicy = restored.Spec.NetworkPolicy
+	dst.Spec.Autoscaling = restored.Spec.Autoscaling
+	dst.Spec.Signer.CertificateChain.IntermediateCA = restored.Spec.Signer.CertificateChain.IntermediateCA
+	restorePodScheduling(&dst.Spec.PodRequirements, restored.Spec.PodRequirements)
+	dst.Status.Certificates = restored.Status.Certificates
+	dst.Status.TemplatePreview = restored.Status.TemplatePreview
+	dst.Status.OIDCIssuers = restored.Status.OIDCIssuers
+	dst.Spec.Signer.KeyAlgorithm = restored.Spec.Signer.KeyAlgorithm
 	return nil
 }
 
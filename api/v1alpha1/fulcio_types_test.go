@@ -344,6 +344,10 @@ func generateFulcioObject(name string) *Fulcio {
 						Issuer:   "url",
 					},
 				},
+				// referenced by the CIProvider of the OIDC issuers
+				CIIssuerMetadata: []CIIssuerMetadata{
+					{IssuerName: "foo"},
+				},
 			},
 			Certificate: FulcioCert{
 				CommonName:       "hostname",
@@ -359,28 +363,26 @@ func generateFulcioObject(name string) *Fulcio {
 }
 
 func addCIIssuerMetadata(config *Fulcio) *Fulcio {
-	config.Spec.Config.CIIssuerMetadata = []CIIssuerMetadata{
-		{
-			IssuerName:                     "gitlab-ci",
-			DefaultTemplateValues:          map[string]string{"url": "https://gitlab.com"},
-			SubjectAlternativeNameTemplate: "https://{{ .ci_config_ref_uri }}",
-			ExtensionTemplates: Extensions{
-				BuildSignerURI:                      "https://{{ .ci_config_ref_uri }}",
-				BuildSignerDigest:                   "ci_config_sha",
-				RunnerEnvironment:                   "runner_environment",
-				SourceRepositoryURI:                 "{{ .url }}/{{ .project_path }}",
-				SourceRepositoryDigest:              "sha",
-				SourceRepositoryRef:                 "refs/{{if eq .ref_type \"branch\"}}heads/{{ else }}tags/{{end}}{{ .ref }}",
-				SourceRepositoryIdentifier:          "project_id",
-				SourceRepositoryOwnerURI:            "{{ .url }}/{{ .namespace_path }}",
-				SourceRepositoryOwnerIdentifier:     "namespace_id",
-				BuildConfigURI:                      "https://{{ .ci_config_ref_uri }}",
-				BuildConfigDigest:                   "ci_config_sha",
-				BuildTrigger:                        "pipeline_source",
-				RunInvocationURI:                    "{{ .url }}/{{ .project_path }}/-/jobs/{{ .job_id }}",
-				SourceRepositoryVisibilityAtSigning: "project_visibility",
-			},
+	config.Spec.Config.CIIssuerMetadata = append(config.Spec.Config.CIIssuerMetadata, CIIssuerMetadata{
+		IssuerName:                     "gitlab-ci",
+		DefaultTemplateValues:          map[string]string{"url": "https://gitlab.com"},
+		SubjectAlternativeNameTemplate: "https://{{ .ci_config_ref_uri }}",
+		ExtensionTemplates: Extensions{
+			BuildSignerURI:                      "https://{{ .ci_config_ref_uri }}",
+			BuildSignerDigest:                   "ci_config_sha",
+			RunnerEnvironment:                   "runner_environment",
+			SourceRepositoryURI:                 "{{ .url }}/{{ .project_path }}",
+			SourceRepositoryDigest:              "sha",
+			SourceRepositoryRef:                 "refs/{{if eq .ref_type \"branch\"}}heads/{{ else }}tags/{{end}}{{ .ref }}",
+			SourceRepositoryIdentifier:          "project_id",
+			SourceRepositoryOwnerURI:            "{{ .url }}/{{ .namespace_path }}",
+			SourceRepositoryOwnerIdentifier:     "namespace_id",
+			BuildConfigURI:                      "https://{{ .ci_config_ref_uri }}",
+			BuildConfigDigest:                   "ci_config_sha",
+			BuildTrigger:                        "pipeline_source",
+			RunInvocationURI:                    "{{ .url }}/{{ .project_path }}/-/jobs/{{ .job_id }}",
+			SourceRepositoryVisibilityAtSigning: "project_visibility",
 		},
-	}
+	})
 	return config
 }
@@ -44,6 +44,11 @@ func Convert_v1_RekorAttestations_To_v1alpha1_RekorAttestations(in *rhtasv1.Reko
 	return autoConvert_v1_RekorAttestations_To_v1alpha1_RekorAttestations(in, out, s)
 }
 
+// v1 adds scheduling of the managed Redis, restored in ConvertTo.
+func Convert_v1_SearchIndex_To_v1alpha1_SearchIndex(in *rhtasv1.SearchIndex, out *SearchIndex, s apiconversion.Scope) error {
+	return autoConvert_v1_SearchIndex_To_v1alpha1_SearchIndex(in, out, s)
+}
+
 func (src *Rekor) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*rhtasv1.Rekor)
 	if err := Convert_v1alpha1_Rekor_To_v1_Rekor(src, dst, nil); err != nil {
@@ -61,6 +66,7 @@ func (src *Rekor) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.ImagePullSecrets = restored.Spec.ImagePullSecrets
 	dst.Status.PublicKey = restored.Status.PublicKey
 	dst.Spec.Monitoring.ServiceMonitor = restored.Spec.Monitoring.ServiceMonitor
+	dst.Spec.Monitoring.PrometheusRule = restored.Spec.Monitoring.PrometheusRule
 	if dst.Spec.Trillian.URL == "" {
 		dst.Spec.Trillian.Ref = restored.Spec.Trillian.Ref
 	}
@@ -68,6 +74,13 @@ func (src *Rekor) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Monitoring.Tuf.Ref = restored.Spec.Monitoring.Tuf.Ref
 	}
 	dst.Spec.PodExtensions = restored.Spec.PodExtensions
+	dst.Spec.NetworkPolicy = restored.Spec.NetworkPolicy
+	dst.Spec.Autoscaling = restored.Spec.Autoscaling
+	restorePodScheduling(&dst.Spec.PodRequirements, restored.Spec.PodRequirements)
+	dst.Spec.SearchIndex.PodRequirements = restored.Spec.SearchIndex.PodRequirements
+	dst.Status.Certificates = restored.Status.Certificates
+	dst.Spec.Signer.KeyAlgorithm = restored.Spec.Signer.KeyAlgorithm
+	dst.Spec.TreeRef = restored.Spec.TreeRef
 
 	return nil
 }
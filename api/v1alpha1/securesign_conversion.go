@@ -36,6 +36,18 @@ func Convert_v1_SecuresignTSAStatus_To_v1alpha1_SecuresignTSAStatus(in *rhtasv1.
 	return nil
 }
 
+func Convert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus(in *rhtasv1.SecuresignFulcioStatus, out *SecuresignFulcioStatus, s apiconversion.Scope) error {
+	return autoConvert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus(in, out, s)
+}
+
+func Convert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(in *rhtasv1.SecuresignSpec, out *SecuresignSpec, s apiconversion.Scope) error {
+	return autoConvert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(in, out, s)
+}
+
+func Convert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus(in *rhtasv1.SecuresignStatus, out *SecuresignStatus, s apiconversion.Scope) error {
+	return autoConvert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus(in, out, s)
+}
+
 func (src *Securesign) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*rhtasv1.Securesign)
 	if err := Convert_v1alpha1_Securesign_To_v1_Securesign(src, dst, nil); err != nil {
@@ -52,6 +64,7 @@ func (src *Securesign) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.Fulcio.ImagePullSecrets = restored.Spec.Fulcio.ImagePullSecrets
 	dst.Spec.Fulcio.Monitoring.ServiceMonitor = restored.Spec.Fulcio.Monitoring.ServiceMonitor
+	dst.Spec.Fulcio.Monitoring.PrometheusRule = restored.Spec.Fulcio.Monitoring.PrometheusRule
 	dst.Spec.Fulcio.Signer.Type = restored.Spec.Fulcio.Signer.Type
 	// If original v1 had File=&{} (empty struct), preserve it
 	if dst.Spec.Fulcio.Signer.File == nil && restored.Spec.Fulcio.Signer.File != nil {
@@ -69,9 +82,19 @@ func (src *Securesign) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.Fulcio.PodExtensions = restored.Spec.Fulcio.PodExtensions
 	dst.Spec.Fulcio.Auth = restored.Spec.Fulcio.Auth
+	dst.Spec.Fulcio.TemplatePreview = restored.Spec.Fulcio.TemplatePreview
+	dst.Spec.Fulcio.IssuerHealthCheck = restored.Spec.Fulcio.IssuerHealthCheck
+	dst.Spec.Fulcio.GrpcIngress = restored.Spec.Fulcio.GrpcIngress
+	dst.Spec.Fulcio.NetworkPolicy = restored.Spec.Fulcio.NetworkPolicy
+	dst.Spec.Fulcio.Autoscaling = restored.Spec.Fulcio.Autoscaling
+	dst.Spec.Fulcio.Signer.CertificateChain.IntermediateCA = restored.Spec.Fulcio.Signer.CertificateChain.IntermediateCA
+	dst.Spec.Fulcio.Signer.KeyAlgorithm = restored.Spec.Fulcio.Signer.KeyAlgorithm
+	restorePodScheduling(&dst.Spec.Fulcio.PodRequirements, restored.Spec.Fulcio.PodRequirements)
+	dst.Status.FulcioStatus.GrpcUrl = restored.Status.FulcioStatus.GrpcUrl
 	dst.Spec.Ctlog.ImagePullSecrets = restored.Spec.Ctlog.ImagePullSecrets
 	dst.Spec.Ctlog.TrustedCA = restored.Spec.Ctlog.TrustedCA
 	dst.Spec.Ctlog.Monitoring.ServiceMonitor = restored.Spec.Ctlog.Monitoring.ServiceMonitor
+	dst.Spec.Ctlog.Monitoring.PrometheusRule = restored.Spec.Ctlog.Monitoring.PrometheusRule
 	dst.Spec.Ctlog.Prefix = restored.Spec.Ctlog.Prefix
 	dst.Spec.Ctlog.Signer.Type = restored.Spec.Ctlog.Signer.Type
 	// If original v1 had File=&{} (empty struct), preserve it
@@ -90,9 +113,23 @@ func (src *Securesign) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Ctlog.PodExtensions = restored.Spec.Ctlog.PodExtensions
 	dst.Spec.Ctlog.Auth = restored.Spec.Ctlog.Auth
 	dst.Spec.Ctlog.Ingress = restored.Spec.Ctlog.Ingress
+	dst.Spec.Ctlog.NetworkPolicy = restored.Spec.Ctlog.NetworkPolicy
+	dst.Spec.Ctlog.Autoscaling = restored.Spec.Ctlog.Autoscaling
+	restorePodScheduling(&dst.Spec.Ctlog.PodRequirements, restored.Spec.Ctlog.PodRequirements)
+	dst.Spec.Ctlog.Signer.KeyAlgorithm = restored.Spec.Ctlog.Signer.KeyAlgorithm
+	dst.Spec.Ctlog.TreeRef = restored.Spec.Ctlog.TreeRef
 	dst.Spec.Rekor.ImagePullSecrets = restored.Spec.Rekor.ImagePullSecrets
 	dst.Spec.Rekor.Monitoring.ServiceMonitor = restored.Spec.Rekor.Monitoring.ServiceMonitor
+	dst.Spec.Rekor.Monitoring.PrometheusRule = restored.Spec.Rekor.Monitoring.PrometheusRule
 	dst.Spec.Rekor.PodExtensions = restored.Spec.Rekor.PodExtensions
+	dst.Spec.Rekor.NetworkPolicy = restored.Spec.Rekor.NetworkPolicy
+	dst.Spec.Rekor.Autoscaling = restored.Spec.Rekor.Autoscaling
+	restorePodScheduling(&dst.Spec.Rekor.PodRequirements, restored.Spec.Rekor.PodRequirements)
+	dst.Spec.Rekor.SearchIndex.PodRequirements = restored.Spec.Rekor.SearchIndex.PodRequirements
+	dst.Spec.Rekor.Signer.KeyAlgorithm = restored.Spec.Rekor.Signer.KeyAlgorithm
+	dst.Spec.Rekor.TreeRef = restored.Spec.Rekor.TreeRef
+	dst.Spec.RekorVersion = restored.Spec.RekorVersion
+	dst.Spec.RekorV2 = restored.Spec.RekorV2
 	if dst.Spec.Rekor.Trillian.URL == "" {
 		dst.Spec.Rekor.Trillian.Ref = restored.Spec.Rekor.Trillian.Ref
 	}
@@ -101,7 +138,14 @@ func (src *Securesign) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.Trillian.ImagePullSecrets = restored.Spec.Trillian.ImagePullSecrets
 	dst.Spec.Trillian.Monitoring.ServiceMonitor = restored.Spec.Trillian.Monitoring.ServiceMonitor
+	dst.Spec.Trillian.Monitoring.PrometheusRule = restored.Spec.Trillian.Monitoring.PrometheusRule
 	dst.Spec.Trillian.PodExtensions = restored.Spec.Trillian.PodExtensions
+	dst.Spec.Trillian.NetworkPolicy = restored.Spec.Trillian.NetworkPolicy
+	restorePodScheduling(&dst.Spec.Trillian.LogServer.PodRequirements, restored.Spec.Trillian.LogServer.PodRequirements)
+	restorePodScheduling(&dst.Spec.Trillian.LogSigner.PodRequirements, restored.Spec.Trillian.LogSigner.PodRequirements)
+	dst.Spec.Trillian.Db.PodRequirements = restored.Spec.Trillian.Db.PodRequirements
+	dst.Spec.Trillian.LogSigner.Sequencer = restored.Spec.Trillian.LogSigner.Sequencer
+	dst.Spec.Trillian.Quota = restored.Spec.Trillian.Quota
 	if src.Spec.Trillian.Db.DatabaseSecretRef != nil {
 		v1Ref := &rhtasv1.LocalObjectReference{Name: src.Spec.Trillian.Db.DatabaseSecretRef.Name}
 		auth := dbsecret.DbSecretToAuth(v1Ref)
@@ -115,6 +159,8 @@ func (src *Securesign) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Tuf.ImagePullSecrets = restored.Spec.Tuf.ImagePullSecrets
 	dst.Spec.Tuf.TrustedCA = restored.Spec.Tuf.TrustedCA
 	dst.Spec.Tuf.PodExtensions = restored.Spec.Tuf.PodExtensions
+	dst.Spec.Tuf.NetworkPolicy = restored.Spec.Tuf.NetworkPolicy
+	restorePodScheduling(&dst.Spec.Tuf.PodRequirements, restored.Spec.Tuf.PodRequirements)
 	restoreBindingRef(dst.Spec.Tuf.Rekor, restored.Spec.Tuf.Rekor)
 	if len(dst.Spec.Tuf.Fulcio) > 0 && len(restored.Spec.Tuf.Fulcio) > 0 {
 		if dst.Spec.Tuf.Fulcio[0].URL == "" {
@@ -131,13 +177,24 @@ func (src *Securesign) ConvertTo(dstRaw conversion.Hub) error {
 	if dst.Spec.Tuf.Tsa != nil && restored.Spec.Tuf.Tsa != nil {
 		restoreBindingRef(*dst.Spec.Tuf.Tsa, *restored.Spec.Tuf.Tsa)
 	}
+	dst.Spec.NetworkPolicy = restored.Spec.NetworkPolicy
 	if dst.Spec.TimestampAuthority != nil && restored.Spec.TimestampAuthority != nil {
 		dst.Spec.TimestampAuthority.ImagePullSecrets = restored.Spec.TimestampAuthority.ImagePullSecrets
 		dst.Spec.TimestampAuthority.Monitoring.ServiceMonitor = restored.Spec.TimestampAuthority.Monitoring.ServiceMonitor
+		dst.Spec.TimestampAuthority.Monitoring.PrometheusRule = restored.Spec.TimestampAuthority.Monitoring.PrometheusRule
 		dst.Spec.TimestampAuthority.PodExtensions = restored.Spec.TimestampAuthority.PodExtensions
+		dst.Spec.TimestampAuthority.NetworkPolicy = restored.Spec.TimestampAuthority.NetworkPolicy
+		dst.Spec.TimestampAuthority.Autoscaling = restored.Spec.TimestampAuthority.Autoscaling
+		restorePodScheduling(&dst.Spec.TimestampAuthority.PodRequirements, restored.Spec.TimestampAuthority.PodRequirements)
+		dst.Spec.TimestampAuthority.Signer.CertificateChain.Renewal = restored.Spec.TimestampAuthority.Signer.CertificateChain.Renewal
+		dst.Spec.TimestampAuthority.Signer.KeyAlgorithm = restored.Spec.TimestampAuthority.Signer.KeyAlgorithm
+		dst.Spec.TimestampAuthority.NTPMonitoring.ReadinessGate = restored.Spec.TimestampAuthority.NTPMonitoring.ReadinessGate
 		// restore also the auth from annotation for case where no KMS or Tink is set
 		dst.Spec.TimestampAuthority.Auth = mergeAuths(dst.Spec.TimestampAuthority.Auth, restored.Spec.TimestampAuthority.Auth)
 	}
+	dst.Spec.Console = restored.Spec.Console
+	dst.Status.ConsoleStatus = restored.Status.ConsoleStatus
+	dst.Status.Components = restored.Status.Components
 	return nil
 }
 
@@ -154,6 +154,14 @@ func Convert_v1alpha1_TimestampAuthoritySigner_To_v1_TimestampAuthoritySigner(in
 	return autoConvert_v1alpha1_TimestampAuthoritySigner_To_v1_TimestampAuthoritySigner(in, out, s)
 }
 
+func Convert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigner(in *rhtasv1.TimestampAuthoritySigner, out *TimestampAuthoritySigner, s apiconversion.Scope) error {
+	return autoConvert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigner(in, out, s)
+}
+
+func Convert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(in *rhtasv1.NTPMonitoring, out *NTPMonitoring, s apiconversion.Scope) error {
+	return autoConvert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(in, out, s)
+}
+
 // mergeAuths merges the given auth objects into a single auth object and keep only unique values.
 func mergeAuths(auth ...*rhtasv1.Auth) *rhtasv1.Auth {
 	var merged *rhtasv1.Auth
@@ -192,6 +200,10 @@ func Convert_v1_TsaCertificateAuthority_To_v1alpha1_TsaCertificateAuthority(in *
 	return nil
 }
 
+func Convert_v1_CertificateChain_To_v1alpha1_CertificateChain(in *rhtasv1.CertificateChain, out *CertificateChain, s apiconversion.Scope) error {
+	return autoConvert_v1_CertificateChain_To_v1alpha1_CertificateChain(in, out, s)
+}
+
 func (src *TimestampAuthority) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*rhtasv1.TimestampAuthority)
 	if err := Convert_v1alpha1_TimestampAuthority_To_v1_TimestampAuthority(src, dst, nil); err != nil {
@@ -203,10 +215,19 @@ func (src *TimestampAuthority) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.ImagePullSecrets = restored.Spec.ImagePullSecrets
 	dst.Spec.Monitoring.ServiceMonitor = restored.Spec.Monitoring.ServiceMonitor
+	dst.Spec.Monitoring.PrometheusRule = restored.Spec.Monitoring.PrometheusRule
 	// restore also the auth from annotation for case where no KMS or Tink is set
 	dst.Spec.Auth = mergeAuths(dst.Spec.Auth, restored.Spec.Auth)
 	dst.Status.CertificateChain = restored.Status.CertificateChain
 	dst.Spec.PodExtensions = restored.Spec.PodExtensions
+	dst.Spec.NetworkPolicy = restored.Spec.NetworkPolicy
+	dst.Spec.Autoscaling = restored.Spec.Autoscaling
+	restorePodScheduling(&dst.Spec.PodRequirements, restored.Spec.PodRequirements)
+	dst.Spec.Signer.CertificateChain.Renewal = restored.Spec.Signer.CertificateChain.Renewal
+	dst.Spec.Signer.KeyAlgorithm = restored.Spec.Signer.KeyAlgorithm
+	dst.Status.Certificates = restored.Status.Certificates
+	dst.Spec.NTPMonitoring.ReadinessGate = restored.Spec.NTPMonitoring.ReadinessGate
+	dst.Status.TimeSynchronization = restored.Status.TimeSynchronization
 	return nil
 }
 
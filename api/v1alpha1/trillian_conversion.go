@@ -16,6 +16,11 @@ func Convert_v1alpha1_TrillianDB_To_v1_TrillianDB(in *TrillianDB, out *rhtasv1.T
 	return autoConvert_v1alpha1_TrillianDB_To_v1_TrillianDB(in, out, s)
 }
 
+// v1 adds scheduling of the managed database, restored in ConvertTo.
+func Convert_v1_TrillianDB_To_v1alpha1_TrillianDB(in *rhtasv1.TrillianDB, out *TrillianDB, s apiconversion.Scope) error {
+	return autoConvert_v1_TrillianDB_To_v1alpha1_TrillianDB(in, out, s)
+}
+
 func Convert_v1alpha1_TrillianDB_To_v1_TrillianDBStatus(in *TrillianDB, out *rhtasv1.TrillianDBStatus, s apiconversion.Scope) error {
 	out.PvcName = in.Pvc.Name
 	if in.DatabaseSecretRef != nil {
@@ -54,6 +59,14 @@ func Convert_v1_TrillianServiceStatus_To_v1alpha1_TrillianLogSigner(in *rhtasv1.
 	return Convert_v1_TLS_To_v1alpha1_TLS(&in.TLS, &out.TLS, s)
 }
 
+func Convert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner(in *rhtasv1.TrillianLogSigner, out *TrillianLogSigner, s apiconversion.Scope) error {
+	return autoConvert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner(in, out, s)
+}
+
+func Convert_v1_TrillianStatus_To_v1alpha1_TrillianStatus(in *rhtasv1.TrillianStatus, out *TrillianStatus, s apiconversion.Scope) error {
+	return autoConvert_v1_TrillianStatus_To_v1alpha1_TrillianStatus(in, out, s)
+}
+
 func (src *Trillian) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*rhtasv1.Trillian)
 	if err := Convert_v1alpha1_Trillian_To_v1_Trillian(src, dst, nil); err != nil {
@@ -65,7 +78,15 @@ func (src *Trillian) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	dst.Spec.ImagePullSecrets = restored.Spec.ImagePullSecrets
 	dst.Spec.Monitoring.ServiceMonitor = restored.Spec.Monitoring.ServiceMonitor
+	dst.Spec.Monitoring.PrometheusRule = restored.Spec.Monitoring.PrometheusRule
 	dst.Spec.PodExtensions = restored.Spec.PodExtensions
+	dst.Spec.NetworkPolicy = restored.Spec.NetworkPolicy
+	restorePodScheduling(&dst.Spec.LogServer.PodRequirements, restored.Spec.LogServer.PodRequirements)
+	restorePodScheduling(&dst.Spec.LogSigner.PodRequirements, restored.Spec.LogSigner.PodRequirements)
+	dst.Spec.Db.PodRequirements = restored.Spec.Db.PodRequirements
+	dst.Spec.LogSigner.Sequencer = restored.Spec.LogSigner.Sequencer
+	dst.Spec.Quota = restored.Spec.Quota
+	dst.Status.Certificates = restored.Status.Certificates
 
 	if src.Spec.Db.DatabaseSecretRef != nil {
 		v1Ref := &rhtasv1.LocalObjectReference{Name: src.Spec.Db.DatabaseSecretRef.Name}
@@ -37,6 +37,8 @@ func (src *Tuf) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.PodExtensions = restored.Spec.PodExtensions
+	dst.Spec.NetworkPolicy = restored.Spec.NetworkPolicy
+	restorePodScheduling(&dst.Spec.PodRequirements, restored.Spec.PodRequirements)
 	return nil
 }
 
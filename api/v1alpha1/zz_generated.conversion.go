@@ -98,11 +98,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.CertificateChain)(nil), (*CertificateChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_CertificateChain_To_v1alpha1_CertificateChain(a.(*v1.CertificateChain), b.(*CertificateChain), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Extensions)(nil), (*v1.Extensions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Extensions_To_v1_Extensions(a.(*Extensions), b.(*v1.Extensions), scope)
 	}); err != nil {
@@ -178,11 +173,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.NTPMonitoring)(nil), (*NTPMonitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(a.(*v1.NTPMonitoring), b.(*NTPMonitoring), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*NtpMonitoringConfig)(nil), (*v1.NtpMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NtpMonitoringConfig_To_v1_NtpMonitoringConfig(a.(*NtpMonitoringConfig), b.(*v1.NtpMonitoringConfig), scope)
 	}); err != nil {
@@ -208,11 +198,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.PodRequirements)(nil), (*PodRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PodRequirements_To_v1alpha1_PodRequirements(a.(*v1.PodRequirements), b.(*PodRequirements), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Pvc)(nil), (*v1.Pvc)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Pvc_To_v1_Pvc(a.(*Pvc), b.(*v1.Pvc), scope)
 	}); err != nil {
@@ -303,11 +288,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.SecuresignFulcioStatus)(nil), (*SecuresignFulcioStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus(a.(*v1.SecuresignFulcioStatus), b.(*SecuresignFulcioStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SecuresignList)(nil), (*v1.SecuresignList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecuresignList_To_v1_SecuresignList(a.(*SecuresignList), b.(*v1.SecuresignList), scope)
 	}); err != nil {
@@ -333,21 +313,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.SecuresignSpec)(nil), (*SecuresignSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(a.(*v1.SecuresignSpec), b.(*SecuresignSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SecuresignStatus)(nil), (*v1.SecuresignStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecuresignStatus_To_v1_SecuresignStatus(a.(*SecuresignStatus), b.(*v1.SecuresignStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.SecuresignStatus)(nil), (*SecuresignStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus(a.(*v1.SecuresignStatus), b.(*SecuresignStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SecuresignTufStatus)(nil), (*v1.SecuresignTufStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecuresignTufStatus_To_v1_SecuresignTufStatus(a.(*SecuresignTufStatus), b.(*v1.SecuresignTufStatus), scope)
 	}); err != nil {
@@ -388,11 +358,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.TimestampAuthoritySigner)(nil), (*TimestampAuthoritySigner)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigner(a.(*v1.TimestampAuthoritySigner), b.(*TimestampAuthoritySigner), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1.Tink)(nil), (*Tink)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Tink_To_v1alpha1_Tink(a.(*v1.Tink), b.(*Tink), scope)
 	}); err != nil {
@@ -438,11 +403,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.TrillianLogSigner)(nil), (*TrillianLogSigner)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner(a.(*v1.TrillianLogSigner), b.(*TrillianLogSigner), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*TrillianSpec)(nil), (*v1.TrillianSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TrillianSpec_To_v1_TrillianSpec(a.(*TrillianSpec), b.(*v1.TrillianSpec), scope)
 	}); err != nil {
@@ -453,11 +413,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.TrillianStatus)(nil), (*TrillianStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TrillianStatus_To_v1alpha1_TrillianStatus(a.(*v1.TrillianStatus), b.(*TrillianStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*Tuf)(nil), (*v1.Tuf)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Tuf_To_v1_Tuf(a.(*Tuf), b.(*v1.Tuf), scope)
 	}); err != nil {
@@ -498,6 +453,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1.CertificateChain)(nil), (*CertificateChain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateChain_To_v1alpha1_CertificateChain(a.(*v1.CertificateChain), b.(*CertificateChain), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1.File)(nil), (*File)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_File_To_v1alpha1_File(a.(*v1.File), b.(*File), scope)
 	}); err != nil {
@@ -533,6 +493,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1.NTPMonitoring)(nil), (*NTPMonitoring)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(a.(*v1.NTPMonitoring), b.(*NTPMonitoring), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.PodRequirements)(nil), (*PodRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PodRequirements_To_v1alpha1_PodRequirements(a.(*v1.PodRequirements), b.(*PodRequirements), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1.Pvc)(nil), (*TufPvc)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Pvc_To_v1alpha1_TufPvc(a.(*v1.Pvc), b.(*TufPvc), scope)
 	}); err != nil {
@@ -563,6 +533,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1.SecuresignFulcioStatus)(nil), (*SecuresignFulcioStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus(a.(*v1.SecuresignFulcioStatus), b.(*SecuresignFulcioStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.SecuresignSpec)(nil), (*SecuresignSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(a.(*v1.SecuresignSpec), b.(*SecuresignSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.SecuresignStatus)(nil), (*SecuresignStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus(a.(*v1.SecuresignStatus), b.(*SecuresignStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1.SecuresignTSAStatus)(nil), (*SecuresignTSAStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_SecuresignTSAStatus_To_v1alpha1_SecuresignTSAStatus(a.(*v1.SecuresignTSAStatus), b.(*SecuresignTSAStatus), scope)
 	}); err != nil {
@@ -588,6 +573,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1.TimestampAuthoritySigner)(nil), (*TimestampAuthoritySigner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigner(a.(*v1.TimestampAuthoritySigner), b.(*TimestampAuthoritySigner), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1.TimestampAuthoritySpec)(nil), (*TimestampAuthoritySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_TimestampAuthoritySpec_To_v1alpha1_TimestampAuthoritySpec(a.(*v1.TimestampAuthoritySpec), b.(*TimestampAuthoritySpec), scope)
 	}); err != nil {
@@ -608,6 +598,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1.TrillianLogSigner)(nil), (*TrillianLogSigner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner(a.(*v1.TrillianLogSigner), b.(*TrillianLogSigner), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1.TrillianServiceStatus)(nil), (*TrillianLogServer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_TrillianServiceStatus_To_v1alpha1_TrillianLogServer(a.(*v1.TrillianServiceStatus), b.(*TrillianLogServer), scope)
 	}); err != nil {
@@ -623,6 +618,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1.TrillianStatus)(nil), (*TrillianStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TrillianStatus_To_v1alpha1_TrillianStatus(a.(*v1.TrillianStatus), b.(*TrillianStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1.TsaCertificateAuthority)(nil), (*TsaCertificateAuthority)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_TsaCertificateAuthority_To_v1alpha1_TsaCertificateAuthority(a.(*v1.TsaCertificateAuthority), b.(*TsaCertificateAuthority), scope)
 	}); err != nil {
@@ -958,12 +958,15 @@ func autoConvert_v1_CTlogSpec_To_v1alpha1_CTlogSpec(in *v1.CTlogSpec, out *CTlog
 	}
 	// WARNING: in.ServiceAccountConfig requires manual conversion: does not exist in peer-type
 	out.TreeID = (*int64)(unsafe.Pointer(in.TreeID))
+	// WARNING: in.TreeRef requires manual conversion: does not exist in peer-type
 	// WARNING: in.Signer requires manual conversion: does not exist in peer-type
 	out.RootCertificates = *(*[]SecretKeySelector)(unsafe.Pointer(&in.RootCertificates))
 	// WARNING: in.Ingress requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_MonitoringWithTLogConfig_To_v1alpha1_MonitoringWithTLogConfig(&in.Monitoring, &out.Monitoring, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.Autoscaling requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_ServiceReference_To_v1alpha1_TrillianService(&in.Trillian, &out.Trillian, s); err != nil {
 		return err
 	}
@@ -1011,6 +1014,7 @@ func autoConvert_v1_CTlogStatus_To_v1alpha1_CTlogStatus(in *v1.CTlogStatus, out
 		return err
 	}
 	out.Url = in.Url
+	// WARNING: in.Certificates requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -1091,14 +1095,10 @@ func autoConvert_v1_CertificateChain_To_v1alpha1_CertificateChain(in *v1.Certifi
 	} else {
 		out.LeafCA = nil
 	}
+	// WARNING: in.Renewal requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_CertificateChain_To_v1alpha1_CertificateChain is an autogenerated conversion function.
-func Convert_v1_CertificateChain_To_v1alpha1_CertificateChain(in *v1.CertificateChain, out *CertificateChain, s conversion.Scope) error {
-	return autoConvert_v1_CertificateChain_To_v1alpha1_CertificateChain(in, out, s)
-}
-
 func autoConvert_v1alpha1_Extensions_To_v1_Extensions(in *Extensions, out *v1.Extensions, s conversion.Scope) error {
 	out.BuildSignerURI = in.BuildSignerURI
 	out.BuildSignerDigest = in.BuildSignerDigest
@@ -1279,6 +1279,7 @@ func autoConvert_v1_FulcioSpec_To_v1alpha1_FulcioSpec(in *v1.FulcioSpec, out *Fu
 	}
 	// WARNING: in.ServiceAccountConfig requires manual conversion: does not exist in peer-type
 	// WARNING: in.Ingress requires manual conversion: does not exist in peer-type
+	// WARNING: in.GrpcIngress requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_ServiceReference_To_v1alpha1_CtlogService(&in.Ctlog, &out.Ctlog, s); err != nil {
 		return err
 	}
@@ -1289,9 +1290,13 @@ func autoConvert_v1_FulcioSpec_To_v1alpha1_FulcioSpec(in *v1.FulcioSpec, out *Fu
 	if err := Convert_v1_MonitoringConfig_To_v1alpha1_MonitoringConfig(&in.Monitoring, &out.Monitoring, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.Autoscaling requires manual conversion: does not exist in peer-type
 	out.TrustedCA = (*LocalObjectReference)(unsafe.Pointer(in.TrustedCA))
 	// WARNING: in.PodExtensions requires manual conversion: does not exist in peer-type
 	// WARNING: in.Auth requires manual conversion: does not exist in peer-type
+	// WARNING: in.TemplatePreview requires manual conversion: does not exist in peer-type
+	// WARNING: in.IssuerHealthCheck requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1328,7 +1333,11 @@ func autoConvert_v1_FulcioStatus_To_v1alpha1_FulcioStatus(in *v1.FulcioStatus, o
 		out.Certificate = nil
 	}
 	out.Url = in.Url
+	// WARNING: in.GrpcUrl requires manual conversion: does not exist in peer-type
 	// WARNING: in.CertificateChain requires manual conversion: does not exist in peer-type
+	// WARNING: in.Certificates requires manual conversion: does not exist in peer-type
+	// WARNING: in.TemplatePreview requires manual conversion: does not exist in peer-type
+	// WARNING: in.OIDCIssuers requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -1377,6 +1386,7 @@ func autoConvert_v1alpha1_MonitoringConfig_To_v1_MonitoringConfig(in *Monitoring
 func autoConvert_v1_MonitoringConfig_To_v1alpha1_MonitoringConfig(in *v1.MonitoringConfig, out *MonitoringConfig, s conversion.Scope) error {
 	// WARNING: in.Metrics requires manual conversion: does not exist in peer-type
 	// WARNING: in.ServiceMonitor requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrometheusRule requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1434,14 +1444,10 @@ func autoConvert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(in *v1.NTPMonitoring
 		return err
 	}
 	out.Config = (*NtpMonitoringConfig)(unsafe.Pointer(in.Config))
+	// WARNING: in.ReadinessGate requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring is an autogenerated conversion function.
-func Convert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(in *v1.NTPMonitoring, out *NTPMonitoring, s conversion.Scope) error {
-	return autoConvert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(in, out, s)
-}
-
 func autoConvert_v1alpha1_NtpMonitoringConfig_To_v1_NtpMonitoringConfig(in *NtpMonitoringConfig, out *v1.NtpMonitoringConfig, s conversion.Scope) error {
 	out.NtpConfigRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NtpConfigRef))
 	out.RequestAttempts = in.RequestAttempts
@@ -1530,14 +1536,13 @@ func autoConvert_v1_PodRequirements_To_v1alpha1_PodRequirements(in *v1.PodRequir
 	out.Affinity = (*corev1.Affinity)(unsafe.Pointer(in.Affinity))
 	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
 	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	// WARNING: in.TopologySpreadConstraints requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeSelector requires manual conversion: does not exist in peer-type
+	// WARNING: in.PriorityClassName requires manual conversion: does not exist in peer-type
+	// WARNING: in.RuntimeClassName requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_PodRequirements_To_v1alpha1_PodRequirements is an autogenerated conversion function.
-func Convert_v1_PodRequirements_To_v1alpha1_PodRequirements(in *v1.PodRequirements, out *PodRequirements, s conversion.Scope) error {
-	return autoConvert_v1_PodRequirements_To_v1alpha1_PodRequirements(in, out, s)
-}
-
 func autoConvert_v1alpha1_Pvc_To_v1_Pvc(in *Pvc, out *v1.Pvc, s conversion.Scope) error {
 	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
 	out.Retain = (*bool)(unsafe.Pointer(in.Retain))
@@ -1695,6 +1700,7 @@ func autoConvert_v1_RekorSigner_To_v1alpha1_RekorSigner(in *v1.RekorSigner, out
 	// WARNING: in.Type requires manual conversion: does not exist in peer-type
 	// WARNING: in.Kms requires manual conversion: does not exist in peer-type
 	out.KeyRef = (*SecretKeySelector)(unsafe.Pointer(in.KeyRef))
+	// WARNING: in.KeyAlgorithm requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1737,6 +1743,7 @@ func autoConvert_v1_RekorSpec_To_v1alpha1_RekorSpec(in *v1.RekorSpec, out *Rekor
 	}
 	// WARNING: in.ServiceAccountConfig requires manual conversion: does not exist in peer-type
 	out.TreeID = (*int64)(unsafe.Pointer(in.TreeID))
+	// WARNING: in.TreeRef requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_ServiceReference_To_v1alpha1_TrillianService(&in.Trillian, &out.Trillian, s); err != nil {
 		return err
 	}
@@ -1744,6 +1751,8 @@ func autoConvert_v1_RekorSpec_To_v1alpha1_RekorSpec(in *v1.RekorSpec, out *Rekor
 	if err := Convert_v1_MonitoringWithTLogConfig_To_v1alpha1_MonitoringWithTLogConfig(&in.Monitoring, &out.Monitoring, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.Autoscaling requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_RekorSigner_To_v1alpha1_RekorSigner(&in.Signer, &out.Signer, s); err != nil {
 		return err
 	}
@@ -1796,6 +1805,7 @@ func autoConvert_v1_RekorStatus_To_v1alpha1_RekorStatus(in *v1.RekorStatus, out
 	out.Url = in.Url
 	// WARNING: in.PublicKey requires manual conversion: does not exist in peer-type
 	out.TreeID = (*int64)(unsafe.Pointer(in.TreeID))
+	// WARNING: in.Certificates requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -1816,6 +1826,7 @@ func Convert_v1alpha1_SearchIndex_To_v1_SearchIndex(in *SearchIndex, out *v1.Sea
 }
 
 func autoConvert_v1_SearchIndex_To_v1alpha1_SearchIndex(in *v1.SearchIndex, out *SearchIndex, s conversion.Scope) error {
+	// WARNING: in.PodRequirements requires manual conversion: does not exist in peer-type
 	out.Create = (*bool)(unsafe.Pointer(in.Create))
 	if err := Convert_v1_TLS_To_v1alpha1_TLS(&in.TLS, &out.TLS, s); err != nil {
 		return err
@@ -1825,11 +1836,6 @@ func autoConvert_v1_SearchIndex_To_v1alpha1_SearchIndex(in *v1.SearchIndex, out
 	return nil
 }
 
-// Convert_v1_SearchIndex_To_v1alpha1_SearchIndex is an autogenerated conversion function.
-func Convert_v1_SearchIndex_To_v1alpha1_SearchIndex(in *v1.SearchIndex, out *SearchIndex, s conversion.Scope) error {
-	return autoConvert_v1_SearchIndex_To_v1alpha1_SearchIndex(in, out, s)
-}
-
 func autoConvert_v1alpha1_SearchIndexStatus_To_v1_SearchIndexStatus(in *SearchIndexStatus, out *v1.SearchIndexStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha1_TLS_To_v1_TLS(&in.TLS, &out.TLS, s); err != nil {
 		return err
@@ -1926,14 +1932,10 @@ func Convert_v1alpha1_SecuresignFulcioStatus_To_v1_SecuresignFulcioStatus(in *Se
 
 func autoConvert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus(in *v1.SecuresignFulcioStatus, out *SecuresignFulcioStatus, s conversion.Scope) error {
 	out.Url = in.Url
+	// WARNING: in.GrpcUrl requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus is an autogenerated conversion function.
-func Convert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus(in *v1.SecuresignFulcioStatus, out *SecuresignFulcioStatus, s conversion.Scope) error {
-	return autoConvert_v1_SecuresignFulcioStatus_To_v1alpha1_SecuresignFulcioStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_SecuresignList_To_v1_SecuresignList(in *SecuresignList, out *v1.SecuresignList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -2030,9 +2032,11 @@ func Convert_v1alpha1_SecuresignSpec_To_v1_SecuresignSpec(in *SecuresignSpec, ou
 }
 
 func autoConvert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(in *v1.SecuresignSpec, out *SecuresignSpec, s conversion.Scope) error {
+	// WARNING: in.RekorVersion requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_RekorSpec_To_v1alpha1_RekorSpec(&in.Rekor, &out.Rekor, s); err != nil {
 		return err
 	}
+	// WARNING: in.RekorV2 requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_FulcioSpec_To_v1alpha1_FulcioSpec(&in.Fulcio, &out.Fulcio, s); err != nil {
 		return err
 	}
@@ -2054,14 +2058,11 @@ func autoConvert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(in *v1.SecuresignS
 	} else {
 		out.TimestampAuthority = nil
 	}
+	// WARNING: in.Console requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec is an autogenerated conversion function.
-func Convert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(in *v1.SecuresignSpec, out *SecuresignSpec, s conversion.Scope) error {
-	return autoConvert_v1_SecuresignSpec_To_v1alpha1_SecuresignSpec(in, out, s)
-}
-
 func autoConvert_v1alpha1_SecuresignStatus_To_v1_SecuresignStatus(in *SecuresignStatus, out *v1.SecuresignStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	if err := Convert_v1alpha1_SecuresignRekorStatus_To_v1_SecuresignRekorStatus(&in.RekorStatus, &out.RekorStatus, s); err != nil {
@@ -2098,14 +2099,11 @@ func autoConvert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus(in *v1.Secures
 	if err := Convert_v1_SecuresignTSAStatus_To_v1alpha1_SecuresignTSAStatus(&in.TSAStatus, &out.TSAStatus, s); err != nil {
 		return err
 	}
+	// WARNING: in.ConsoleStatus requires manual conversion: does not exist in peer-type
+	// WARNING: in.Components requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus is an autogenerated conversion function.
-func Convert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus(in *v1.SecuresignStatus, out *SecuresignStatus, s conversion.Scope) error {
-	return autoConvert_v1_SecuresignStatus_To_v1alpha1_SecuresignStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_SecuresignTSAStatus_To_v1_SecuresignTSAStatus(in *SecuresignTSAStatus, out *v1.SecuresignTSAStatus, s conversion.Scope) error {
 	out.Url = in.Url
 	return nil
@@ -2297,14 +2295,10 @@ func autoConvert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigne
 	} else {
 		out.Tink = nil
 	}
+	// WARNING: in.KeyAlgorithm requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigner is an autogenerated conversion function.
-func Convert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigner(in *v1.TimestampAuthoritySigner, out *TimestampAuthoritySigner, s conversion.Scope) error {
-	return autoConvert_v1_TimestampAuthoritySigner_To_v1alpha1_TimestampAuthoritySigner(in, out, s)
-}
-
 func autoConvert_v1alpha1_TimestampAuthoritySpec_To_v1_TimestampAuthoritySpec(in *TimestampAuthoritySpec, out *v1.TimestampAuthoritySpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_PodRequirements_To_v1_PodRequirements(&in.PodRequirements, &out.PodRequirements, s); err != nil {
 		return err
@@ -2336,6 +2330,8 @@ func autoConvert_v1_TimestampAuthoritySpec_To_v1alpha1_TimestampAuthoritySpec(in
 	if err := Convert_v1_MonitoringConfig_To_v1alpha1_MonitoringConfig(&in.Monitoring, &out.Monitoring, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.Autoscaling requires manual conversion: does not exist in peer-type
 	out.TrustedCA = (*LocalObjectReference)(unsafe.Pointer(in.TrustedCA))
 	// WARNING: in.Auth requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_NTPMonitoring_To_v1alpha1_NTPMonitoring(&in.NTPMonitoring, &out.NTPMonitoring, s); err != nil {
@@ -2375,6 +2371,8 @@ func autoConvert_v1_TimestampAuthorityStatus_To_v1alpha1_TimestampAuthorityStatu
 	}
 	out.Url = in.Url
 	// WARNING: in.CertificateChain requires manual conversion: does not exist in peer-type
+	// WARNING: in.Certificates requires manual conversion: does not exist in peer-type
+	// WARNING: in.TimeSynchronization requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -2464,6 +2462,7 @@ func autoConvert_v1alpha1_TrillianDB_To_v1_TrillianDB(in *TrillianDB, out *v1.Tr
 }
 
 func autoConvert_v1_TrillianDB_To_v1alpha1_TrillianDB(in *v1.TrillianDB, out *TrillianDB, s conversion.Scope) error {
+	// WARNING: in.PodRequirements requires manual conversion: does not exist in peer-type
 	out.Create = (*bool)(unsafe.Pointer(in.Create))
 	if err := Convert_v1_Pvc_To_v1alpha1_Pvc(&in.Pvc, &out.Pvc, s); err != nil {
 		return err
@@ -2476,11 +2475,6 @@ func autoConvert_v1_TrillianDB_To_v1alpha1_TrillianDB(in *v1.TrillianDB, out *Tr
 	return nil
 }
 
-// Convert_v1_TrillianDB_To_v1alpha1_TrillianDB is an autogenerated conversion function.
-func Convert_v1_TrillianDB_To_v1alpha1_TrillianDB(in *v1.TrillianDB, out *TrillianDB, s conversion.Scope) error {
-	return autoConvert_v1_TrillianDB_To_v1alpha1_TrillianDB(in, out, s)
-}
-
 func autoConvert_v1alpha1_TrillianList_To_v1_TrillianList(in *TrillianList, out *v1.TrillianList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -2575,14 +2569,10 @@ func autoConvert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner(in *v1.Trill
 	if err := Convert_v1_TLS_To_v1alpha1_TLS(&in.TLS, &out.TLS, s); err != nil {
 		return err
 	}
+	// WARNING: in.Sequencer requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner is an autogenerated conversion function.
-func Convert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner(in *v1.TrillianLogSigner, out *TrillianLogSigner, s conversion.Scope) error {
-	return autoConvert_v1_TrillianLogSigner_To_v1alpha1_TrillianLogSigner(in, out, s)
-}
-
 func autoConvert_v1alpha1_TrillianSpec_To_v1_TrillianSpec(in *TrillianSpec, out *v1.TrillianSpec, s conversion.Scope) error {
 	if err := Convert_v1alpha1_TrillianDB_To_v1_TrillianDB(&in.Db, &out.Db, s); err != nil {
 		return err
@@ -2615,6 +2605,7 @@ func autoConvert_v1_TrillianSpec_To_v1alpha1_TrillianSpec(in *v1.TrillianSpec, o
 	if err := Convert_v1_MonitoringConfig_To_v1alpha1_MonitoringConfig(&in.Monitoring, &out.Monitoring, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkPolicy requires manual conversion: does not exist in peer-type
 	if err := Convert_v1_TrillianLogServer_To_v1alpha1_TrillianLogServer(&in.LogServer, &out.LogServer, s); err != nil {
 		return err
 	}
@@ -2622,6 +2613,7 @@ func autoConvert_v1_TrillianSpec_To_v1alpha1_TrillianSpec(in *v1.TrillianSpec, o
 		return err
 	}
 	out.TrustedCA = (*LocalObjectReference)(unsafe.Pointer(in.TrustedCA))
+	// WARNING: in.Quota requires manual conversion: does not exist in peer-type
 	out.MaxRecvMessageSize = (*int64)(unsafe.Pointer(in.MaxRecvMessageSize))
 	out.Auth = (*Auth)(unsafe.Pointer(in.Auth))
 	// WARNING: in.PodExtensions requires manual conversion: does not exist in peer-type
@@ -2657,15 +2649,11 @@ func autoConvert_v1_TrillianStatus_To_v1alpha1_TrillianStatus(in *v1.TrillianSta
 	if err := Convert_v1_TrillianServiceStatus_To_v1alpha1_TrillianLogSigner(&in.LogSigner, &out.LogSigner, s); err != nil {
 		return err
 	}
+	// WARNING: in.Certificates requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1_TrillianStatus_To_v1alpha1_TrillianStatus is an autogenerated conversion function.
-func Convert_v1_TrillianStatus_To_v1alpha1_TrillianStatus(in *v1.TrillianStatus, out *TrillianStatus, s conversion.Scope) error {
-	return autoConvert_v1_TrillianStatus_To_v1alpha1_TrillianStatus(in, out, s)
-}
-
 func autoConvert_v1alpha1_TsaCertificateAuthority_To_v1_TsaCertificateAuthority(in *TsaCertificateAuthority, out *v1.TsaCertificateAuthority, s conversion.Scope) error {
 	out.CommonName = in.CommonName
 	out.OrganizationName = in.OrganizationName
@@ -2785,6 +2773,7 @@ func autoConvert_v1_TufSpec_To_v1alpha1_TufSpec(in *v1.TufSpec, out *TufSpec, s
 	if err := Convert_v1_Pvc_To_v1alpha1_TufPvc(&in.Pvc, &out.Pvc, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkPolicy requires manual conversion: does not exist in peer-type
 	// WARNING: in.Ctlog requires manual conversion: inconvertible types ([]github.com/securesign/operator/api/v1.TrustRootBinding vs github.com/securesign/operator/api/v1alpha1.CtlogService)
 	// WARNING: in.Fulcio requires manual conversion: inconvertible types ([]github.com/securesign/operator/api/v1.TrustRootBindingWithOIDC vs github.com/securesign/operator/api/v1alpha1.FulcioService)
 	// WARNING: in.Rekor requires manual conversion: inconvertible types ([]github.com/securesign/operator/api/v1.TrustRootBinding vs github.com/securesign/operator/api/v1alpha1.RekorService)
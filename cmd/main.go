@@ -35,6 +35,7 @@ import (
 	"github.com/securesign/operator/internal/utils/fips"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -73,8 +74,10 @@ import (
 	"github.com/securesign/operator/internal/controller/ctlog"
 	"github.com/securesign/operator/internal/controller/fulcio"
 	"github.com/securesign/operator/internal/controller/rekor"
+	"github.com/securesign/operator/internal/controller/rekorv2"
 	"github.com/securesign/operator/internal/controller/securesign"
 	"github.com/securesign/operator/internal/controller/trillian"
+	"github.com/securesign/operator/internal/controller/trilliantree"
 	"github.com/securesign/operator/internal/controller/tsa"
 	"github.com/securesign/operator/internal/controller/tuf"
 	//+kubebuilder:scaffold:imports
@@ -135,6 +138,15 @@ func main() {
 	utils.StringFlagOrEnv(&appconfig.IngressHostTemplate, "ingress-host-template", "INGRESS_HOST_TEMPLATE", appconfig.IngressHostTemplate,
 		"Default hostname template for non-OpenShift Ingress resources when Ingress.Host is not set. "+
 			"Uses Go fmt.Sprintf with %[1]s=service name, %[2]s=namespace. Ignored on OpenShift.")
+	utils.StringFlagOrEnv(&appconfig.NetworkPolicyIngressNamespace, "network-policy-ingress-namespace", "NETWORK_POLICY_INGRESS_NAMESPACE", appconfig.NetworkPolicyIngressNamespace,
+		"Namespace of the ingress controller admitted by generated NetworkPolicies on non-OpenShift clusters.")
+	utils.StringFlagOrEnv(&appconfig.NetworkPolicyMonitoringNamespace, "network-policy-monitoring-namespace", "NETWORK_POLICY_MONITORING_NAMESPACE", appconfig.NetworkPolicyMonitoringNamespace,
+		"Namespace of the Prometheus stack admitted to metrics ports by generated NetworkPolicies on non-OpenShift clusters.")
+	utils.StringListFlagOrEnv(&appconfig.WatchNamespaces, "watch-namespaces", "WATCH_NAMESPACE",
+		"Comma separated namespaces the operator watches. Empty (the default) watches all namespaces. "+
+			"When set, the operator only requires namespaced RBAC in the listed namespaces.")
+	utils.DurationListFlagOrEnv(&appconfig.CertificateExpiryThresholds, "certificate-expiry-thresholds", "CERTIFICATE_EXPIRY_THRESHOLDS",
+		"Comma separated remaining validity periods (e.g. 720h,168h,24h) at which managed certificates are reported as expiring.")
 	utils.RelatedImageFlag("trillian-log-signer-image", images.TrillianLogSigner, "The image used for trillian log signer.")
 	utils.RelatedImageFlag("trillian-log-server-image", images.TrillianServer, "The image used for trillian log server.")
 	utils.RelatedImageFlag("trillian-db-image", images.TrillianDb, "The image used for trillian's database.")
@@ -152,6 +164,8 @@ func main() {
 	utils.RelatedImageFlag("ctlog-monitor-image", images.CTLogMonitor, "The image used for ctlog monitor.")
 	utils.RelatedImageFlag("console-api-image", images.ConsoleApi, "The image used for the console backend (API).")
 	utils.RelatedImageFlag("console-ui-image", images.ConsoleUI, "The image used for the console UI.")
+	utils.RelatedImageFlag("console-auth-proxy-image", images.ConsoleAuthProxy, "The image used for the console OIDC auth proxy.")
+	utils.RelatedImageFlag("console-oauth-proxy-image", images.ConsoleOAuthProxy, "The image used for the console OpenShift OAuth proxy.")
 
 	klog.InitFlags(flag.CommandLine)
 	flag.Parse()
@@ -172,6 +186,10 @@ func main() {
 		setupLog.Info("Platform explicitly configured via flag/env", "openshift", appconfig.Openshift)
 	}
 
+	if kubernetes.IsNamespaceScoped() {
+		setupLog.Info("Watching selected namespaces only", "namespaces", appconfig.WatchNamespaces)
+	}
+
 	// Resolve the cluster TLS security profile once at startup, before the webhook and metrics
 	// servers are configured. A dedicated bootstrap client is used because the manager has not
 	// started yet. On vanilla Kubernetes (no configv1.APIServer) or when the flag is set,
@@ -242,18 +260,34 @@ func main() {
 
 	cacheOpts := cache.Options{
 		ByObject: map[client.Object]cache.ByObject{
-			&appsv1.Deployment{}:    operatorCacheSelector,
-			&appsv1.ReplicaSet{}:    operatorCacheSelector,
-			&appsv1.StatefulSet{}:   operatorCacheSelector,
-			&corev1.Pod{}:           operatorCacheSelector,
-			&corev1.Service{}:       operatorCacheSelector,
-			&networkingv1.Ingress{}: operatorCacheSelector,
-			&batchv1.CronJob{}:      operatorCacheSelector,
-			&batchv1.Job{}:          operatorCacheSelector,
+			&appsv1.Deployment{}:                     operatorCacheSelector,
+			&appsv1.ReplicaSet{}:                     operatorCacheSelector,
+			&appsv1.StatefulSet{}:                    operatorCacheSelector,
+			&corev1.Pod{}:                            operatorCacheSelector,
+			&corev1.Service{}:                        operatorCacheSelector,
+			&networkingv1.Ingress{}:                  operatorCacheSelector,
+			&networkingv1.NetworkPolicy{}:            operatorCacheSelector,
+			&batchv1.CronJob{}:                       operatorCacheSelector,
+			&autoscalingv2.HorizontalPodAutoscaler{}: operatorCacheSelector,
+			&batchv1.Job{}:                           operatorCacheSelector,
 		},
 	}
 
-	if kubernetes.IsOpenShift() {
+	// Restrict all namespaced informers to the watched namespaces. Cluster-scoped objects are not
+	// affected by DefaultNamespaces, see the OpenShift ingress config below.
+	uncachedObjects := []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}}
+	if kubernetes.IsNamespaceScoped() {
+		cacheOpts.DefaultNamespaces = make(map[string]cache.Config, len(appconfig.WatchNamespaces))
+		for _, ns := range appconfig.WatchNamespaces {
+			cacheOpts.DefaultNamespaces[ns] = cache.Config{}
+		}
+	}
+
+	if kubernetes.IsOpenShift() && kubernetes.IsNamespaceScoped() {
+		// Read the cluster ingress config live, so a missing permission surfaces as Forbidden
+		// (and falls back to the ingress host template) instead of a never-syncing informer.
+		uncachedObjects = append(uncachedObjects, &configv1.Ingress{})
+	} else if kubernetes.IsOpenShift() {
 		// Configure the manager's cache.
 		// We must explicitly configure the cache for config.openshift.io/ingresses to watch only the "cluster" resource.
 		// This is because the operator's ClusterRole has permissions restricted to that specific resource name, and a full
@@ -263,7 +297,7 @@ func main() {
 				"metadata.name": "cluster",
 			}),
 		}
-		if !appconfig.DisableClusterTLSProfile {
+		if watchClusterTLSProfile() {
 			// Restrict the APIServer cache to the single "cluster" object.
 			// The ClusterRole only grants access to this named resource, so a
 			// full cluster-wide list would be forbidden.
@@ -316,10 +350,7 @@ func main() {
 		// keeps reads live instead, avoiding an unfiltered, cluster-wide cache.
 		Client: client.Options{
 			Cache: &client.CacheOptions{
-				DisableFor: []client.Object{
-					&corev1.Secret{},
-					&corev1.ConfigMap{},
-				},
+				DisableFor: uncachedObjects,
 			},
 		},
 	})
@@ -331,7 +362,7 @@ func main() {
 	// Watch the cluster TLS security profile for changes. When the profile or adherence
 	// policy changes, cancel() triggers a graceful shutdown so the operator restarts
 	// and picks up the new configuration.
-	if appconfig.Openshift && !appconfig.DisableClusterTLSProfile {
+	if watchClusterTLSProfile() {
 		if err := (&ostls.SecurityProfileWatcher{
 			Client:                    mgr.GetClient(),
 			InitialTLSProfileSpec:     tlsProfileSpec,
@@ -356,10 +387,12 @@ func main() {
 	setupController("fulcio", fulcio.NewReconciler, mgr)
 	setupController("trillian", trillian.NewReconciler, mgr)
 	setupController("rekor", rekor.NewReconciler, mgr)
+	setupController("rekorv2", rekorv2.NewReconciler, mgr)
 	setupController("tuf", tuf.NewReconciler, mgr)
 	setupController("ctlog", ctlog.NewReconciler, mgr)
 	setupController("tsa", tsa.NewReconciler, mgr)
 	setupController("console", console.NewReconciler, mgr)
+	setupController("trilliantree", trilliantree.NewReconciler, mgr)
 	//+kubebuilder:scaffold:builder
 
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
@@ -371,10 +404,12 @@ func main() {
 		setupWebhook("Fulcio", rhtasv1.SetupFulcioWebhookWithManager, mgr)
 		setupWebhook("Trillian", rhtasv1.SetupTrillianWebhookWithManager, mgr)
 		setupWebhook("Rekor", rhtasv1.SetupRekorWebhookWithManager, mgr)
+		setupWebhook("RekorV2", rhtasv1.SetupRekorV2WebhookWithManager, mgr)
 		setupWebhook("Tuf", rhtasv1.SetupTufWebhookWithManager, mgr)
 		setupWebhook("CTlog", rhtasv1.SetupCTlogWebhookWithManager, mgr)
 		setupWebhook("TimestampAuthority", rhtasv1.SetupTimestampAuthorityWebhookWithManager, mgr)
 		setupWebhook("Console", rhtasv1.SetupConsoleWebhookWithManager, mgr)
+		setupWebhook("TrillianTree", rhtasv1.SetupTrillianTreeWebhookWithManager, mgr)
 	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -424,6 +459,9 @@ func resolveClusterTLSProfile(ctx context.Context, cli client.Client, openshift,
 		if apiErrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
 			log.Info("config.openshift.io APIServer not available; using Intermediate TLS defaults")
 			tlsProfileSpec = intermediateSpec
+		} else if apiErrors.IsForbidden(err) {
+			log.Info("not allowed to read config.openshift.io APIServer; using Intermediate TLS defaults")
+			tlsProfileSpec = intermediateSpec
 		} else {
 			return configv1.TLSProfileSpec{}, "", fmt.Errorf("unable to fetch cluster TLS security profile: %w", err)
 		}
@@ -443,6 +481,13 @@ func resolveClusterTLSProfile(ctx context.Context, cli client.Client, openshift,
 	return tlsProfileSpec, tlsAdherence, nil
 }
 
+// watchClusterTLSProfile reports whether the operator follows changes of the cluster TLS security profile.
+// A namespace-scoped install resolves the profile once at startup (if permitted) but does not watch
+// the cluster-scoped APIServer resource.
+func watchClusterTLSProfile() bool {
+	return appconfig.Openshift && !appconfig.DisableClusterTLSProfile && !kubernetes.IsNamespaceScoped()
+}
+
 func setupController(name string, constructor controller.Constructor, manager ctrl.Manager) {
 	if err := constructor(
 		manager.GetClient(),
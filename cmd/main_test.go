@@ -121,6 +121,30 @@ func TestResolveClusterTLSProfile_APIServerNotFound(t *testing.T) {
 	g.Expect(adherence).To(gomega.Equal(configv1.TLSAdherencePolicyNoOpinion))
 }
 
+// resolveClusterTLSProfile: a namespace-scoped install without access to the APIServer resource
+// falls back to Intermediate defaults instead of aborting startup.
+func TestResolveClusterTLSProfile_Forbidden(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewWithT(t)
+	intermediate := *configv1.TLSProfiles[configv1.TLSProfileIntermediateType]
+
+	cli := fake.NewClientBuilder().
+		WithScheme(tlsTestScheme(t)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+				return apierrors.NewForbidden(configv1.GroupVersion.WithResource("apiservers").GroupResource(), key.Name, errors.New("denied"))
+			},
+		}).
+		Build()
+
+	profile, adherence, err := resolveClusterTLSProfile(
+		context.Background(), cli, true, false, logr.Discard())
+
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(profile.MinTLSVersion).To(gomega.Equal(intermediate.MinTLSVersion))
+	g.Expect(adherence).To(gomega.Equal(configv1.TLSAdherencePolicyNoOpinion))
+}
+
 // resolveClusterTLSProfile: an unexpected error fetching the profile aborts startup.
 func TestResolveClusterTLSProfile_ProfileFetchError(t *testing.T) {
 	t.Parallel()
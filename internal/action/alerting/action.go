@@ -0,0 +1,157 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewAction creates a generic alerting action that manages a Prometheus
+// PrometheusRule for a component.
+func NewAction[T apis.ConditionsAwareObject](
+	componentName string,
+	monitoringRoleName string,
+	prometheusRuleName string,
+	conditionType string,
+	cfg Config[T],
+) action.Action[T] {
+	return &alertingAction[T]{
+		componentName:      componentName,
+		monitoringRoleName: monitoringRoleName,
+		prometheusRuleName: prometheusRuleName,
+		conditionType:      conditionType,
+		cfg:                cfg,
+	}
+}
+
+type alertingAction[T apis.ConditionsAwareObject] struct {
+	action.BaseAction
+	componentName      string
+	monitoringRoleName string
+	prometheusRuleName string
+	conditionType      string
+	cfg                Config[T]
+}
+
+func (a *alertingAction[T]) Name() string {
+	return "create prometheus rule"
+}
+
+func (a *alertingAction[T]) CanHandle(_ context.Context, instance T) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (a *alertingAction[T]) Handle(ctx context.Context, instance T) *action.Result {
+	pr := kubernetes.CreatePrometheusRule(instance.GetNamespace(), a.prometheusRuleName)
+	cfg := a.cfg.PrometheusRule(instance)
+
+	if !utils.IsEnabled(cfg.Enabled) {
+		if err := a.Client.Delete(ctx, pr); err != nil {
+			if client.IgnoreNotFound(err) == nil || meta.IsNoMatchError(err) {
+				return a.Continue()
+			}
+			return a.handleError(ctx, fmt.Errorf("%w: %w", ErrPrometheusRuleDelete, err), instance)
+		}
+		return a.Continue()
+	}
+
+	rules, err := RenderRules(instance.GetNamespace(), a.cfg.Rules(instance), cfg.Alerts)
+	if err != nil {
+		return a.handleError(ctx, reconcile.TerminalError(err), instance)
+	}
+
+	ruleLabels := labels.For(a.componentName, a.monitoringRoleName, instance.GetName())
+	if _, err = kubernetes.CreateOrUpdate(ctx, a.Client, pr,
+		ensure.ControllerReference[*unstructured.Unstructured](instance, a.Client),
+		ensure.Labels[*unstructured.Unstructured](slices.Collect(maps.Keys(ruleLabels)), ruleLabels),
+		kubernetes.EnsurePrometheusRuleSpec(a.prometheusRuleName+".rules", rules...),
+	); err != nil {
+		if meta.IsNoMatchError(err) {
+			return a.handleError(ctx, fmt.Errorf("%w: %w", ErrPrometheusRuleCRDMissing, err), instance)
+		}
+		return a.handleError(ctx, fmt.Errorf("%w: %w", ErrPrometheusRuleCreate, err), instance)
+	}
+
+	return a.Continue()
+}
+
+func (a *alertingAction[T]) handleError(ctx context.Context, err error, instance T) *action.Result {
+	if a.conditionType != "" {
+		return a.Error(ctx, err, instance, metav1.Condition{
+			Type:    a.conditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Failure.String(),
+			Message: err.Error(),
+		})
+	}
+	return a.Error(ctx, err, instance)
+}
+
+// RenderRules applies the CR overrides to the curated rules and renders them
+// for the namespace. Overrides of alerts that are not among rules are rejected.
+func RenderRules(namespace string, rules []Rule, overrides []rhtasv1.AlertOverride) ([]kubernetes.PrometheusAlertingRule, error) {
+	byName := make(map[string]Rule, len(rules))
+	for _, r := range rules {
+		byName[r.Alert] = r
+	}
+	for _, o := range overrides {
+		if _, ok := byName[o.Alert]; !ok {
+			return nil, fmt.Errorf("%w %q, supported alerts: %v", ErrUnknownAlert, o.Alert, slices.Sorted(maps.Keys(byName)))
+		}
+	}
+
+	result := make([]kubernetes.PrometheusAlertingRule, 0, len(rules))
+	for _, r := range rules {
+		threshold, pending := r.Threshold, r.For
+		if i := slices.IndexFunc(overrides, func(o rhtasv1.AlertOverride) bool { return o.Alert == r.Alert }); i >= 0 {
+			if overrides[i].Threshold != nil {
+				threshold = *overrides[i].Threshold
+			}
+			if overrides[i].For != nil {
+				pending = formatDuration(overrides[i].For.Duration)
+			}
+		}
+		result = append(result, kubernetes.PrometheusAlertingRule{
+			Alert:  r.Alert,
+			Expr:   r.Expr(namespace, threshold),
+			For:    pending,
+			Labels: map[string]string{"severity": r.Severity},
+			Annotations: map[string]string{
+				"summary":     r.Summary,
+				"description": r.Description,
+			},
+		})
+	}
+	return result, nil
+}
+
+// formatDuration renders d in the Prometheus duration format.
+func formatDuration(d time.Duration) string {
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%ds", d/time.Second)
+	default:
+		return fmt.Sprintf("%dms", d/time.Millisecond)
+	}
+}
@@ -0,0 +1,219 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	"github.com/securesign/operator/internal/utils"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	testComponent      = "test-component"
+	testMonitoringRole = "prometheus-k8s-test"
+	testRule           = "test-server"
+	testNamespace      = "test-ns"
+	testInstanceName   = "test-instance"
+)
+
+var prGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+
+type testConfig struct {
+	cfg rhtasv1.PrometheusRuleConfig
+}
+
+func (c testConfig) PrometheusRule(*rhtasv1.Fulcio) rhtasv1.PrometheusRuleConfig { return c.cfg }
+func (c testConfig) Rules(*rhtasv1.Fulcio) []Rule                                { return testRules() }
+
+func testRules() []Rule {
+	return []Rule{
+		{
+			Alert:     "TestErrors",
+			Threshold: "0.05",
+			For:       "10m",
+			Severity:  SeverityCritical,
+			Summary:   "summary",
+			Expr: func(namespace, threshold string) string {
+				return fmt.Sprintf(`errors{namespace=%q} > %s`, namespace, threshold)
+			},
+		},
+		CertificateExpiryRule("TestCertificateExpiring", "Fulcio", testInstanceName),
+	}
+}
+
+func newTestInstance() *rhtasv1.Fulcio {
+	instance := &rhtasv1.Fulcio{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInstanceName, Namespace: testNamespace, Generation: 1,
+		},
+	}
+	apimeta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Creating.String(),
+	})
+	return instance
+}
+
+func existingRule() *unstructured.Unstructured {
+	pr := &unstructured.Unstructured{}
+	pr.SetGroupVersionKind(prGVK)
+	pr.SetName(testRule)
+	pr.SetNamespace(testNamespace)
+	return pr
+}
+
+func getRules(ctx context.Context, g Gomega, cli client.Client) (*unstructured.Unstructured, []any) {
+	pr := existingRule()
+	g.Expect(cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testRule}, pr)).To(Succeed())
+	groups, found, err := unstructured.NestedSlice(pr.Object, "spec", "groups")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(found).To(BeTrue())
+	g.Expect(groups).To(HaveLen(1))
+	g.Expect(groups[0].(map[string]any)["name"]).To(Equal(testRule + ".rules"))
+	return pr, groups[0].(map[string]any)["rules"].([]any)
+}
+
+func TestHandle(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       rhtasv1.PrometheusRuleConfig
+		objects   []client.Object
+		intercept interceptor.Funcs
+		verify    func(context.Context, Gomega, client.WithWatch)
+		wantErr   bool
+		terminal  bool
+	}{
+		{
+			name: "enabled — creates PrometheusRule with default thresholds",
+			cfg:  rhtasv1.PrometheusRuleConfig{Enabled: utils.Pointer(true)},
+			verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+				pr, rules := getRules(ctx, g, cli)
+				g.Expect(pr.GetLabels()).To(Equal(labels.For(testComponent, testMonitoringRole, testInstanceName)))
+				g.Expect(pr.GetOwnerReferences()).To(HaveLen(1))
+				g.Expect(rules).To(HaveLen(2))
+
+				r := rules[0].(map[string]any)
+				g.Expect(r["alert"]).To(Equal("TestErrors"))
+				g.Expect(r["expr"]).To(Equal(`errors{namespace="test-ns"} > 0.05`))
+				g.Expect(r["for"]).To(Equal("10m"))
+				g.Expect(r["labels"]).To(HaveKeyWithValue("severity", SeverityCritical))
+
+				r = rules[1].(map[string]any)
+				g.Expect(r["expr"]).To(ContainSubstring(`kind="Fulcio",namespace="test-ns",name="test-instance"`))
+				g.Expect(r["expr"]).To(HaveSuffix("< 30"))
+			},
+		},
+		{
+			name: "enabled — applies overrides",
+			cfg: rhtasv1.PrometheusRuleConfig{
+				Enabled: utils.Pointer(true),
+				Alerts: []rhtasv1.AlertOverride{
+					{Alert: "TestErrors", Threshold: utils.Pointer("0.1"), For: &metav1.Duration{Duration: 90 * time.Second}},
+					{Alert: "TestCertificateExpiring", Threshold: utils.Pointer("14")},
+				},
+			},
+			objects: []client.Object{existingRule()},
+			verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+				_, rules := getRules(ctx, g, cli)
+				r := rules[0].(map[string]any)
+				g.Expect(r["expr"]).To(HaveSuffix("> 0.1"))
+				g.Expect(r["for"]).To(Equal("90s"))
+				r = rules[1].(map[string]any)
+				g.Expect(r["expr"]).To(HaveSuffix("< 14"))
+				g.Expect(r["for"]).To(Equal("1h"))
+			},
+		},
+		{
+			name: "enabled — unknown alert override is terminal",
+			cfg: rhtasv1.PrometheusRuleConfig{
+				Enabled: utils.Pointer(true),
+				Alerts:  []rhtasv1.AlertOverride{{Alert: "Unknown"}},
+			},
+			wantErr:  true,
+			terminal: true,
+		},
+		{
+			name: "enabled — CRD missing",
+			cfg:  rhtasv1.PrometheusRuleConfig{Enabled: utils.Pointer(true)},
+			intercept: interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if obj.GetObjectKind().GroupVersionKind().Kind == "PrometheusRule" {
+						return &apimeta.NoKindMatchError{GroupKind: prGVK.GroupKind(), SearchedVersions: []string{"v1"}}
+					}
+					return c.Get(ctx, key, obj, opts...)
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "disabled — deletes existing PrometheusRule",
+			objects: []client.Object{existingRule()},
+			verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+				err := cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testRule}, existingRule())
+				g.Expect(err).To(HaveOccurred())
+			},
+		},
+		{
+			name: "disabled — CRD not installed, continues",
+			intercept: interceptor.Funcs{
+				Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+					return &apimeta.NoKindMatchError{GroupKind: prGVK.GroupKind(), SearchedVersions: []string{"v1"}}
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			instance := newTestInstance()
+			cli := testAction.FakeClientBuilder().
+				WithObjects(instance).
+				WithObjects(tt.objects...).
+				WithStatusSubresource(instance).
+				WithInterceptorFuncs(tt.intercept).
+				Build()
+
+			a := testAction.PrepareAction(cli, NewAction(testComponent, testMonitoringRole, testRule, "", testConfig{cfg: tt.cfg}))
+			g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+
+			got := a.Handle(ctx, instance)
+			if tt.wantErr {
+				g.Expect(got).ToNot(BeNil())
+				g.Expect(got.Err).To(HaveOccurred())
+				if tt.terminal {
+					g.Expect(got.Err).To(MatchError(reconcile.TerminalError(nil)))
+					g.Expect(got.Err).To(MatchError(ErrUnknownAlert))
+				}
+			} else {
+				g.Expect(got).To(Equal(testAction.Continue()))
+			}
+			if tt.verify != nil {
+				tt.verify(ctx, g, cli)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(formatDuration(2 * time.Hour)).To(Equal("2h"))
+	g.Expect(formatDuration(15 * time.Minute)).To(Equal("15m"))
+	g.Expect(formatDuration(90 * time.Second)).To(Equal("90s"))
+	g.Expect(formatDuration(1500 * time.Millisecond)).To(Equal("1500ms"))
+}
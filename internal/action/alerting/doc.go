@@ -0,0 +1,26 @@
+// Package alerting provides a generic action for managing Prometheus
+// PrometheusRule resources with curated alerts for operator components.
+//
+// The action creates or deletes a PrometheusRule based on the component's
+// monitoring.prometheusRule configuration:
+//
+//   - Enabled: creates or updates the PrometheusRule with one rule group
+//     holding the component alerts returned by [Config.Rules].
+//   - Disabled: deletes the PrometheusRule if it exists.
+//   - CRD missing: returns a retriable error on create, silently ignores on delete.
+//
+// Each alert compares its expression with a numeric threshold. The default
+// threshold and pending period of an alert can be overridden from the CR
+// by alert name; overrides of unknown alerts fail the reconciliation with a
+// terminal error.
+//
+// Usage:
+//
+//	func NewPrometheusRuleAction() action.Action[*rhtasv1.Fulcio] {
+//	    return alerting.NewAction(
+//	        ComponentName, MonitoringRoleName, DeploymentName,
+//	        "", // conditionType: empty = no status condition on error
+//	        fulcioAlertingConfig{},
+//	    )
+//	}
+package alerting
@@ -0,0 +1,20 @@
+package alerting
+
+import "errors"
+
+var (
+	// ErrPrometheusRuleCRDMissing is returned when PrometheusRule creation is
+	// requested but the monitoring.coreos.com CRD is not installed.
+	ErrPrometheusRuleCRDMissing = errors.New("PrometheusRule CRD is not installed; install the Prometheus Operator or set monitoring.prometheusRule.enabled=false")
+
+	// ErrPrometheusRuleCreate is returned when creating or updating the
+	// PrometheusRule resource fails.
+	ErrPrometheusRuleCreate = errors.New("could not create prometheusRule")
+
+	// ErrPrometheusRuleDelete is returned when deleting the PrometheusRule
+	// resource fails.
+	ErrPrometheusRuleDelete = errors.New("could not delete prometheusRule")
+
+	// ErrUnknownAlert is returned when the CR overrides an alert the component does not define.
+	ErrUnknownAlert = errors.New("unknown alert")
+)
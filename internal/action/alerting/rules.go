@@ -0,0 +1,37 @@
+package alerting
+
+import "fmt"
+
+// CertificateExpiryRule alerts when a certificate the operator publishes for
+// the instance (rhtas_operator_certificate_expiry_timestamp_seconds) expires
+// within threshold days.
+func CertificateExpiryRule(alert, kind, name string) Rule {
+	return Rule{
+		Alert:       alert,
+		Threshold:   "30",
+		For:         "1h",
+		Severity:    SeverityWarning,
+		Summary:     fmt.Sprintf("%s certificate expires soon", kind),
+		Description: "Certificate {{ $labels.certificate }} expires in {{ $value | humanize }} days.",
+		Expr: func(namespace, threshold string) string {
+			return fmt.Sprintf(`(rhtas_operator_certificate_expiry_timestamp_seconds{kind=%q,namespace=%q,name=%q} - time()) / 86400 < %s`,
+				kind, namespace, name, threshold)
+		},
+	}
+}
+
+// MonitorInconsistencyRule alerts when the transparency log monitor served by
+// service reports more than threshold failed log verifications in 30 minutes.
+func MonitorInconsistencyRule(alert, service string) Rule {
+	return Rule{
+		Alert:       alert,
+		Threshold:   "0",
+		Severity:    SeverityCritical,
+		Summary:     "Transparency log monitor detected an inconsistency",
+		Description: "The log monitor {{ $labels.pod }} failed to verify the consistency of the log {{ $value }} times in the last 30 minutes.",
+		Expr: func(namespace, threshold string) string {
+			return fmt.Sprintf(`increase(log_index_verification_failure{namespace=%q,service=%q}[30m]) > %s`,
+				namespace, service, threshold)
+		},
+	}
+}
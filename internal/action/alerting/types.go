@@ -0,0 +1,42 @@
+package alerting
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/apis"
+)
+
+// Severity labels attached to the curated alerts.
+const (
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Rule is a curated alert of a component.
+type Rule struct {
+	// Alert is the alert name, also used to match overrides from the CR.
+	Alert string
+	// Expr renders the PromQL expression for the instance namespace and the threshold.
+	Expr func(namespace, threshold string) string
+	// Threshold is the default threshold.
+	Threshold string
+	// For is the default pending period.
+	For string
+	// Severity is attached as the severity label.
+	Severity string
+	// Summary is attached as the summary annotation.
+	Summary string
+	// Description is attached as the description annotation.
+	// It may use Prometheus alert templating ({{ $value }}, {{ $labels }}).
+	Description string
+}
+
+// Config defines component-specific behavior that depends on the CRD instance.
+// Static naming and labeling are passed as constructor parameters to [NewAction].
+type Config[T apis.ConditionsAwareObject] interface {
+	// PrometheusRule returns the prometheusRule configuration of the instance.
+	PrometheusRule(instance T) rhtasv1.PrometheusRuleConfig
+
+	// Rules returns the curated alerts of the component. Features disabled on
+	// the instance should not contribute alerts.
+	Rules(instance T) []Rule
+}
@@ -0,0 +1,115 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewAction creates a generic action that manages a HorizontalPodAutoscaler
+// for the Deployment of a component.
+func NewAction[T apis.ConditionsAwareObject](
+	componentName string,
+	deploymentName string,
+	cfg Config[T],
+) action.Action[T] {
+	return &autoscalingAction[T]{
+		componentName:  componentName,
+		deploymentName: deploymentName,
+		cfg:            cfg,
+	}
+}
+
+type autoscalingAction[T apis.ConditionsAwareObject] struct {
+	action.BaseAction
+	componentName  string
+	deploymentName string
+	cfg            Config[T]
+}
+
+func (a *autoscalingAction[T]) Name() string {
+	return "create autoscaler"
+}
+
+func (a *autoscalingAction[T]) CanHandle(_ context.Context, instance T) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (a *autoscalingAction[T]) Handle(ctx context.Context, instance T) *action.Result {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.deploymentName,
+			Namespace: instance.GetNamespace(),
+		},
+	}
+
+	cfg := a.cfg.Autoscaling(instance)
+	if !utils.IsEnabled(cfg.Enabled) {
+		if err := a.Client.Delete(ctx, hpa); client.IgnoreNotFound(err) != nil {
+			return a.Error(ctx, fmt.Errorf("%w: %w", ErrHPADelete, err), instance)
+		}
+		return a.Continue()
+	}
+
+	hpaLabels := labels.For(a.componentName, a.deploymentName, instance.GetName())
+
+	if _, err := kubernetes.CreateOrUpdate(ctx, a.Client, hpa,
+		ensure.ControllerReference[*autoscalingv2.HorizontalPodAutoscaler](instance, a.Client),
+		ensure.Labels[*autoscalingv2.HorizontalPodAutoscaler](slices.Collect(maps.Keys(hpaLabels)), hpaLabels),
+		a.ensureSpec(cfg),
+	); err != nil {
+		return a.Error(ctx, fmt.Errorf("%w: %w", ErrHPACreate, err), instance)
+	}
+
+	return a.Continue()
+}
+
+func (a *autoscalingAction[T]) ensureSpec(cfg rhtasv1.Autoscaling) func(*autoscalingv2.HorizontalPodAutoscaler) error {
+	return func(hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+		hpa.Spec.ScaleTargetRef = autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       a.deploymentName,
+		}
+		hpa.Spec.MinReplicas = cfg.MinReplicas
+		hpa.Spec.MaxReplicas = cfg.MaxReplicas
+
+		var metrics []autoscalingv2.MetricSpec
+		if cfg.TargetCPUUtilizationPercentage != nil {
+			metrics = append(metrics, resourceMetric(corev1.ResourceCPU, *cfg.TargetCPUUtilizationPercentage))
+		}
+		if cfg.TargetMemoryUtilizationPercentage != nil {
+			metrics = append(metrics, resourceMetric(corev1.ResourceMemory, *cfg.TargetMemoryUtilizationPercentage))
+		}
+		hpa.Spec.Metrics = append(metrics, cfg.Metrics...)
+		return nil
+	}
+}
+
+func resourceMetric(name corev1.ResourceName, utilization int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
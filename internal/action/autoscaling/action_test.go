@@ -0,0 +1,210 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testComponent    = "test-component"
+	testDeployment   = "test-server"
+	testNamespace    = "test-ns"
+	testInstanceName = "test-instance"
+)
+
+type testConfig struct {
+	autoscaling rhtasv1.Autoscaling
+}
+
+func (c testConfig) Autoscaling(*rhtasv1.Fulcio) rhtasv1.Autoscaling { return c.autoscaling }
+
+func newTestInstance(conditions ...metav1.Condition) *rhtasv1.Fulcio {
+	instance := &rhtasv1.Fulcio{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInstanceName, Namespace: testNamespace, Generation: 1,
+		},
+	}
+	for _, c := range conditions {
+		apimeta.SetStatusCondition(&instance.Status.Conditions, c)
+	}
+	return instance
+}
+
+func getHPA(ctx context.Context, g Gomega, cli client.Client) *autoscalingv2.HorizontalPodAutoscaler {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	g.Expect(cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testDeployment}, hpa)).To(Succeed())
+	return hpa
+}
+
+func TestCanHandle(t *testing.T) {
+	tests := []struct {
+		name      string
+		reason    state.State
+		canHandle bool
+	}{
+		{name: "Pending state", reason: state.Pending, canHandle: false},
+		{name: "Creating state", reason: state.Creating, canHandle: true},
+		{name: "Ready state", reason: state.Ready, canHandle: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := testAction.FakeClientBuilder().Build()
+			a := testAction.PrepareAction(c, NewAction(testComponent, testDeployment, testConfig{}))
+			instance := newTestInstance(metav1.Condition{
+				Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: tt.reason.String(),
+			})
+			g.Expect(a.CanHandle(t.Context(), instance)).To(Equal(tt.canHandle))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	enabled := rhtasv1.Autoscaling{
+		Enabled:                        ptr.To(true),
+		MinReplicas:                    ptr.To(int32(2)),
+		MaxReplicas:                    10,
+		TargetCPUUtilizationPercentage: ptr.To(int32(80)),
+	}
+
+	type env struct {
+		autoscaling rhtasv1.Autoscaling
+		objects     []client.Object
+		intercept   interceptor.Funcs
+	}
+	type want struct {
+		result  *action.Result
+		isError bool
+		verify  func(context.Context, Gomega, client.WithWatch)
+	}
+	tests := []struct {
+		name string
+		env  env
+		want want
+	}{
+		{
+			name: "enabled — creates HPA targeting the deployment",
+			env:  env{autoscaling: enabled},
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+					hpa := getHPA(ctx, g, cli)
+					g.Expect(hpa.Labels).To(Equal(labels.For(testComponent, testDeployment, testInstanceName)))
+					g.Expect(hpa.Spec.ScaleTargetRef.Kind).To(Equal("Deployment"))
+					g.Expect(hpa.Spec.ScaleTargetRef.Name).To(Equal(testDeployment))
+					g.Expect(hpa.Spec.MinReplicas).To(Equal(ptr.To(int32(2))))
+					g.Expect(hpa.Spec.MaxReplicas).To(Equal(int32(10)))
+					g.Expect(hpa.Spec.Metrics).To(HaveLen(1))
+					g.Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceCPU))
+					g.Expect(hpa.Spec.Metrics[0].Resource.Target.AverageUtilization).To(Equal(ptr.To(int32(80))))
+					g.Expect(hpa.OwnerReferences).To(HaveLen(1))
+				},
+			},
+		},
+		{
+			name: "enabled — appends custom metrics",
+			env: env{autoscaling: rhtasv1.Autoscaling{
+				Enabled:                           ptr.To(true),
+				MaxReplicas:                       3,
+				TargetMemoryUtilizationPercentage: ptr.To(int32(70)),
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.PodsMetricSourceType,
+						Pods: &autoscalingv2.PodsMetricSource{
+							Metric: autoscalingv2.MetricIdentifier{Name: "http_requests_per_second"},
+						},
+					},
+				},
+			}},
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+					hpa := getHPA(ctx, g, cli)
+					g.Expect(hpa.Spec.Metrics).To(HaveLen(2))
+					g.Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceMemory))
+					g.Expect(hpa.Spec.Metrics[1].Pods.Metric.Name).To(Equal("http_requests_per_second"))
+				},
+			},
+		},
+		{
+			name: "disabled — deletes existing HPA",
+			env: env{objects: []client.Object{&autoscalingv2.HorizontalPodAutoscaler{
+				ObjectMeta: metav1.ObjectMeta{Name: testDeployment, Namespace: testNamespace},
+			}}},
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+					err := cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testDeployment}, &autoscalingv2.HorizontalPodAutoscaler{})
+					g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				},
+			},
+		},
+		{
+			name: "disabled — no HPA exists, continues",
+			want: want{result: testAction.Continue()},
+		},
+		{
+			name: "create failure — retriable error",
+			env: env{
+				autoscaling: enabled,
+				intercept: interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+						return fmt.Errorf("quota exceeded")
+					},
+				},
+			},
+			want: want{isError: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			instance := newTestInstance(metav1.Condition{
+				Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Creating.String(),
+			})
+
+			cli := testAction.FakeClientBuilder().
+				WithObjects(instance).
+				WithObjects(tt.env.objects...).
+				WithStatusSubresource(instance).
+				WithInterceptorFuncs(tt.env.intercept).
+				Build()
+
+			a := testAction.PrepareAction(cli, NewAction(testComponent, testDeployment, testConfig{autoscaling: tt.env.autoscaling}))
+
+			got := a.Handle(ctx, instance)
+
+			if tt.want.isError {
+				g.Expect(got).ToNot(BeNil())
+				g.Expect(got.Err).To(HaveOccurred())
+			} else if !reflect.DeepEqual(got, tt.want.result) {
+				t.Errorf("Handle() = %v, want %v", got, tt.want.result)
+			}
+
+			if tt.want.verify != nil {
+				tt.want.verify(ctx, g, cli)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package autoscaling provides a generic action for managing
+// HorizontalPodAutoscaler resources of stateless operator components.
+//
+// The action creates or deletes a HorizontalPodAutoscaler based on the
+// component's autoscaling configuration:
+//
+//   - Enabled: creates or updates the HorizontalPodAutoscaler targeting the
+//     component Deployment with the configured replica limits and metrics.
+//   - Disabled: deletes the HorizontalPodAutoscaler if it exists.
+//
+// The deployment action of an autoscaled component must wrap the ensure
+// functions that set spec.replicas with deployment.Autoscaling so the
+// operator does not fight the autoscaler.
+//
+// Usage:
+//
+//	func NewAutoscalingAction() action.Action[*rhtasv1.Fulcio] {
+//	    return autoscaling.NewAction(
+//	        ComponentName, DeploymentName,
+//	        fulcioAutoscalingConfig{},
+//	    )
+//	}
+package autoscaling
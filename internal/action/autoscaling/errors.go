@@ -0,0 +1,13 @@
+package autoscaling
+
+import "errors"
+
+var (
+	// ErrHPACreate is returned when creating or updating the
+	// HorizontalPodAutoscaler resource fails.
+	ErrHPACreate = errors.New("could not create horizontalPodAutoscaler")
+
+	// ErrHPADelete is returned when deleting the HorizontalPodAutoscaler
+	// resource fails.
+	ErrHPADelete = errors.New("could not delete horizontalPodAutoscaler")
+)
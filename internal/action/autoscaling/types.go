@@ -0,0 +1,13 @@
+package autoscaling
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/apis"
+)
+
+// Config defines component-specific behavior that depends on the CRD instance.
+// Static naming and labeling are passed as constructor parameters to [NewAction].
+type Config[T apis.ConditionsAwareObject] interface {
+	// Autoscaling returns the autoscaling configuration of the component.
+	Autoscaling(instance T) rhtasv1.Autoscaling
+}
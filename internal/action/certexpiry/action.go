@@ -0,0 +1,196 @@
+package certexpiry
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/config"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ExpiringCondition = "CertificateExpiring"
+
+	ReasonValid    = "Valid"
+	ReasonExpiring = "Expiring"
+	ReasonExpired  = "Expired"
+
+	maxRequeue = 24 * time.Hour
+	minRequeue = time.Minute
+)
+
+// NewAction creates a generic action that publishes the validity of the
+// component certificates and raises the CertificateExpiring condition.
+func NewAction[T apis.ConditionsAwareObject](cfg Config[T]) action.Action[T] {
+	return &certExpiryAction[T]{cfg: cfg}
+}
+
+type certExpiryAction[T apis.ConditionsAwareObject] struct {
+	action.BaseAction
+	cfg Config[T]
+}
+
+func (i certExpiryAction[T]) Name() string {
+	return "track certificate expiry"
+}
+
+func (i certExpiryAction[T]) CanHandle(_ context.Context, instance T) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Ready
+}
+
+func (i certExpiryAction[T]) Handle(ctx context.Context, instance T) *action.Result {
+	var (
+		statuses   []rhtasv1.CertificateStatus
+		expiring   *rhtasv1.CertificateStatus
+		now        = time.Now()
+		thresholds = sortedThresholds()
+	)
+
+	for _, c := range i.cfg.Certificates(instance) {
+		if c.Ref == nil {
+			continue
+		}
+		certs, err := i.readCertificates(ctx, instance.GetNamespace(), c.Ref)
+		if err != nil {
+			i.Logger.Error(err, "skipping certificate", "name", c.Name)
+			continue
+		}
+		for idx, cert := range certs {
+			name := c.Name
+			if len(certs) > 1 {
+				name = fmt.Sprintf("%s[%d]", c.Name, idx)
+			}
+			status := rhtasv1.CertificateStatus{
+				Name:         name,
+				Subject:      cert.Subject.String(),
+				SerialNumber: cert.SerialNumber.Text(16),
+				NotBefore:    metav1.NewTime(cert.NotBefore),
+				NotAfter:     metav1.NewTime(cert.NotAfter),
+			}
+			statuses = append(statuses, status)
+			if expiring == nil || status.NotAfter.Before(&expiring.NotAfter) {
+				expiring = &status
+			}
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               ExpiringCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             ReasonValid,
+		Message:            "No certificate is close to its expiry",
+		ObservedGeneration: instance.GetGeneration(),
+	}
+	requeue := maxRequeue
+	if expiring != nil {
+		remaining := expiring.NotAfter.Sub(now)
+		if crossed, ok := crossedThreshold(remaining, thresholds); ok {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = ReasonExpiring
+			condition.Message = fmt.Sprintf("Certificate %s (%s) expires in less than %s", expiring.Name, expiring.Subject, formatDuration(crossed))
+		}
+		if remaining <= 0 {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = ReasonExpired
+			condition.Message = fmt.Sprintf("Certificate %s (%s) expired at %s", expiring.Name, expiring.Subject, expiring.NotAfter.UTC().Format(time.RFC3339))
+		}
+		requeue = nextCheck(statuses, thresholds, now)
+	}
+
+	if current := meta.FindStatusCondition(instance.GetConditions(), ExpiringCondition); condition.Status == metav1.ConditionTrue &&
+		(current == nil || current.Reason != condition.Reason || current.Message != condition.Message) {
+		i.Recorder.Eventf(instance, nil, corev1.EventTypeWarning, "CertificateExpiring", "Expiry", "%s", condition.Message)
+	}
+
+	metrics.SetCertificateExpiry(instance, statuses)
+	if current := i.cfg.Status(instance); !equality.Semantic.DeepEqual(*current, statuses) {
+		*current = statuses
+	}
+	instance.SetCondition(condition)
+	if _, err := i.PersistStatus(ctx, instance); err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	return i.RequeueAfter(requeue)
+}
+
+func (i certExpiryAction[T]) readCertificates(ctx context.Context, namespace string, ref *rhtasv1.SecretKeySelector) ([]*x509.Certificate, error) {
+	data, err := kubernetes.GetSecretData(ctx, i.Client, namespace, ref)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s/%s: %w", ErrCertificateRead, ref.Name, ref.Key, err)
+	}
+	certs, err := ParseCertificates(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s/%s: %w", ErrCertificateParse, ref.Name, ref.Key, err)
+	}
+	return certs, nil
+}
+
+// ParseCertificates decodes all PEM CERTIFICATE blocks from data.
+func ParseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+func sortedThresholds() []time.Duration {
+	thresholds := slices.Clone(config.CertificateExpiryThresholds)
+	slices.Sort(thresholds)
+	return thresholds
+}
+
+// crossedThreshold returns the smallest threshold the remaining validity is within.
+func crossedThreshold(remaining time.Duration, thresholds []time.Duration) (time.Duration, bool) {
+	for _, t := range thresholds {
+		if remaining <= t {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// nextCheck returns the delay until the next certificate crosses a threshold or expires.
+func nextCheck(statuses []rhtasv1.CertificateStatus, thresholds []time.Duration, now time.Time) time.Duration {
+	next := maxRequeue
+	for _, s := range statuses {
+		remaining := s.NotAfter.Sub(now)
+		for _, t := range append([]time.Duration{0}, thresholds...) {
+			if d := remaining - t; d > 0 && d < next {
+				next = d
+			}
+		}
+	}
+	return max(next, minRequeue)
+}
+
+func formatDuration(d time.Duration) string {
+	if d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", d/(24*time.Hour))
+	}
+	return d.String()
+}
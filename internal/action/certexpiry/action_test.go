@@ -0,0 +1,242 @@
+package certexpiry
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+)
+
+const (
+	testNamespace    = "test-ns"
+	testInstanceName = "test-instance"
+	testSecret       = "test-cert"
+)
+
+type testConfig struct{}
+
+func (testConfig) Certificates(instance *rhtasv1.Fulcio) []Certificate {
+	return []Certificate{
+		{Name: "ca", Ref: instance.Status.Certificate.CARef},
+		{Name: "missing"},
+	}
+}
+
+func (testConfig) Status(instance *rhtasv1.Fulcio) *[]rhtasv1.CertificateStatus {
+	return &instance.Status.Certificates
+}
+
+func newTestInstance(readyReason state.State) *rhtasv1.Fulcio {
+	instance := &rhtasv1.Fulcio{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testNamespace, Generation: 1},
+		Status: rhtasv1.FulcioStatus{
+			Certificate: &rhtasv1.FulcioCertStatus{
+				CARef: &rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: testSecret},
+					Key:                  constants.KeyCert,
+				},
+			},
+		},
+	}
+	apimeta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: readyReason.String(),
+	})
+	return instance
+}
+
+func certSecret(validity ...time.Duration) *corev1.Secret {
+	var data []byte
+	for i, v := range validity {
+		data = append(data, createCert(i, v)...)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: testSecret, Namespace: testNamespace},
+		Data:       map[string][]byte{constants.KeyCert: data},
+	}
+}
+
+func createCert(serial int, validity time.Duration) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(int64(serial + 1)),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		panic(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestCanHandle(t *testing.T) {
+	tests := []struct {
+		name      string
+		reason    state.State
+		canHandle bool
+	}{
+		{name: "Pending state", reason: state.Pending, canHandle: false},
+		{name: "Initialize state", reason: state.Initialize, canHandle: false},
+		{name: "Ready state", reason: state.Ready, canHandle: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			a := testAction.PrepareAction(testAction.FakeClientBuilder().Build(), NewAction[*rhtasv1.Fulcio](testConfig{}))
+			g.Expect(a.CanHandle(t.Context(), newTestInstance(tt.reason))).To(Equal(tt.canHandle))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	day := 24 * time.Hour
+	tests := []struct {
+		name     string
+		validity []time.Duration
+		status   metav1.ConditionStatus
+		reason   string
+		message  string
+		event    bool
+		requeue  func(Gomega, time.Duration)
+		verify   func(Gomega, []rhtasv1.CertificateStatus)
+	}{
+		{
+			name:     "valid certificate",
+			validity: []time.Duration{365 * day},
+			status:   metav1.ConditionFalse,
+			reason:   ReasonValid,
+			requeue: func(g Gomega, d time.Duration) {
+				g.Expect(d).To(Equal(maxRequeue))
+			},
+			verify: func(g Gomega, certs []rhtasv1.CertificateStatus) {
+				g.Expect(certs).To(HaveLen(1))
+				g.Expect(certs[0].Name).To(Equal("ca"))
+				g.Expect(certs[0].Subject).To(Equal("CN=test"))
+				g.Expect(certs[0].SerialNumber).To(Equal("1"))
+				g.Expect(certs[0].NotAfter.Time).To(BeTemporally("~", time.Now().Add(365*day), time.Minute))
+			},
+		},
+		{
+			name:     "certificate crossed threshold",
+			validity: []time.Duration{7*day + 2*time.Hour},
+			status:   metav1.ConditionTrue,
+			reason:   ReasonExpiring,
+			message:  "expires in less than 30d",
+			event:    true,
+			requeue: func(g Gomega, d time.Duration) {
+				g.Expect(d).To(BeNumerically("~", 2*time.Hour, time.Minute))
+			},
+		},
+		{
+			name:     "smallest crossed threshold is reported",
+			validity: []time.Duration{12 * time.Hour},
+			status:   metav1.ConditionTrue,
+			reason:   ReasonExpiring,
+			message:  "expires in less than 1d",
+			event:    true,
+			requeue: func(g Gomega, d time.Duration) {
+				g.Expect(d).To(BeNumerically("~", 12*time.Hour, time.Minute))
+			},
+		},
+		{
+			name:     "expired certificate",
+			validity: []time.Duration{-time.Minute},
+			status:   metav1.ConditionTrue,
+			reason:   ReasonExpired,
+			message:  "expired at",
+			event:    true,
+			requeue: func(g Gomega, d time.Duration) {
+				g.Expect(d).To(Equal(maxRequeue))
+			},
+		},
+		{
+			name:     "chain reports every certificate",
+			validity: []time.Duration{365 * day, 5 * day},
+			status:   metav1.ConditionTrue,
+			reason:   ReasonExpiring,
+			message:  "Certificate ca[1]",
+			event:    true,
+			verify: func(g Gomega, certs []rhtasv1.CertificateStatus) {
+				g.Expect(certs).To(HaveLen(2))
+				g.Expect(certs[0].Name).To(Equal("ca[0]"))
+				g.Expect(certs[1].Name).To(Equal("ca[1]"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			instance := newTestInstance(state.Ready)
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance, certSecret(tt.validity...)).
+				WithStatusSubresource(instance).
+				Build()
+			recorder := events.NewFakeRecorder(10)
+			a := testAction.PrepareAction(c, NewAction[*rhtasv1.Fulcio](testConfig{}))
+			a.InjectRecorder(recorder)
+
+			result := a.Handle(ctx, instance)
+			g.Expect(result).ToNot(BeNil())
+			g.Expect(result.Err).ToNot(HaveOccurred())
+			if tt.requeue != nil {
+				tt.requeue(g, result.Result.RequeueAfter)
+			}
+
+			stored := &rhtasv1.Fulcio{}
+			g.Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testInstanceName}, stored)).To(Succeed())
+			condition := apimeta.FindStatusCondition(stored.Status.Conditions, ExpiringCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.status))
+			g.Expect(condition.Reason).To(Equal(tt.reason))
+			g.Expect(condition.Message).To(ContainSubstring(tt.message))
+			if tt.verify != nil {
+				tt.verify(g, stored.Status.Certificates)
+			}
+			if tt.event {
+				g.Expect(recorder.Events).To(Receive(ContainSubstring("CertificateExpiring")))
+			} else {
+				g.Expect(recorder.Events).ToNot(Receive())
+			}
+
+			// a second reconcile is stable and does not repeat the event
+			result = a.Handle(ctx, stored)
+			g.Expect(result.Err).ToNot(HaveOccurred())
+			g.Expect(recorder.Events).ToNot(Receive())
+		})
+	}
+}
+
+func TestNextCheck(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now()
+	thresholds := []time.Duration{time.Hour, 2 * time.Hour}
+	statuses := []rhtasv1.CertificateStatus{
+		{NotAfter: metav1.NewTime(now.Add(150 * time.Minute))},
+		{NotAfter: metav1.NewTime(now.Add(100 * time.Hour))},
+	}
+	g.Expect(nextCheck(statuses, thresholds, now)).To(Equal(30 * time.Minute))
+	g.Expect(nextCheck(statuses[:1], thresholds, now.Add(149*time.Minute+59*time.Second))).To(Equal(minRequeue))
+	g.Expect(nextCheck(nil, thresholds, now)).To(Equal(maxRequeue))
+}
@@ -0,0 +1,28 @@
+// Package certexpiry provides a generic action that tracks the validity of
+// the certificates held by an operator component.
+//
+// On every reconcile of a Ready instance the action reads the certificates
+// returned by the component [Config], publishes their subject, serial number,
+// notBefore and notAfter into the component status and maintains the
+// [ExpiringCondition] condition:
+//
+//   - False (reason Valid): every certificate is further from its expiry than
+//     the largest threshold in config.CertificateExpiryThresholds.
+//   - True (reason Expiring): a certificate crossed one of the thresholds. The
+//     message names the certificate and the smallest crossed threshold.
+//   - True (reason Expired): a certificate is past its notAfter.
+//
+// A warning event is emitted whenever the condition changes to a new crossed
+// threshold. The action requeues the reconcile for the next threshold
+// crossing, at least once a day, so the condition follows the passing time
+// without any change to the watched resources.
+//
+// PEM bundles are supported: certificates of a chain are reported under the
+// configured name suffixed with their index (e.g. "ca[0]", "ca[1]").
+//
+// Usage:
+//
+//	func NewCertificateExpiryAction() action.Action[*rhtasv1.Fulcio] {
+//	    return certexpiry.NewAction[*rhtasv1.Fulcio](fulcioCertificates{})
+//	}
+package certexpiry
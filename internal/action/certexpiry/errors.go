@@ -0,0 +1,13 @@
+package certexpiry
+
+import "errors"
+
+var (
+	// ErrCertificateRead is returned when a referenced certificate secret
+	// cannot be read.
+	ErrCertificateRead = errors.New("could not read certificate")
+
+	// ErrCertificateParse is returned when the referenced data does not
+	// contain a valid PEM encoded certificate.
+	ErrCertificateParse = errors.New("could not parse certificate")
+)
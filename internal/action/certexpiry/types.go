@@ -0,0 +1,23 @@
+package certexpiry
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/apis"
+)
+
+// Certificate references a PEM encoded certificate (or chain) held by a component.
+type Certificate struct {
+	// Name identifies the certificate in the component status.
+	Name string
+	// Ref points to the secret key with the PEM data. Nil refs are skipped.
+	Ref *rhtasv1.SecretKeySelector
+}
+
+// Config defines component-specific behavior that depends on the CRD instance.
+type Config[T apis.ConditionsAwareObject] interface {
+	// Certificates returns the certificates held by the instance.
+	Certificates(instance T) []Certificate
+
+	// Status returns the status field the certificate validity is published to.
+	Status(instance T) *[]rhtasv1.CertificateStatus
+}
@@ -0,0 +1,178 @@
+package certrenewal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certexpiry"
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewAction creates a generic action that renews an operator-generated
+// certificate before it expires.
+func NewAction[T apis.ConditionsAwareObject](
+	secretNameFormat string,
+	componentName string,
+	deploymentName string,
+	cfg Config[T],
+) action.Action[T] {
+	return &certRenewalAction[T]{
+		secretNameFormat: secretNameFormat,
+		componentName:    componentName,
+		deploymentName:   deploymentName,
+		cfg:              cfg,
+	}
+}
+
+type certRenewalAction[T apis.ConditionsAwareObject] struct {
+	action.BaseAction
+	secretNameFormat string
+	componentName    string
+	deploymentName   string
+	cfg              Config[T]
+}
+
+func (i certRenewalAction[T]) Name() string {
+	return "renew certificate"
+}
+
+func (i certRenewalAction[T]) CanHandle(_ context.Context, instance T) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Ready &&
+		i.cfg.IsEnabled(instance) && i.cfg.CurrentRef(instance) != nil
+}
+
+func (i certRenewalAction[T]) Handle(ctx context.Context, instance T) *action.Result {
+	if err := i.withdrawPrevious(ctx, instance, time.Now()); err != nil {
+		i.Logger.Error(err, "failed to withdraw the previous certificate")
+	}
+
+	ref := i.cfg.CurrentRef(instance)
+	current, err := kubernetes.GetSecret(ctx, i.Client, instance.GetNamespace(), ref.Name)
+	if err != nil {
+		return i.Error(ctx, fmt.Errorf("%w %s: %w", ErrCertificateRead, ref.Name, err), instance)
+	}
+	certs, err := certexpiry.ParseCertificates(current.Data[ref.Key])
+	if err != nil {
+		return i.Error(ctx, fmt.Errorf("%w %s/%s: %w", ErrCertificateRead, ref.Name, ref.Key, err), instance)
+	}
+	if time.Until(certs[0].NotAfter) > i.cfg.RenewBefore(instance) {
+		return i.Continue()
+	}
+
+	data, err := i.cfg.Renew(ctx, instance, i.Client, current.Data)
+	if err != nil {
+		return i.Error(ctx, fmt.Errorf("%w: %w", ErrRenew, err), instance)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf(i.secretNameFormat, instance.GetName()) + "-",
+			Namespace:    instance.GetNamespace(),
+			Labels:       labels.For(i.componentName, i.deploymentName, instance.GetName()),
+		},
+		Immutable: ptr.To(true),
+		Data:      data,
+	}
+	i.cfg.MutateSecret(instance, secret)
+	if err = i.Client.Create(ctx, secret); err != nil {
+		return i.Error(ctx, fmt.Errorf("%w: %w", ErrSecretCreate, err), instance)
+	}
+
+	if err = i.publishUntil(ctx, current, time.Now().Add(i.cfg.Overlap(instance))); err != nil {
+		i.Logger.Error(err, "failed to mark the previous secret", "secret", current.Name)
+	}
+
+	// The renewed certificate changes the trust material served by the component.
+	// Acknowledge the change up front, the operator initiated it.
+	if _, err = kubernetes.CreateOrUpdate(ctx, i.Client, instance,
+		ensure.Annotations[T]([]string{annotations.RefreshTrustMaterial}, map[string]string{annotations.RefreshTrustMaterial: "true"}),
+	); err != nil {
+		return i.Error(ctx, err, instance)
+	}
+
+	i.Recorder.Eventf(instance, secret, corev1.EventTypeNormal, "CertificateRenewed", "Renewed",
+		"Certificate from secret %s renewed into secret %s", current.Name, secret.Name)
+
+	i.cfg.AlignStatus(instance, rhtasv1.SecretKeySelector{
+		LocalObjectReference: rhtasv1.LocalObjectReference{Name: secret.Name},
+	})
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
+
+// publishUntil keeps the labels set by MutateSecret on the previous secret
+// until the overlap window ends, so both certificates are published.
+func (i certRenewalAction[T]) publishUntil(ctx context.Context, previous *corev1.Secret, until time.Time) error {
+	return i.patchMetadata(ctx, previous.ObjectMeta, map[string]any{
+		"annotations": map[string]any{annotations.PublishedUntil: until.UTC().Format(time.RFC3339)},
+	})
+}
+
+// withdrawPrevious removes the labels set by MutateSecret from the previous
+// secrets of the instance whose overlap window ended, so the autodiscovery
+// only finds the renewed one.
+func (i certRenewalAction[T]) withdrawPrevious(ctx context.Context, instance T, now time.Time) error {
+	probe := &corev1.Secret{}
+	i.cfg.MutateSecret(instance, probe)
+	remove := map[string]any{}
+	for label := range probe.Labels {
+		remove[label] = nil
+	}
+	for label := range probe.Labels {
+		secrets, err := kubernetes.ListSecrets(ctx, i.Client, instance.GetNamespace(), label)
+		if err != nil {
+			return err
+		}
+		for _, secret := range secrets.Items {
+			if _, ok := secret.Annotations[annotations.PublishedUntil]; !ok ||
+				secret.Labels[labels.LabelAppInstance] != instance.GetName() || Published(&secret, now) {
+				continue
+			}
+			if err = i.patchMetadata(ctx, secret.ObjectMeta, map[string]any{
+				"labels":      remove,
+				"annotations": map[string]any{annotations.PublishedUntil: nil},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (i certRenewalAction[T]) patchMetadata(ctx context.Context, meta metav1.ObjectMeta, patch map[string]any) error {
+	data, err := json.Marshal(map[string]any{"metadata": patch})
+	if err != nil {
+		return err
+	}
+	object := &metav1.PartialObjectMetadata{ObjectMeta: meta}
+	object.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	if err = i.Client.Patch(ctx, object, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return fmt.Errorf("could not update secret %s: %w", meta.Name, err)
+	}
+	return nil
+}
+
+// Published reports whether a previous certificate secret is still published
+// next to the renewed one at the given time.
+func Published(secret metav1.Object, now time.Time) bool {
+	until, ok := secret.GetAnnotations()[annotations.PublishedUntil]
+	if !ok {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, until)
+	return err == nil && now.Before(t)
+}
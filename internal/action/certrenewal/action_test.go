@@ -0,0 +1,288 @@
+package certrenewal
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	testComponent    = "test-component"
+	testDeployment   = "test-server"
+	testNamespace    = "test-ns"
+	testInstanceName = "test-instance"
+	testSecret       = "test-cert-config-test-instance"
+	testLabel        = labels.LabelNamespace + "/test.pem"
+)
+
+type testConfig struct {
+	enabled bool
+	err     error
+}
+
+func (c testConfig) IsEnabled(*rhtasv1.Fulcio) bool            { return c.enabled }
+func (c testConfig) RenewBefore(*rhtasv1.Fulcio) time.Duration { return 30 * 24 * time.Hour }
+func (c testConfig) Overlap(*rhtasv1.Fulcio) time.Duration     { return 7 * 24 * time.Hour }
+func (c testConfig) CurrentRef(instance *rhtasv1.Fulcio) *rhtasv1.SecretKeySelector {
+	return instance.Status.Certificate.CARef
+}
+func (c testConfig) Renew(_ context.Context, _ *rhtasv1.Fulcio, _ client.Client, current map[string][]byte) (map[string][]byte, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return map[string][]byte{
+		constants.KeyPrivate: current[constants.KeyPrivate],
+		constants.KeyCert:    createCert(365 * 24 * time.Hour),
+	}, nil
+}
+func (c testConfig) AlignStatus(instance *rhtasv1.Fulcio, ref rhtasv1.SecretKeySelector) {
+	instance.Status.Certificate.CARef = &rhtasv1.SecretKeySelector{
+		LocalObjectReference: ref.LocalObjectReference,
+		Key:                  constants.KeyCert,
+	}
+}
+func (c testConfig) MutateSecret(_ *rhtasv1.Fulcio, secret *corev1.Secret) {
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[testLabel] = constants.KeyCert
+}
+
+func newTestInstance(readyReason state.State) *rhtasv1.Fulcio {
+	instance := &rhtasv1.Fulcio{
+		ObjectMeta: metav1.ObjectMeta{Name: testInstanceName, Namespace: testNamespace, Generation: 1},
+		Status: rhtasv1.FulcioStatus{
+			Certificate: &rhtasv1.FulcioCertStatus{
+				CARef: &rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: testSecret},
+					Key:                  constants.KeyCert,
+				},
+			},
+		},
+	}
+	apimeta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: readyReason.String(),
+	})
+	return instance
+}
+
+func currentSecret(validity time.Duration) *corev1.Secret {
+	secretLabels := labels.For(testComponent, testDeployment, testInstanceName)
+	secretLabels[testLabel] = constants.KeyCert
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testSecret, Namespace: testNamespace,
+			Labels: secretLabels,
+		},
+		Immutable: ptr.To(true),
+		Data: map[string][]byte{
+			constants.KeyPrivate: []byte("private"),
+			constants.KeyCert:    createCert(validity),
+		},
+	}
+}
+
+// previousSecret returns a renewed certificate secret published until the given time.
+func previousSecret(until time.Time) *corev1.Secret {
+	secret := currentSecret(10 * 24 * time.Hour)
+	secret.Name = testSecret + "-previous"
+	secret.Annotations = map[string]string{annotations.PublishedUntil: until.UTC().Format(time.RFC3339)}
+	return secret
+}
+
+func createCert(validity time.Duration) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(validity),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		panic(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestCanHandle(t *testing.T) {
+	tests := []struct {
+		name      string
+		reason    state.State
+		enabled   bool
+		canHandle bool
+	}{
+		{name: "Ready state and enabled", reason: state.Ready, enabled: true, canHandle: true},
+		{name: "Ready state and disabled", reason: state.Ready, enabled: false, canHandle: false},
+		{name: "Initialize state", reason: state.Initialize, enabled: true, canHandle: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			a := testAction.PrepareAction(testAction.FakeClientBuilder().Build(),
+				NewAction("test-cert-config-%s", testComponent, testDeployment, testConfig{enabled: tt.enabled}))
+			g.Expect(a.CanHandle(t.Context(), newTestInstance(tt.reason))).To(Equal(tt.canHandle))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	type want struct {
+		result  *action.Result
+		isError bool
+		verify  func(context.Context, Gomega, client.Client, *rhtasv1.Fulcio)
+	}
+	tests := []struct {
+		name     string
+		validity time.Duration
+		previous *corev1.Secret
+		err      error
+		want     want
+	}{
+		{
+			name:     "certificate outside of renewal window",
+			validity: 365 * 24 * time.Hour,
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.Client, instance *rhtasv1.Fulcio) {
+					g.Expect(instance.Status.Certificate.CARef.Name).To(Equal(testSecret))
+					secrets := &corev1.SecretList{}
+					g.Expect(cli.List(ctx, secrets, client.InNamespace(testNamespace))).To(Succeed())
+					g.Expect(secrets.Items).To(HaveLen(1))
+				},
+			},
+		},
+		{
+			name:     "certificate within renewal window",
+			validity: 10 * 24 * time.Hour,
+			want: want{
+				result: testAction.Return(),
+				verify: func(ctx context.Context, g Gomega, cli client.Client, instance *rhtasv1.Fulcio) {
+					ref := instance.Status.Certificate.CARef
+					g.Expect(ref.Name).To(HavePrefix(testSecret + "-"))
+					g.Expect(ref.Key).To(Equal(constants.KeyCert))
+
+					renewed := &corev1.Secret{}
+					g.Expect(cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: ref.Name}, renewed)).To(Succeed())
+					g.Expect(renewed.Immutable).To(HaveValue(BeTrue()))
+					g.Expect(renewed.Data).To(HaveKeyWithValue(constants.KeyPrivate, []byte("private")))
+					g.Expect(renewed.Labels).To(HaveKeyWithValue(testLabel, constants.KeyCert))
+					g.Expect(renewed.Labels).To(HaveKeyWithValue(labels.LabelAppComponent, testComponent))
+
+					g.Expect(instance.Annotations).To(HaveKeyWithValue(annotations.RefreshTrustMaterial, "true"))
+
+					// both certificates are published during the overlap window
+					previous := &corev1.Secret{}
+					g.Expect(cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testSecret}, previous)).To(Succeed())
+					g.Expect(previous.Labels).To(HaveKeyWithValue(testLabel, constants.KeyCert))
+					g.Expect(previous.Annotations).To(HaveKey(annotations.PublishedUntil))
+					g.Expect(Published(previous, time.Now().Add(6*24*time.Hour))).To(BeTrue())
+					g.Expect(Published(previous, time.Now().Add(8*24*time.Hour))).To(BeFalse())
+
+					published, err := kubernetes.ListSecrets(ctx, cli, testNamespace, testLabel)
+					g.Expect(err).ToNot(HaveOccurred())
+					g.Expect(published.Items).To(HaveLen(2))
+				},
+			},
+		},
+		{
+			name:     "previous certificate within overlap window",
+			validity: 365 * 24 * time.Hour,
+			previous: previousSecret(time.Now().Add(time.Hour)),
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.Client, _ *rhtasv1.Fulcio) {
+					published, err := kubernetes.ListSecrets(ctx, cli, testNamespace, testLabel)
+					g.Expect(err).ToNot(HaveOccurred())
+					g.Expect(published.Items).To(HaveLen(2))
+				},
+			},
+		},
+		{
+			name:     "previous certificate after overlap window",
+			validity: 365 * 24 * time.Hour,
+			previous: previousSecret(time.Now().Add(-time.Hour)),
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.Client, _ *rhtasv1.Fulcio) {
+					published, err := kubernetes.ListSecrets(ctx, cli, testNamespace, testLabel)
+					g.Expect(err).ToNot(HaveOccurred())
+					g.Expect(published.Items).To(HaveLen(1))
+					g.Expect(published.Items[0].Name).To(Equal(testSecret))
+
+					previous := &corev1.Secret{}
+					g.Expect(cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testSecret + "-previous"}, previous)).To(Succeed())
+					g.Expect(previous.Annotations).ToNot(HaveKey(annotations.PublishedUntil))
+					g.Expect(previous.Labels).To(HaveKeyWithValue(labels.LabelAppInstance, testInstanceName))
+				},
+			},
+		},
+		{
+			name:     "renewal failure",
+			validity: 10 * 24 * time.Hour,
+			err:      errors.New("boom"),
+			want: want{
+				isError: true,
+				verify: func(_ context.Context, g Gomega, _ client.Client, instance *rhtasv1.Fulcio) {
+					g.Expect(instance.Status.Certificate.CARef.Name).To(Equal(testSecret))
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			instance := newTestInstance(state.Ready)
+			objects := []client.Object{instance, currentSecret(tt.validity)}
+			if tt.previous != nil {
+				objects = append(objects, tt.previous)
+			}
+			c := testAction.FakeClientBuilder().
+				WithObjects(objects...).
+				WithStatusSubresource(instance).
+				Build()
+			a := testAction.PrepareAction(c,
+				NewAction("test-cert-config-%s", testComponent, testDeployment, testConfig{enabled: true, err: tt.err}))
+
+			result := a.Handle(ctx, instance)
+			if tt.want.isError {
+				g.Expect(result.Err).To(MatchError(ErrRenew))
+			} else {
+				g.Expect(result).To(Equal(tt.want.result))
+			}
+
+			stored := &rhtasv1.Fulcio{}
+			g.Expect(c.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testInstanceName}, stored)).To(Succeed())
+			tt.want.verify(ctx, g, c, stored)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Package certrenewal provides a generic action that renews certificates
+// generated by the operator ahead of their expiry.
+//
+// The action runs on Ready instances with renewal enabled. It reads the
+// certificate referenced by [Config.CurrentRef] and, once the first
+// certificate of the PEM data is within [Config.RenewBefore] of its notAfter:
+//
+//  1. Calls [Config.Renew] with the data of the current secret to issue a new
+//     certificate (e.g. a leaf or an intermediate signed by the unchanged root).
+//  2. Creates a new immutable secret with the renewed data. The name is
+//     generated from the signer secret name format.
+//  3. Sets the labels set by [Config.MutateSecret] (TUF autodiscovery) on the
+//     renewed secret and keeps them on the current one for [Config.Overlap].
+//  4. Sets the [annotations.RefreshTrustMaterial] annotation, the trust
+//     material served by the component changes with the renewal.
+//  5. Calls [Config.AlignStatus] so the component switches to the new secret
+//     on the following reconcile.
+//
+// The previous secret is kept. Its certificate stays valid until its own
+// notAfter, and it stays published next to the renewed one until the
+// [annotations.PublishedUntil] time, which gives relying parties an overlap
+// window to pick up the renewed trust material. Once the window ends, the
+// labels set by [Config.MutateSecret] are removed from the previous secret.
+//
+// Usage:
+//
+//	func NewCertificateRenewalAction() action.Action[*rhtasv1.Fulcio] {
+//	    return certrenewal.NewAction(
+//	        certSecretNameFormat, ComponentName, DeploymentName,
+//	        fulcioRenewalConfig{},
+//	    )
+//	}
+package certrenewal
@@ -0,0 +1,16 @@
+package certrenewal
+
+import "errors"
+
+var (
+	// ErrCertificateRead is returned when the current certificate cannot be
+	// read or parsed.
+	ErrCertificateRead = errors.New("could not read current certificate")
+
+	// ErrRenew is returned when the component fails to issue the renewed
+	// certificate.
+	ErrRenew = errors.New("could not renew certificate")
+
+	// ErrSecretCreate is returned when creating the renewed secret fails.
+	ErrSecretCreate = errors.New("could not create renewed secret")
+)
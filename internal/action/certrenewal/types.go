@@ -0,0 +1,41 @@
+package certrenewal
+
+import (
+	"context"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/apis"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Config defines component-specific behavior that depends on the CRD instance.
+// Static naming and labeling are passed as constructor parameters to [NewAction].
+type Config[T apis.ConditionsAwareObject] interface {
+	// IsEnabled reports whether the operator renews the certificate of the instance.
+	IsEnabled(instance T) bool
+
+	// RenewBefore returns how long before the expiry the certificate is renewed.
+	RenewBefore(instance T) time.Duration
+
+	// Overlap returns how long the previous certificate stays published next
+	// to the renewed one.
+	Overlap(instance T) time.Duration
+
+	// CurrentRef returns the reference to the certificate in use. The first
+	// certificate of the referenced PEM data is checked for expiry.
+	CurrentRef(instance T) *rhtasv1.SecretKeySelector
+
+	// Renew issues a new certificate from the data of the current secret and
+	// returns the data of the renewed secret.
+	Renew(ctx context.Context, instance T, cli client.Client, current map[string][]byte) (map[string][]byte, error)
+
+	// AlignStatus points the component status to the renewed secret.
+	AlignStatus(instance T, ref rhtasv1.SecretKeySelector)
+
+	// MutateSecret adds labels or annotations to the renewed secret.
+	// Labels set here are removed from the previous secret once the overlap
+	// window ends.
+	MutateSecret(instance T, secret *corev1.Secret)
+}
@@ -340,6 +340,34 @@ func TestHandle_FIPSCryptoValidation(t *testing.T) {
 		g.Expect(cc.Status).To(Equal(metav1.ConditionFalse))
 	})
 
+	t.Run("rejects non-approved key algorithm as terminal error", func(t *testing.T) {
+		g := NewWithT(t)
+		ctx := t.Context()
+		instance := testInstance(pendingConditions()...)
+
+		cli := testAction.FakeClientBuilder().
+			WithObjects(instance).
+			WithStatusSubresource(instance).
+			Build()
+
+		a := testAction.PrepareAction(cli, NewAction(
+			testCondition, testComponent,
+			testFIPSWrapper(nil, func(_ context.Context, _ *rhtasv1.Rekor, _ client.Client) ([]CryptoRef, error) {
+				var refs []CryptoRef
+				AppendKeyAlgorithm("", "spec.signer.keyAlgorithm", &refs)
+				AppendKeyAlgorithm("rsa-1024", "spec.signer.keyAlgorithm", &refs)
+				return refs, nil
+			}),
+		))
+
+		result := a.Handle(ctx, instance)
+
+		g.Expect(result).ToNot(BeNil())
+		g.Expect(errors.Is(result.Err, reconcile.TerminalError(result.Err))).To(BeTrue())
+		g.Expect(result.Err).To(MatchError(fipsutil.ErrNonFIPSKeyAlgorithm))
+		g.Expect(result.Err.Error()).To(ContainSubstring("spec.signer.keyAlgorithm"))
+	})
+
 	t.Run("skips validation when CryptoMaterial callback is nil", func(t *testing.T) {
 		g := NewWithT(t)
 		ctx := t.Context()
@@ -5,6 +5,7 @@ import (
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/apis"
+	fipsutil "github.com/securesign/operator/internal/utils/fips"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -87,3 +88,18 @@ func AppendSecretRef(ctx context.Context, c client.Client, namespace string,
 	*refs = append(*refs, CryptoRef{FieldPath: fieldPath, Data: data, Validate: validate})
 	return nil
 }
+
+// AppendKeyAlgorithm appends a CryptoRef validating the algorithm of keys generated by the operator.
+// If algorithm is empty, it's a no-op.
+func AppendKeyAlgorithm(algorithm rhtasv1.KeyAlgorithm, fieldPath string, refs *[]CryptoRef) {
+	if algorithm == "" {
+		return
+	}
+	*refs = append(*refs, CryptoRef{
+		FieldPath: fieldPath,
+		Data:      []byte(algorithm),
+		Validate: func(data []byte) error {
+			return fipsutil.ValidateKeyAlgorithm(string(data))
+		},
+	})
+}
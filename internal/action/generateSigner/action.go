@@ -10,6 +10,7 @@ import (
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certrenewal"
 	"github.com/securesign/operator/internal/apis"
 	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/labels"
@@ -146,14 +147,16 @@ func (i signerAction[T]) ensureLabelsOnSecret(ctx context.Context, instance T, w
 		return nil
 	}
 
-	// Remove those labels from any other secret that has them
+	// Remove those labels from any other secret that has them, except a renewed
+	// certificate still published next to its successor
+	now := time.Now()
 	for label := range probe.Labels {
 		existing, err := kubernetes.ListSecrets(ctx, i.Client, instance.GetNamespace(), label)
 		if err != nil {
 			return err
 		}
 		for _, s := range existing.Items {
-			if s.Name == ref.Name {
+			if s.Name == ref.Name || certrenewal.Published(&s, now) {
 				continue
 			}
 			if err := labels.Remove(ctx, &s, i.Client, label); err != nil {
@@ -0,0 +1,87 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewAction creates a generic action that manages a NetworkPolicy
+// restricting ingress traffic to the pods of a component.
+func NewAction[T apis.ConditionsAwareObject](
+	componentName string,
+	policyName string,
+	cfg Config[T],
+) action.Action[T] {
+	return &networkPolicyAction[T]{
+		componentName: componentName,
+		policyName:    policyName,
+		cfg:           cfg,
+	}
+}
+
+type networkPolicyAction[T apis.ConditionsAwareObject] struct {
+	action.BaseAction
+	componentName string
+	policyName    string
+	cfg           Config[T]
+}
+
+func (a *networkPolicyAction[T]) Name() string {
+	return "create network policy"
+}
+
+func (a *networkPolicyAction[T]) CanHandle(_ context.Context, instance T) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (a *networkPolicyAction[T]) Handle(ctx context.Context, instance T) *action.Result {
+	np := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      a.policyName,
+			Namespace: instance.GetNamespace(),
+		},
+	}
+
+	if !a.cfg.IsEnabled(instance) {
+		if err := a.Client.Delete(ctx, np); client.IgnoreNotFound(err) != nil {
+			return a.Error(ctx, fmt.Errorf("%w: %w", ErrNetworkPolicyDelete, err), instance)
+		}
+		return a.Continue()
+	}
+
+	policyLabels := labels.For(a.componentName, a.policyName, instance.GetName())
+
+	if _, err := kubernetes.CreateOrUpdate(ctx, a.Client, np,
+		ensure.ControllerReference[*networkingv1.NetworkPolicy](instance, a.Client),
+		ensure.Labels[*networkingv1.NetworkPolicy](slices.Collect(maps.Keys(policyLabels)), policyLabels),
+		a.ensureSpec(instance),
+	); err != nil {
+		return a.Error(ctx, fmt.Errorf("%w: %w", ErrNetworkPolicyCreate, err), instance)
+	}
+
+	return a.Continue()
+}
+
+func (a *networkPolicyAction[T]) ensureSpec(instance T) func(*networkingv1.NetworkPolicy) error {
+	return func(np *networkingv1.NetworkPolicy) error {
+		np.Spec.PodSelector = metav1.LabelSelector{
+			MatchLabels: labels.ForComponent(a.componentName, instance.GetName()),
+		}
+		np.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+		np.Spec.Ingress = a.cfg.Ingress(instance)
+		return nil
+	}
+}
@@ -0,0 +1,272 @@
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/config"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testComponent    = "test-component"
+	testPolicy       = "test-server"
+	testNamespace    = "test-ns"
+	testInstanceName = "test-instance"
+)
+
+type testConfig struct {
+	enabled bool
+}
+
+func (c testConfig) IsEnabled(*rhtasv1.Fulcio) bool { return c.enabled }
+func (c testConfig) Ingress(*rhtasv1.Fulcio) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		Rule([]int32{8080}, FromComponents("client"), FromOperator()),
+	}
+}
+
+func newTestInstance(conditions ...metav1.Condition) *rhtasv1.Fulcio {
+	instance := &rhtasv1.Fulcio{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testInstanceName, Namespace: testNamespace, Generation: 1,
+		},
+	}
+	for _, c := range conditions {
+		apimeta.SetStatusCondition(&instance.Status.Conditions, c)
+	}
+	return instance
+}
+
+func existingPolicy() *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: testPolicy, Namespace: testNamespace},
+	}
+}
+
+func TestCanHandle(t *testing.T) {
+	tests := []struct {
+		name      string
+		reason    state.State
+		canHandle bool
+	}{
+		{name: "Pending state", reason: state.Pending, canHandle: false},
+		{name: "Creating state", reason: state.Creating, canHandle: true},
+		{name: "Initialize state", reason: state.Initialize, canHandle: true},
+		{name: "Ready state", reason: state.Ready, canHandle: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := testAction.FakeClientBuilder().Build()
+			a := testAction.PrepareAction(c, NewAction(testComponent, testPolicy, testConfig{enabled: true}))
+			instance := newTestInstance(metav1.Condition{
+				Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: tt.reason.String(),
+			})
+			g.Expect(a.CanHandle(t.Context(), instance)).To(Equal(tt.canHandle))
+		})
+	}
+}
+
+func TestHandle(t *testing.T) {
+	type env struct {
+		enabled   bool
+		objects   []client.Object
+		intercept interceptor.Funcs
+	}
+	type want struct {
+		result  *action.Result
+		isError bool
+		verify  func(context.Context, Gomega, client.WithWatch)
+	}
+	tests := []struct {
+		name string
+		env  env
+		want want
+	}{
+		{
+			name: "enabled — creates NetworkPolicy selecting component pods",
+			env:  env{enabled: true},
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+					np := &networkingv1.NetworkPolicy{}
+					g.Expect(cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testPolicy}, np)).To(Succeed())
+
+					g.Expect(np.Labels).To(Equal(labels.For(testComponent, testPolicy, testInstanceName)))
+					g.Expect(np.Spec.PodSelector.MatchLabels).To(Equal(labels.ForComponent(testComponent, testInstanceName)))
+					g.Expect(np.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+					g.Expect(np.Spec.Ingress).To(HaveLen(1))
+					g.Expect(np.Spec.Ingress[0].Ports).To(HaveLen(1))
+					g.Expect(*np.Spec.Ingress[0].Ports[0].Port).To(Equal(intstr.FromInt32(8080)))
+					g.Expect(np.Spec.Ingress[0].From).To(HaveLen(2))
+
+					g.Expect(np.OwnerReferences).To(HaveLen(1))
+					g.Expect(np.OwnerReferences[0].Name).To(Equal(testInstanceName))
+				},
+			},
+		},
+		{
+			name: "enabled — corrects stale NetworkPolicy",
+			env: env{
+				enabled: true,
+				objects: func() []client.Object {
+					np := existingPolicy()
+					np.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{}}
+					return []client.Object{np}
+				}(),
+			},
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+					np := &networkingv1.NetworkPolicy{}
+					g.Expect(cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testPolicy}, np)).To(Succeed())
+					g.Expect(np.Spec.Ingress[0].From).To(HaveLen(2))
+				},
+			},
+		},
+		{
+			name: "disabled — no NetworkPolicy exists, continues",
+			env:  env{enabled: false},
+			want: want{result: testAction.Continue()},
+		},
+		{
+			name: "disabled — deletes existing NetworkPolicy",
+			env:  env{enabled: false, objects: []client.Object{existingPolicy()}},
+			want: want{
+				result: testAction.Continue(),
+				verify: func(ctx context.Context, g Gomega, cli client.WithWatch) {
+					err := cli.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: testPolicy}, &networkingv1.NetworkPolicy{})
+					g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+				},
+			},
+		},
+		{
+			name: "create failure — retriable error",
+			env: env{
+				enabled: true,
+				intercept: interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+						return fmt.Errorf("quota exceeded")
+					},
+				},
+			},
+			want: want{isError: true},
+		},
+		{
+			name: "delete failure — retriable error",
+			env: env{
+				enabled: false,
+				intercept: interceptor.Funcs{
+					Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+						return fmt.Errorf("forbidden")
+					},
+				},
+			},
+			want: want{isError: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			instance := newTestInstance(metav1.Condition{
+				Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Creating.String(),
+			})
+
+			cli := testAction.FakeClientBuilder().
+				WithObjects(instance).
+				WithObjects(tt.env.objects...).
+				WithStatusSubresource(instance).
+				WithInterceptorFuncs(tt.env.intercept).
+				Build()
+
+			a := testAction.PrepareAction(cli, NewAction(testComponent, testPolicy, testConfig{enabled: tt.env.enabled}))
+
+			got := a.Handle(ctx, instance)
+
+			if tt.want.isError {
+				g.Expect(got).ToNot(BeNil())
+				g.Expect(got.Err).To(HaveOccurred())
+			} else if !reflect.DeepEqual(got, tt.want.result) {
+				t.Errorf("Handle() = %v, want %v", got, tt.want.result)
+			}
+
+			if tt.want.verify != nil {
+				tt.want.verify(ctx, g, cli)
+			}
+		})
+	}
+}
+
+func TestPeers(t *testing.T) {
+	tests := []struct {
+		name        string
+		isOpenShift bool
+		peer        func() networkingv1.NetworkPolicyPeer
+		want        map[string]string
+	}{
+		{
+			name: "ingress controllers on Kubernetes",
+			peer: FromIngressControllers,
+			want: map[string]string{"kubernetes.io/metadata.name": config.NetworkPolicyIngressNamespace},
+		},
+		{
+			name:        "ingress controllers on OpenShift",
+			isOpenShift: true,
+			peer:        FromIngressControllers,
+			want:        map[string]string{"network.openshift.io/policy-group": "ingress"},
+		},
+		{
+			name: "monitoring on Kubernetes",
+			peer: FromMonitoring,
+			want: map[string]string{"kubernetes.io/metadata.name": config.NetworkPolicyMonitoringNamespace},
+		},
+		{
+			name:        "monitoring on OpenShift",
+			isOpenShift: true,
+			peer:        FromMonitoring,
+			want:        map[string]string{"network.openshift.io/policy-group": "monitoring"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			config.Openshift = tt.isOpenShift
+			t.Cleanup(func() { config.Openshift = false })
+
+			peer := tt.peer()
+			g.Expect(peer.PodSelector).To(BeNil())
+			g.Expect(peer.NamespaceSelector.MatchLabels).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestFromComponents(t *testing.T) {
+	g := NewWithT(t)
+	peer := FromComponents("a", "b")
+	g.Expect(peer.NamespaceSelector).To(BeNil())
+	g.Expect(peer.PodSelector.MatchLabels).To(HaveKeyWithValue(labels.LabelAppPartOf, constants.AppName))
+	g.Expect(peer.PodSelector.MatchExpressions).To(ConsistOf(metav1.LabelSelectorRequirement{
+		Key:      labels.LabelAppComponent,
+		Operator: metav1.LabelSelectorOpIn,
+		Values:   []string{"a", "b"},
+	}))
+}
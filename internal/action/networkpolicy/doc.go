@@ -0,0 +1,27 @@
+// Package networkpolicy provides a generic action for managing Kubernetes
+// NetworkPolicy resources across operator components.
+//
+// The action creates or deletes a NetworkPolicy based on the component's
+// network policy configuration:
+//
+//   - Enabled: creates or updates the NetworkPolicy. The policy selects the
+//     component pods by the labels from the internal/labels package and only
+//     admits the ingress rules returned by the component [Config].
+//   - Disabled: deletes the NetworkPolicy if it exists.
+//
+// Peers are described with the helpers in this package:
+//
+//   - [FromComponents]: operator-managed pods of the given components in the same namespace.
+//   - [FromOperator]: the operator controller manager.
+//   - [FromIngressControllers]: ingress controller (OpenShift router) pods.
+//   - [FromMonitoring]: the cluster monitoring stack.
+//
+// Usage:
+//
+//	func NewNetworkPolicyAction() action.Action[*rhtasv1.Fulcio] {
+//	    return networkpolicy.NewAction(
+//	        ComponentName, DeploymentName,
+//	        fulcioNetworkPolicyConfig{},
+//	    )
+//	}
+package networkpolicy
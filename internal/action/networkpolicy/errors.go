@@ -0,0 +1,13 @@
+package networkpolicy
+
+import "errors"
+
+var (
+	// ErrNetworkPolicyCreate is returned when creating or updating the
+	// NetworkPolicy resource fails.
+	ErrNetworkPolicyCreate = errors.New("could not create networkPolicy")
+
+	// ErrNetworkPolicyDelete is returned when deleting the NetworkPolicy
+	// resource fails.
+	ErrNetworkPolicyDelete = errors.New("could not delete networkPolicy")
+)
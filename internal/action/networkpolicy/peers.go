@@ -0,0 +1,90 @@
+package networkpolicy
+
+import (
+	"github.com/securesign/operator/internal/config"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// OperatorPodLabel is the label set on the operator controller manager pods.
+	OperatorPodLabel = "control-plane"
+	// OperatorPodLabelValue is the value of [OperatorPodLabel] on the operator controller manager pods.
+	OperatorPodLabelValue = "operator-controller-manager"
+
+	openshiftPolicyGroupLabel = "network.openshift.io/policy-group"
+)
+
+// Rule returns an ingress rule admitting traffic from any of the peers to the given TCP ports.
+func Rule(ports []int32, peers ...networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicyIngressRule {
+	rule := networkingv1.NetworkPolicyIngressRule{From: peers}
+	for _, p := range ports {
+		rule.Ports = append(rule.Ports, networkingv1.NetworkPolicyPort{
+			Protocol: ptr.To(corev1.ProtocolTCP),
+			Port:     ptr.To(intstr.FromInt32(p)),
+		})
+	}
+	return rule
+}
+
+// FromComponents selects operator-managed pods of the given components in the policy namespace.
+func FromComponents(components ...string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				labels.LabelAppPartOf: constants.AppName,
+			},
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      labels.LabelAppComponent,
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   components,
+				},
+			},
+		},
+	}
+}
+
+// FromOperator selects the operator controller manager pods in any namespace.
+func FromOperator() networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{},
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				OperatorPodLabel: OperatorPodLabelValue,
+			},
+		},
+	}
+}
+
+// FromIngressControllers selects the namespace of the ingress controllers.
+// On OpenShift, the router namespaces are selected by their policy group.
+func FromIngressControllers() networkingv1.NetworkPolicyPeer {
+	if kubernetes.IsOpenShift() {
+		return fromNamespaceLabel(openshiftPolicyGroupLabel, "ingress")
+	}
+	return fromNamespaceLabel(corev1.LabelMetadataName, config.NetworkPolicyIngressNamespace)
+}
+
+// FromMonitoring selects the namespace of the Prometheus stack scraping the metrics endpoints.
+// On OpenShift, the cluster monitoring namespaces are selected by their policy group.
+func FromMonitoring() networkingv1.NetworkPolicyPeer {
+	if kubernetes.IsOpenShift() {
+		return fromNamespaceLabel(openshiftPolicyGroupLabel, "monitoring")
+	}
+	return fromNamespaceLabel(corev1.LabelMetadataName, config.NetworkPolicyMonitoringNamespace)
+}
+
+func fromNamespaceLabel(key, value string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{key: value},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package networkpolicy
+
+import (
+	"github.com/securesign/operator/internal/apis"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// Config defines component-specific behavior that depends on the CRD instance.
+// Static naming and labeling are passed as constructor parameters to [NewAction].
+type Config[T apis.ConditionsAwareObject] interface {
+	// IsEnabled reports whether NetworkPolicy creation is enabled.
+	IsEnabled(instance T) bool
+
+	// Ingress returns the ingress rules admitted to the component pods.
+	Ingress(instance T) []networkingv1.NetworkPolicyIngressRule
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/images"
 	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/metrics"
 	"github.com/securesign/operator/internal/serviceresolver"
 	"github.com/securesign/operator/internal/state"
 	"github.com/securesign/operator/internal/utils"
@@ -56,11 +57,21 @@ func (i resolveTree[T]) Name() string {
 func (i resolveTree[T]) CanHandle(ctx context.Context, instance T) bool {
 	wrapped := i.wrapper(instance)
 
+	treeID := wrapped.GetTreeID()
+	if wrapped.GetTreeRef() != nil {
+		referenced, err := i.referencedTree(ctx, instance)
+		if err != nil || referenced.Status.TreeID == nil {
+			// wait for the referenced tree
+			return true
+		}
+		treeID = referenced.Status.TreeID
+	}
+
 	switch {
 	case wrapped.GetStatusTreeID() == nil:
 		return true
-	case wrapped.GetTreeID() != nil:
-		return !equality.Semantic.DeepEqual(wrapped.GetTreeID(), wrapped.GetStatusTreeID())
+	case treeID != nil:
+		return !equality.Semantic.DeepEqual(treeID, wrapped.GetStatusTreeID())
 	default:
 		return !meta.IsStatusConditionTrue(instance.GetConditions(), JobCondition)
 	}
@@ -83,6 +94,52 @@ func (i resolveTree[T]) handleMissingCondition(ctx context.Context, instance T)
 	return nil
 }
 
+// referencedTree returns the TrillianTree referenced by the instance.
+func (i resolveTree[T]) referencedTree(ctx context.Context, instance T) (*rhtasv1.TrillianTree, error) {
+	trillianTree := &rhtasv1.TrillianTree{}
+	key := client.ObjectKey{Namespace: instance.GetNamespace(), Name: i.wrapper(instance).GetTreeRef().Name}
+	if err := i.Client.Get(ctx, key, trillianTree); err != nil {
+		return nil, err
+	}
+	return trillianTree, nil
+}
+
+func (i resolveTree[T]) handleTreeRef(ctx context.Context, instance T) *action.Result {
+	wrapped := i.wrapper(instance)
+	if wrapped.GetTreeRef() == nil {
+		return i.Continue()
+	}
+
+	trillianTree, err := i.referencedTree(ctx, instance)
+	if client.IgnoreNotFound(err) != nil {
+		return i.Error(ctx, fmt.Errorf("could not get TrillianTree: %w", err), instance)
+	}
+	if trillianTree == nil || trillianTree.Status.TreeID == nil {
+		instance.SetCondition(metav1.Condition{
+			Type:    JobCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Pending.String(),
+			Message: fmt.Sprintf("Waiting for TrillianTree %s", wrapped.GetTreeRef().Name),
+		})
+		if _, err = i.PersistStatus(ctx, instance); err != nil {
+			return i.Error(ctx, err, instance)
+		}
+		return i.RequeueAfter(5 * time.Second)
+	}
+
+	wrapped.SetStatusTreeID(trillianTree.Status.TreeID)
+	instance.SetCondition(metav1.Condition{
+		Type:   JobCondition,
+		Status: metav1.ConditionTrue,
+		Reason: state.Ready.String(),
+	})
+	// the tree is managed by the TrillianTree, never continue with the tree creation
+	if _, err = i.PersistStatus(ctx, instance); err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	return i.Return()
+}
+
 func (i resolveTree[T]) handleManual(ctx context.Context, instance T) *action.Result {
 	wrapped := i.wrapper(instance)
 
@@ -98,7 +155,7 @@ func (i resolveTree[T]) handleRbac(ctx context.Context, instance T) *action.Resu
 	var err error
 	rbacName := fmt.Sprintf(RBACNameMask, i.component)
 
-	labels := labels.For("createtree", i.component, instance.GetName())
+	labels := labels.For(ComponentName, i.component, instance.GetName())
 
 	// ServiceAccount
 	if _, err = kubernetes.CreateOrUpdate(ctx, i.Client, &corev1.ServiceAccount{
@@ -162,7 +219,7 @@ func (i resolveTree[T]) handleConfigMap(ctx context.Context, instance T) *action
 		err    error
 	)
 
-	labels := labels.For("createtree", i.component, instance.GetName())
+	labels := labels.For(ComponentName, i.component, instance.GetName())
 
 	// Needed for configMap clean-up
 	configMap := &corev1.ConfigMap{
@@ -198,7 +255,7 @@ func (i resolveTree[T]) handleJob(ctx context.Context, instance T) *action.Resul
 	var trillUrl string
 	wrapped := i.wrapper(instance)
 
-	labels := labels.For("createtree", i.component, instance.GetName())
+	labels := labels.For(ComponentName, i.component, instance.GetName())
 
 	configMapName := fmt.Sprintf(configMapResultMask, i.component, instance.GetName())
 	configMap, err := kubernetes.GetConfigMap(ctx, i.Client, instance.GetNamespace(), configMapName)
@@ -249,6 +306,9 @@ func (i resolveTree[T]) handleJob(ctx context.Context, instance T) *action.Resul
 			object.Spec.Template.Labels = labels
 			return nil
 		},
+		func(object *batchv1.Job) error {
+			return ensure.PodScheduling(wrapped.GetPodRequirements())(&object.Spec.Template.Spec)
+		},
 		func(object *batchv1.Job) error {
 			return ensure.PodSecurityContext(&object.Spec.Template.Spec)
 		},
@@ -326,6 +386,7 @@ func (i resolveTree[T]) handleJobFinished(ctx context.Context, instance T) *acti
 		return i.RequeueAfter(5 * time.Second)
 	}
 	i.Logger.V(1).Info("createtree job is already present.", "Succeeded", j.Status.Succeeded, "Failures", j.Status.Failed)
+	metrics.SetFailedJobs(instance, "createtree", j.Status.Failed)
 
 	if !job.IsCompleted(*j) {
 		return i.RequeueAfter(5 * time.Second)
@@ -416,11 +477,21 @@ func (i resolveTree[T]) Handle(ctx context.Context, instance T) *action.Result {
 		return result
 	}
 
+	result = i.handleTreeRef(ctx, instance)
+	if result != nil {
+		return result
+	}
+
 	result = i.handleManual(ctx, instance)
 	if result != nil {
 		return result
 	}
 
+	result = i.handleAdminAPI(ctx, instance)
+	if result != nil {
+		return result
+	}
+
 	result = i.handleRbac(ctx, instance)
 	if result != nil {
 		return result
@@ -38,6 +38,9 @@ var defaultWrapper = Wrapper[*rhtasv1.Rekor](
 	func(rekor *rhtasv1.Rekor) *int64 {
 		return rekor.Spec.TreeID
 	},
+	func(rekor *rhtasv1.Rekor) *rhtasv1.LocalObjectReference {
+		return rekor.Spec.TreeRef
+	},
 	func(rekor *rhtasv1.Rekor) *int64 {
 		return rekor.Status.TreeID
 	},
@@ -49,6 +52,9 @@ var defaultWrapper = Wrapper[*rhtasv1.Rekor](
 			URL: "https://trillian-logserver.default.svc",
 		}
 	},
+	func(rekor *rhtasv1.Rekor) rhtasv1.PodRequirements {
+		return rekor.Spec.PodRequirements
+	},
 )
 
 var (
@@ -59,6 +65,7 @@ var (
 func init() {
 	tests = []namedTest{
 		{name: "missingCondition", run: testMissingCondition},
+		{name: "tree-ref", run: testTreeRef},
 		{name: "manual", run: testManual},
 		{name: "rbac", run: testRbac},
 		{name: "configmap", run: testConfigMap},
@@ -117,6 +124,73 @@ func testMissingCondition(t *testing.T) {
 	}
 }
 
+func testTreeRef(t *testing.T) {
+	setTreeRef := func(ctx context.Context, g Gomega, c client.WithWatch) {
+		r := rhtasv1.Rekor{}
+		g.Expect(c.Get(ctx, nnObject, &r)).To(Succeed())
+
+		r.Spec.TreeRef = &rhtasv1.LocalObjectReference{Name: "tree"}
+		g.Expect(c.Update(ctx, &r)).To(Succeed())
+	}
+
+	for _, tc := range []struct {
+		desc string
+		pre  pre
+		want want
+	}{
+		{
+			desc: "not-set",
+			want: want{
+				result: testAction.Continue(),
+			},
+		},
+		{
+			desc: "waiting for TrillianTree",
+			pre: pre{
+				before: setTreeRef,
+			},
+			want: want{
+				result: testAction.RequeueAfter(5 * time.Second),
+				verify: func(ctx context.Context, g Gomega, c client.WithWatch) {
+					r := rhtasv1.Rekor{}
+					g.Expect(c.Get(ctx, nnObject, &r)).To(Succeed())
+					g.Expect(r.Status.TreeID).Should(BeNil())
+
+					cond := meta.FindStatusCondition(r.GetConditions(), JobCondition)
+					g.Expect(cond).ShouldNot(BeNil())
+					g.Expect(cond.Status).Should(Equal(metav1.ConditionFalse))
+					g.Expect(cond.Message).Should(Equal("Waiting for TrillianTree tree"))
+				},
+			},
+		},
+		{
+			desc: "resolved",
+			pre: pre{
+				before: func(ctx context.Context, g Gomega, c client.WithWatch) {
+					setTreeRef(ctx, g, c)
+					g.Expect(c.Create(ctx, &rhtasv1.TrillianTree{
+						ObjectMeta: metav1.ObjectMeta{Name: "tree", Namespace: nnObject.Namespace},
+						Status:     rhtasv1.TrillianTreeStatus{TreeID: ptr.To(int64(123456789))},
+					})).To(Succeed())
+				},
+			},
+			want: want{
+				result: testAction.Return(),
+				verify: func(ctx context.Context, g Gomega, c client.WithWatch) {
+					r := rhtasv1.Rekor{}
+					g.Expect(c.Get(ctx, nnObject, &r)).To(Succeed())
+					g.Expect(r.Status.TreeID).Should(Equal(ptr.To(int64(123456789))))
+					g.Expect(meta.IsStatusConditionTrue(r.GetConditions(), JobCondition)).To(BeTrue())
+				},
+			},
+		},
+	} {
+		t.Run(tc.desc, testRunner(tc.pre, tc.want, func(r *resolveTree[*rhtasv1.Rekor], ctx context.Context, rekor *rhtasv1.Rekor) *action.Result {
+			return r.handleTreeRef(ctx, rekor)
+		}))
+	}
+}
+
 func testManual(t *testing.T) {
 	for _, tc := range []struct {
 		desc string
@@ -0,0 +1,158 @@
+package tree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/trillian"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// maxRootDuration matches the default of the createtree command.
+const maxRootDuration = time.Hour
+
+// handleAdminAPI creates and initialises the tree by calling the Trillian admin API from the operator.
+//
+// The result ConfigMap is annotated as pending before the tree is created. A CreateTree request whose outcome is
+// unknown (e.g. a timeout or an operator restart) is resolved on the next reconcile by looking the tree up by its
+// display name and the instance UID kept in its description, so a tree is never created twice.
+// The action continues with the createtree Job when the Job was already launched, or when Trillian cannot be reached
+// and no request was sent.
+func (i resolveTree[T]) handleAdminAPI(ctx context.Context, instance T) *action.Result {
+	if kubernetes.IsDryRun(ctx) {
+		// do not create trees in Trillian, the plan lists the createtree Job resources instead
+		return i.Continue()
+	}
+
+	configMapName := fmt.Sprintf(configMapResultMask, i.component, instance.GetName())
+	configMap, err := kubernetes.GetConfigMap(ctx, i.Client, instance.GetNamespace(), configMapName)
+	switch {
+	case apierrors.IsNotFound(err):
+		configMap = nil
+	case err != nil:
+		return i.Error(ctx, fmt.Errorf("could not get configmap: %w", err), instance)
+	case configMap.Data[configMapResultField] != "":
+		// the tree was created, but the status was not persisted
+		return i.handleExtractJobResult(ctx, instance)
+	case configMap.GetAnnotations()[pendingTreeAnnotation] == "":
+		// the createtree Job owns the tree creation
+		return i.Continue()
+	}
+
+	address, err := trillianutils.Resolve(ctx, i.Client, *i.wrapper(instance).GetTrillianService(), instance.GetNamespace())
+	if err != nil {
+		if errors.Is(err, trillianutils.ErrNotReady) {
+			i.Logger.V(1).Info("waiting for Trillian to become ready", "error", err.Error())
+			return i.RequeueAfter(5 * time.Second)
+		}
+		return i.Error(ctx, err, instance)
+	}
+
+	conn, err := trillianutils.Dial(ctx, i.Client, instance, address)
+	if err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	defer func() { _ = conn.Close() }()
+
+	rpcCtx, cancel := context.WithTimeout(ctx, trillianutils.Timeout)
+	defer cancel()
+	if err = trillianutils.Connect(rpcCtx, conn); err != nil {
+		if configMap == nil {
+			i.Logger.Info("Trillian admin API is not reachable, falling back to createtree job", "address", address, "error", err.Error())
+			return i.Continue()
+		}
+		// an earlier CreateTree request may have been sent, the tree must be looked up before anything else
+		return i.treeError(ctx, instance, err)
+	}
+
+	description := string(instance.GetUID())
+	var tree *trillian.Tree
+	if configMap != nil {
+		tree, err = trillianutils.FindTree(rpcCtx, conn, func(t *trillian.Tree) bool {
+			return t.GetDisplayName() == i.treeDisplayName && t.GetDescription() == description
+		})
+		if err != nil {
+			return i.treeError(ctx, instance, err)
+		}
+		if tree != nil {
+			i.Logger.Info("found the tree of an earlier attempt", "treeID", tree.TreeId)
+			if err = trillianutils.InitLog(rpcCtx, conn, tree.TreeId); err != nil {
+				return i.treeError(ctx, instance, err)
+			}
+		}
+	}
+
+	if tree == nil {
+		if configMap, err = i.markPending(ctx, instance, configMapName); err != nil {
+			return i.Error(ctx, fmt.Errorf("could not create %s ConfigMap: %w", configMapName, err), instance)
+		}
+		tree, err = trillianutils.CreateTree(rpcCtx, conn, &trillian.Tree{
+			TreeState:       trillian.TreeState_ACTIVE,
+			TreeType:        trillian.TreeType_LOG,
+			DisplayName:     i.treeDisplayName,
+			Description:     description,
+			MaxRootDuration: durationpb.New(maxRootDuration),
+		})
+		if err != nil {
+			return i.treeError(ctx, instance, err)
+		}
+	}
+
+	// the result survives a failure to persist the status
+	configMap.Data = map[string]string{configMapResultField: strconv.FormatInt(tree.TreeId, 10)}
+	if err = i.Client.Update(ctx, configMap); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not update %s ConfigMap: %w", configMapName, err), instance)
+	}
+
+	treeID := tree.TreeId
+	i.wrapper(instance).SetStatusTreeID(&treeID)
+	instance.SetCondition(metav1.Condition{
+		Type:   JobCondition,
+		Status: metav1.ConditionTrue,
+		Reason: state.Ready.String(),
+	})
+	i.Recorder.Eventf(instance, nil, corev1.EventTypeNormal, "TrillianTreeCreated", "Created", "New Trillian tree created: %d", treeID)
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
+
+// markPending creates the result ConfigMap annotated with the display name of the tree about to be created.
+func (i resolveTree[T]) markPending(ctx context.Context, instance T, name string) (*corev1.ConfigMap, error) {
+	labels := labels.For(ComponentName, i.component, instance.GetName())
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: instance.GetNamespace(),
+		},
+	}
+	if _, err := kubernetes.CreateOrUpdate(ctx, i.Client, configMap,
+		ensure.ControllerReference[*corev1.ConfigMap](instance, i.Client),
+		ensure.Labels[*corev1.ConfigMap](slices.Collect(maps.Keys(labels)), labels),
+		ensure.Annotations[*corev1.ConfigMap]([]string{pendingTreeAnnotation}, map[string]string{pendingTreeAnnotation: i.treeDisplayName}),
+	); err != nil {
+		return nil, err
+	}
+	return configMap, nil
+}
+
+func (i resolveTree[T]) treeError(ctx context.Context, instance T, err error) *action.Result {
+	return i.Error(ctx, err, instance, metav1.Condition{
+		Type:    JobCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  state.Creating.String(),
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,261 @@
+package tree
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/trillian"
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	_ "github.com/securesign/operator/internal/controller/trillian/serviceresolver"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	testTrillian "github.com/securesign/operator/internal/testing/trillian"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newAdminAPIInstance() *rhtasv1.Rekor {
+	return &rhtasv1.Rekor{
+		ObjectMeta: metav1.ObjectMeta{Name: nnObject.Name, Namespace: nnObject.Namespace, UID: "test-uid"},
+	}
+}
+
+func pendingConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nnResult.Name,
+			Namespace:   nnResult.Namespace,
+			Annotations: map[string]string{pendingTreeAnnotation: "test-tree"},
+		},
+		Data: data,
+	}
+}
+
+func TestHandleAdminAPI(t *testing.T) {
+	for _, tc := range []struct {
+		desc    string
+		server  *testTrillian.Server
+		objects []*corev1.ConfigMap
+		ctx     func(context.Context) context.Context
+		verify  func(Gomega, client.Client, *testTrillian.Server, *rhtasv1.Rekor, any)
+	}{
+		{
+			desc:   "create and initialise tree",
+			server: testTrillian.NewServer(42),
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(Equal(testAction.Return()))
+				g.Expect(server.Connections()).To(ConsistOf("trillian-logserver.default.svc:8091"))
+				tree := server.Tree(42)
+				g.Expect(tree).ToNot(BeNil())
+				g.Expect(tree.GetDisplayName()).To(Equal("test-tree"))
+				g.Expect(tree.GetTreeType()).To(Equal(trillian.TreeType_LOG))
+				g.Expect(tree.GetTreeState()).To(Equal(trillian.TreeState_ACTIVE))
+				g.Expect(tree.GetDescription()).To(Equal("test-uid"))
+				g.Expect(server.Sizes).To(HaveKey(int64(42)))
+				g.Expect(r.Status.TreeID).To(Equal(ptr.To(int64(42))))
+				g.Expect(meta.IsStatusConditionTrue(r.Status.Conditions, JobCondition)).To(BeTrue())
+
+				cm := &corev1.ConfigMap{}
+				g.Expect(c.Get(context.Background(), nnResult, cm)).To(Succeed())
+				g.Expect(cm.Annotations).To(HaveKeyWithValue(pendingTreeAnnotation, "test-tree"))
+				g.Expect(cm.Data).To(HaveKeyWithValue(configMapResultField, "42"))
+			},
+		},
+		{
+			desc: "unreachable trillian falls back to job",
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(Equal(testAction.Continue()))
+				g.Expect(r.Status.TreeID).To(BeNil())
+				g.Expect(c.Get(context.Background(), nnResult, &corev1.ConfigMap{})).To(WithTransform(apierrors.IsNotFound, BeTrue()))
+			},
+		},
+		{
+			desc:    "unreachable trillian with a pending tree does not fall back to job",
+			objects: []*corev1.ConfigMap{pendingConfigMap(nil)},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(HaveField("Err", MatchError(trillianutils.ErrNotConnected)))
+				g.Expect(r.Status.TreeID).To(BeNil())
+			},
+		},
+		{
+			desc:   "create tree timeout keeps the pending marker",
+			server: &testTrillian.Server{Errors: map[string]error{"CreateTree": status.Error(codes.DeadlineExceeded, "timeout")}},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(HaveField("Err", MatchError(ContainSubstring("could not create tree"))))
+				g.Expect(r.Status.TreeID).To(BeNil())
+
+				cm := &corev1.ConfigMap{}
+				g.Expect(c.Get(context.Background(), nnResult, cm)).To(Succeed())
+				g.Expect(cm.Annotations).To(HaveKeyWithValue(pendingTreeAnnotation, "test-tree"))
+				g.Expect(cm.Data).To(BeEmpty())
+			},
+		},
+		{
+			desc: "pending tree of an earlier attempt is adopted",
+			server: func() *testTrillian.Server {
+				server := testTrillian.NewServer(42)
+				server.Trees[7] = &trillian.Tree{TreeId: 7, DisplayName: "test-tree", Description: "other-uid"}
+				server.Trees[8] = &trillian.Tree{TreeId: 8, DisplayName: "test-tree", Description: "test-uid"}
+				return server
+			}(),
+			objects: []*corev1.ConfigMap{pendingConfigMap(nil)},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(Equal(testAction.Return()))
+				g.Expect(server.Trees).To(HaveLen(2))
+				g.Expect(server.Sizes).To(HaveKey(int64(8)))
+				g.Expect(r.Status.TreeID).To(Equal(ptr.To(int64(8))))
+
+				cm := &corev1.ConfigMap{}
+				g.Expect(c.Get(context.Background(), nnResult, cm)).To(Succeed())
+				g.Expect(cm.Data).To(HaveKeyWithValue(configMapResultField, "8"))
+			},
+		},
+		{
+			desc:    "pending tree that was never created is created",
+			server:  testTrillian.NewServer(42),
+			objects: []*corev1.ConfigMap{pendingConfigMap(nil)},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(Equal(testAction.Return()))
+				g.Expect(server.Trees).To(HaveLen(1))
+				g.Expect(r.Status.TreeID).To(Equal(ptr.To(int64(42))))
+			},
+		},
+		{
+			desc:    "lookup failure of a pending tree",
+			server:  &testTrillian.Server{Errors: map[string]error{"ListTrees": status.Error(codes.Unavailable, "unavailable")}},
+			objects: []*corev1.ConfigMap{pendingConfigMap(nil)},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(HaveField("Err", MatchError(ContainSubstring("could not list trees"))))
+				g.Expect(r.Status.TreeID).To(BeNil())
+			},
+		},
+		{
+			desc:    "created tree is recovered from the result",
+			server:  testTrillian.NewServer(42),
+			objects: []*corev1.ConfigMap{pendingConfigMap(map[string]string{configMapResultField: "7"})},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(Equal(testAction.Return()))
+				g.Expect(server.Connections()).To(BeEmpty())
+				g.Expect(r.Status.TreeID).To(Equal(ptr.To(int64(7))))
+			},
+		},
+		{
+			desc:   "create tree failure",
+			server: &testTrillian.Server{Errors: map[string]error{"CreateTree": status.Error(codes.PermissionDenied, "denied")}},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(HaveField("Err", MatchError(ContainSubstring("could not create tree"))))
+				g.Expect(r.Status.TreeID).To(BeNil())
+				condition := meta.FindStatusCondition(r.Status.Conditions, JobCondition)
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(condition.Reason).To(Equal(state.Creating.String()))
+			},
+		},
+		{
+			desc: "init log failure deletes the tree",
+			server: func() *testTrillian.Server {
+				server := testTrillian.NewServer(42)
+				server.Errors["InitLog"] = status.Error(codes.Internal, "boom")
+				return server
+			}(),
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(HaveField("Err", MatchError(ContainSubstring("could not initialise tree 42"))))
+				g.Expect(server.DeletedTrees()).To(ConsistOf(int64(42)))
+				g.Expect(r.Status.TreeID).To(BeNil())
+			},
+		},
+		{
+			desc:   "job already launched",
+			server: testTrillian.NewServer(42),
+			objects: []*corev1.ConfigMap{{
+				ObjectMeta: metav1.ObjectMeta{Name: nnResult.Name, Namespace: nnResult.Namespace},
+			}},
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(Equal(testAction.Continue()))
+				g.Expect(server.Connections()).To(BeEmpty())
+				g.Expect(server.Trees).To(BeEmpty())
+			},
+		},
+		{
+			desc:   "dry-run",
+			server: testTrillian.NewServer(42),
+			ctx:    kubernetes.WithDryRun,
+			verify: func(g Gomega, c client.Client, server *testTrillian.Server, r *rhtasv1.Rekor, result any) {
+				g.Expect(result).To(Equal(testAction.Continue()))
+				g.Expect(server.Connections()).To(BeEmpty())
+			},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+			if tc.ctx != nil {
+				ctx = tc.ctx(ctx)
+			}
+			if tc.server != nil {
+				testTrillian.Serve(t, tc.server)
+			} else {
+				testTrillian.ServeUnreachable(t)
+			}
+
+			instance := newAdminAPIInstance()
+			builder := testAction.FakeClientBuilder().WithObjects(instance).WithStatusSubresource(instance)
+			for _, o := range tc.objects {
+				builder = builder.WithObjects(o)
+			}
+			c := builder.Build()
+
+			a := testAction.PrepareAction(c, NewResolveTreeAction("test", Wrapper[*rhtasv1.Rekor](
+				func(rekor *rhtasv1.Rekor) *int64 { return rekor.Spec.TreeID },
+				func(rekor *rhtasv1.Rekor) *rhtasv1.LocalObjectReference { return rekor.Spec.TreeRef },
+				func(rekor *rhtasv1.Rekor) *int64 { return rekor.Status.TreeID },
+				func(rekor *rhtasv1.Rekor, i *int64) { rekor.Status.TreeID = i },
+				func(rekor *rhtasv1.Rekor) *rhtasv1.ServiceReference {
+					return &rhtasv1.ServiceReference{URL: "trillian-logserver.default.svc:8091"}
+				},
+				func(rekor *rhtasv1.Rekor) rhtasv1.PodRequirements { return rekor.Spec.PodRequirements },
+			)))
+			ra := a.(*resolveTree[*rhtasv1.Rekor])
+
+			g.Expect(c.Get(ctx, nnObject, instance)).To(Succeed())
+			result := ra.handleAdminAPI(ctx, instance)
+
+			g.Expect(c.Get(ctx, nnObject, instance)).To(Succeed())
+			tc.verify(g, c, tc.server, instance, result)
+		})
+	}
+}
+
+func TestHandleAdminAPI_WaitsForTrillian(t *testing.T) {
+	g := NewWithT(t)
+	server := testTrillian.NewServer(42)
+	testTrillian.Serve(t, server)
+
+	instance := newAdminAPIInstance()
+	trillianInstance := &rhtasv1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: nnObject.Namespace},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance, trillianInstance).WithStatusSubresource(instance).Build()
+	a := testAction.PrepareAction(c, NewResolveTreeAction("test", Wrapper[*rhtasv1.Rekor](
+		func(rekor *rhtasv1.Rekor) *int64 { return rekor.Spec.TreeID },
+		func(rekor *rhtasv1.Rekor) *rhtasv1.LocalObjectReference { return rekor.Spec.TreeRef },
+		func(rekor *rhtasv1.Rekor) *int64 { return rekor.Status.TreeID },
+		func(rekor *rhtasv1.Rekor, i *int64) { rekor.Status.TreeID = i },
+		func(rekor *rhtasv1.Rekor) *rhtasv1.ServiceReference { return &rekor.Spec.Trillian },
+		func(rekor *rhtasv1.Rekor) rhtasv1.PodRequirements { return rekor.Spec.PodRequirements },
+	)))
+	ra := a.(*resolveTree[*rhtasv1.Rekor])
+
+	result := ra.handleAdminAPI(context.Background(), instance)
+	g.Expect(result).To(Equal(testAction.RequeueAfter(5 * time.Second)))
+	g.Expect(server.Connections()).To(BeEmpty())
+}
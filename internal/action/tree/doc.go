@@ -12,12 +12,19 @@ the [apis.ConditionsAwareObject] and [apis.TlsClient] interfaces.
 
 Workflow:
  1. Check if the tree is already resolved. If a tree ID exists in the resource, update the status and exit.
- 2. Prepare the environment by creating or updating the necessary RBAC resources (ServiceAccount, Role, and RoleBinding)
+ 2. Create and initialise the tree in-process by calling the Trillian admin and log gRPC API (CreateTree, InitLog). The
+    service address is resolved from the Trillian service reference and the connection trusts the Trillian TLS CA
+    on OpenShift or when a TrustedCA is configured. The result ConfigMap is annotated as pending before CreateTree is
+    sent and receives the tree ID afterwards; while it is pending, the tree of an earlier attempt is looked up by its
+    display name and the instance UID in its description instead of creating another one. On success, the status is
+    updated and the remaining steps are skipped. When no connection to Trillian can be established (e.g. restricted
+    by a network policy) and no tree is pending, the action falls back to the createtree Job.
+ 3. Prepare the environment by creating or updating the necessary RBAC resources (ServiceAccount, Role, and RoleBinding)
     and setting up a ConfigMap used to store the result of the tree creation job.
- 3. Launch the tree creation job by submitting a Kubernetes Job that executes the Trillian tree creation script. Configuration,
+ 4. Launch the tree creation job by submitting a Kubernetes Job that executes the Trillian tree creation script. Configuration,
     such as the ConfigMap name, admin server address, and TLS settings, is passed to the job via environment variables.
- 4. Monitor and handle the job by waiting for its completion. If the job is still running or fails, requeue the reconciliation.
- 5. Process the job results by extracting the tree ID from the ConfigMap, updating the custom resource status with the new tree ID,
+ 5. Monitor and handle the job by waiting for its completion. If the job is still running or fails, requeue the reconciliation.
+ 6. Process the job results by extracting the tree ID from the ConfigMap, updating the custom resource status with the new tree ID,
     and recording a success event.
 
 Usage:
@@ -26,6 +33,9 @@ Usage:
 		func(obj *rhtasv1.Rekor) *int64 {
 			return obj.Spec.TreeID
 		},
+		func(obj *rhtasv1.Rekor) *rhtasv1.LocalObjectReference {
+			return obj.Spec.TreeRef
+		},
 		func(obj *rhtasv1.Rekor) *int64 {
 			return obj.Status.TreeID
 		},
@@ -34,6 +44,9 @@ Usage:
 		},
 		func(obj *rhtasv1.Rekor) *rhtasv1.ServiceReference {
 			return &obj.Spec.Trillian
+		},
+		func(obj *rhtasv1.Rekor) rhtasv1.PodRequirements {
+			return obj.Spec.PodRequirements
 		})
 	tree.NewResolveTreeAction[*rhtasv1.Rekor]("rekor", wrapper)
 */
@@ -11,22 +11,27 @@ import (
 var jobScript []byte
 
 const (
+	ComponentName           = "createtree"
 	RBACNameMask            = "%s-createtree-job"
 	JobNameMask             = "%s-createtree-job-"
 	JobCondition            = "Tree"
 	createTreeContainerName = "createtree"
 	configMapResultMask     = "%s-%s-createtree-result"
 	configMapResultField    = "tree_id"
+	// pendingTreeAnnotation marks the result ConfigMap of a tree created via the Trillian admin API.
+	pendingTreeAnnotation = "rhtas.redhat.com/pending-tree"
 )
 
-func Wrapper[T tlsAwareObject](getTree, getStatusTree func(T) *int64, setStatusTree func(T, *int64), getTrillianService func(T) *rhtasv1.ServiceReference) func(T) *wrapper[T] {
+func Wrapper[T tlsAwareObject](getTree func(T) *int64, getTreeRef func(T) *rhtasv1.LocalObjectReference, getStatusTree func(T) *int64, setStatusTree func(T, *int64), getTrillianService func(T) *rhtasv1.ServiceReference, getPodRequirements func(T) rhtasv1.PodRequirements) func(T) *wrapper[T] {
 	return func(obj T) *wrapper[T] {
 		return &wrapper[T]{
 			object:              obj,
 			callTree:            getTree,
+			callTreeRef:         getTreeRef,
 			callStatusTree:      getStatusTree,
 			callSetStatusTree:   setStatusTree,
 			callTrillianService: getTrillianService,
+			callPodRequirements: getPodRequirements,
 		}
 	}
 }
@@ -35,15 +40,21 @@ type wrapper[T tlsAwareObject] struct {
 	object T
 
 	callTree            func(T) *int64
+	callTreeRef         func(T) *rhtasv1.LocalObjectReference
 	callStatusTree      func(T) *int64
 	callSetStatusTree   func(T, *int64)
 	callTrillianService func(T) *rhtasv1.ServiceReference
+	callPodRequirements func(T) rhtasv1.PodRequirements
 }
 
 func (c *wrapper[T]) GetTreeID() *int64 {
 	return c.callTree(c.object)
 }
 
+func (c *wrapper[T]) GetTreeRef() *rhtasv1.LocalObjectReference {
+	return c.callTreeRef(c.object)
+}
+
 func (c *wrapper[T]) GetStatusTreeID() *int64 {
 	return c.callStatusTree(c.object)
 }
@@ -56,6 +67,10 @@ func (c *wrapper[T]) GetTrillianService() *rhtasv1.ServiceReference {
 	return c.callTrillianService(c.object)
 }
 
+func (c *wrapper[T]) GetPodRequirements() rhtasv1.PodRequirements {
+	return c.callPodRequirements(c.object)
+}
+
 type tlsAwareObject interface {
 	apis.ConditionsAwareObject
 	apis.TlsClient
@@ -9,6 +9,7 @@ import (
 	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/apis"
 	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/metrics"
 	"github.com/securesign/operator/internal/state"
 	k8sutils "github.com/securesign/operator/internal/utils/kubernetes"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
@@ -96,6 +97,7 @@ func (a *resolveAction[T]) handleFetchFailure(ctx context.Context, instance T, c
 func (a *resolveAction[T]) handleDrift(ctx context.Context, instance T, cond *metav1.Condition) *action.Result {
 	// Already flagged — re-affirm without re-persisting or re-firing the event.
 	if cond != nil && cond.Reason == ReasonDrifted {
+		metrics.SetTrustMaterialDrifted(instance, a.resolver.ComponentName(), true)
 		return a.Return()
 	}
 
@@ -119,6 +121,7 @@ func (a *resolveAction[T]) handleDrift(ctx context.Context, instance T, cond *me
 	}
 
 	a.Recorder.Eventf(instance, nil, "Warning", "TrustMaterialDrifted", ReasonDrifted, message)
+	metrics.SetTrustMaterialDrifted(instance, a.resolver.ComponentName(), true)
 	return a.Return()
 }
 
@@ -141,6 +144,7 @@ func (a *resolveAction[T]) acceptTrustMaterial(ctx context.Context, instance T,
 	if _, err := a.PersistStatus(ctx, instance); err != nil {
 		return a.Error(ctx, fmt.Errorf("%w: %s: %w", ErrPersistStatus, a.resolver.ComponentName(), err), instance)
 	}
+	metrics.SetTrustMaterialDrifted(instance, a.resolver.ComponentName(), false)
 
 	switch {
 	case current == "":
@@ -125,6 +125,55 @@
 //	  name: example
 //	  annotations:
 //	    rhtas.redhat.com/log-type: "dev"
+//
+// # Annotation: rhtas.redhat.com/drift-report-only
+//
+// [DriftReportOnly] keeps external modifications of a managed resource.
+//
+// The operator detects when a managed resource (e.g. a Deployment, ConfigMap or Secret) was modified outside
+// of the operator since its last reconciliation and reverts the modified fields. Each correction is logged with
+// the list of changed fields, counted by the rhtas_operator_configuration_drift_total metric and reported by a
+// ConfigurationDrift event on the resource.
+//
+// Options:
+//   - "true": The drift is reported but not reverted.
+//   - "false": The drift is reverted (default behavior).
+//
+// Note: Changes of the owning custom resource are still applied, only to the fields they change. The drift is
+// reported again when the set of modified fields changes.
+//
+// Example usage:
+//
+//	apiVersion: apps/v1
+//	kind: Deployment
+//	metadata:
+//	  name: example
+//	  annotations:
+//	    rhtas.redhat.com/drift-report-only: "true"
+//
+// # Annotation: rhtas.redhat.com/dry-run
+//
+// [DryRun] switches a custom resource to plan mode.
+//
+// The controller runs its reconciliation with server-side dry-run on all writes and stores a summary
+// of the resources that would be created, updated or deleted and of the Jobs that would run
+// in the <name>-<kind>-dry-run ConfigMap. Nothing else is changed in the cluster.
+//
+// Options:
+//   - "true": Plans the changes without applying them.
+//   - "false": Applies the changes (default behavior).
+//
+// Note: The plan of a Securesign lists the changes of its child resources (e.g. Rekor), but not
+// the changes the child controllers would make in turn.
+//
+// Example usage:
+//
+//	apiVersion: rhtas.redhat.com/v1
+//	kind: Rekor
+//	metadata:
+//	  name: example
+//	  annotations:
+//	    rhtas.redhat.com/dry-run: "true"
 package annotations
 
 const (
@@ -147,7 +196,16 @@ const (
 	// trust material change and accept the newly observed value.
 	RefreshTrustMaterial = "rhtas.redhat.com/refresh-trust-material"
 
+	// DriftReportOnly defines the annotation key used to report configuration drift of a managed resource without reverting it.
+	DriftReportOnly = "rhtas.redhat.com/drift-report-only"
+
+	// DryRun defines the annotation key used to plan the changes of a resource without applying them.
+	DryRun = "rhtas.redhat.com/dry-run"
+
 	TLS = "service.beta.openshift.io/serving-cert-secret-name"
+
+	// PublishedUntil marks a renewed certificate secret that stays published next to its successor until the RFC 3339 time.
+	PublishedUntil = "rhtas.redhat.com/published-until"
 )
 
 var InheritableAnnotations = []string{
@@ -9,4 +9,13 @@ var (
 	APIServerTimeout         time.Duration
 	IngressHostTemplate      = "%[1]s.local"
 	DisableClusterTLSProfile bool
+
+	NetworkPolicyIngressNamespace    = "ingress-nginx"
+	NetworkPolicyMonitoringNamespace = "monitoring"
+
+	// WatchNamespaces restricts the operator to the listed namespaces. Empty means all namespaces.
+	WatchNamespaces []string
+
+	// CertificateExpiryThresholds are the remaining validity periods at which a CertificateExpiring condition is raised.
+	CertificateExpiryThresholds = []time.Duration{30 * 24 * time.Hour, 7 * 24 * time.Hour, 24 * time.Hour}
 )
@@ -0,0 +1,21 @@
+package api
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certexpiry"
+)
+
+type consoleCertificates struct{}
+
+func (consoleCertificates) Certificates(i *rhtasv1.Console) []certexpiry.Certificate {
+	return []certexpiry.Certificate{{Name: "api-tls", Ref: i.Status.Api.TLS.CertRef}}
+}
+
+func (consoleCertificates) Status(i *rhtasv1.Console) *[]rhtasv1.CertificateStatus {
+	return &i.Status.Certificates
+}
+
+func NewCertificateExpiryAction() action.Action[*rhtasv1.Console] {
+	return certexpiry.NewAction[*rhtasv1.Console](consoleCertificates{})
+}
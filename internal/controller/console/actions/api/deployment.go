@@ -70,10 +70,12 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.Console) *ac
 		},
 		ensure.ControllerReference[*apps.Deployment](instance, i.Client),
 		ensure.Labels[*apps.Deployment](slices.Collect(maps.Keys(l)), l),
-		ensureApiDeployment(l, tufURL),
+		deployment.Autoscaling(instance.Spec.Api.Autoscaling,
+			ensureApiDeployment(l, tufURL),
+			deployment.PodRequirements(instance.Spec.Api.PodRequirements, actions.ApiDeploymentName),
+		),
 		ensureTUFWaitInitContainer(tufURL),
 		ensureApiProbes(),
-		deployment.PodRequirements(instance.Spec.Api.PodRequirements, actions.ApiDeploymentName),
 		deployment.Proxy(),
 		deployment.GODEBUG(instance.GetAnnotations()),
 		deployment.TrustedCA(instance.GetTrustedCA(), actions.ApiDeploymentName),
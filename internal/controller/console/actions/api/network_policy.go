@@ -0,0 +1,33 @@
+package api
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/controller/console/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type apiNetworkPolicyConfig struct{}
+
+// IsEnabled enforces the policy with authentication, so the API is reachable through the authenticated UI only.
+func (apiNetworkPolicyConfig) IsEnabled(i *rhtasv1.Console) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled) || actions.AuthEnabled(i)
+}
+
+func (apiNetworkPolicyConfig) Ingress(_ *rhtasv1.Console) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{actions.ApiPort},
+			networkpolicy.FromComponents(actions.UIComponentName),
+		),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Console] {
+	return networkpolicy.NewAction(
+		actions.ApiComponentName,
+		actions.ApiDeploymentName,
+		apiNetworkPolicyConfig{},
+	)
+}
@@ -0,0 +1,8 @@
+package actions
+
+import rhtasv1 "github.com/securesign/operator/api/v1"
+
+// AuthEnabled reports whether the Console UI is served through the auth proxy.
+func AuthEnabled(instance *rhtasv1.Console) bool {
+	return instance.Spec.Auth != nil
+}
@@ -20,4 +20,11 @@ const (
 
 	UIPort     int32 = 8080
 	UIPortName       = "http"
+
+	AuthProxyContainerName       = "auth-proxy"
+	AuthProxyPort          int32 = 8888
+	AuthProxyPortName            = "auth-proxy"
+	// AuthProxySecret holds the session cookie secret of the auth proxy.
+	AuthProxySecret          = "%s-console-auth-proxy"
+	AuthProxyCookieSecretKey = "cookie-secret"
 )
@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/controller/console/actions"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// oauthRedirectURIAnnotation registers the ServiceAccount as an OpenShift OAuth client for the UI host.
+const oauthRedirectURIAnnotation = "serviceaccounts.openshift.io/oauth-redirecturi.console"
+
+var ErrOpenShiftAuthUnsupported = errors.New("OpenShift authentication is only supported on OpenShift")
+
+func NewAuthProxyAction() action.Action[*rhtasv1.Console] {
+	return &authProxyAction{}
+}
+
+type authProxyAction struct {
+	action.BaseAction
+}
+
+func (i authProxyAction) Name() string {
+	return "ui auth proxy"
+}
+
+func (i authProxyAction) CanHandle(_ context.Context, instance *rhtasv1.Console) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (i authProxyAction) Handle(ctx context.Context, instance *rhtasv1.Console) *action.Result {
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf(actions.AuthProxySecret, instance.Name),
+			Namespace: instance.Namespace,
+		},
+	}
+
+	if !actions.AuthEnabled(instance) {
+		if err := client.IgnoreNotFound(i.Client.Delete(ctx, secret)); err != nil {
+			return i.Error(ctx, fmt.Errorf("could not delete auth proxy secret: %w", err), instance)
+		}
+		return i.Continue()
+	}
+
+	if instance.Spec.Auth.Type == rhtasv1.ConsoleAuthOpenShift && !kubernetes.IsOpenShift() {
+		return i.Error(ctx, reconcile.TerminalError(ErrOpenShiftAuthUnsupported), instance, metav1.Condition{
+			Type:    actions.UICondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Failure.String(),
+			Message: ErrOpenShiftAuthUnsupported.Error(),
+		})
+	}
+
+	if err := i.ensureCookieSecret(ctx, instance, secret); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not create auth proxy secret: %w", err), instance, metav1.Condition{
+			Type:    actions.UICondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Failure.String(),
+			Message: err.Error(),
+		})
+	}
+
+	if instance.Spec.Auth.Type == rhtasv1.ConsoleAuthOpenShift {
+		if err := i.ensureOAuthClient(ctx, instance); err != nil {
+			return i.Error(ctx, fmt.Errorf("could not register OAuth client: %w", err), instance, metav1.Condition{
+				Type:    actions.UICondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  state.Failure.String(),
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return i.Continue()
+}
+
+// ensureCookieSecret generates the session cookie secret once and keeps it afterward.
+func (i authProxyAction) ensureCookieSecret(ctx context.Context, instance *rhtasv1.Console, secret *core.Secret) error {
+	err := i.Client.Get(ctx, client.ObjectKeyFromObject(secret), secret)
+	if err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// 16 random bytes hex-encoded give the 32 byte secret required for AES-256 cookie encryption
+	raw := make([]byte, 16)
+	if _, err = rand.Read(raw); err != nil {
+		return err
+	}
+	l := labels.For(actions.UIComponentName, actions.UIDeploymentName, instance.Name)
+	return kubernetes.Create(ctx, i.Client, secret,
+		ensure.ControllerReference[*core.Secret](instance, i.Client),
+		ensure.Labels[*core.Secret](slices.Collect(maps.Keys(l)), l),
+		func(s *core.Secret) error {
+			s.Data = map[string][]byte{actions.AuthProxyCookieSecretKey: []byte(hex.EncodeToString(raw))}
+			return nil
+		},
+	)
+}
+
+// ensureOAuthClient allows the OpenShift OAuth server to redirect back to the UI host
+// on behalf of the UI ServiceAccount.
+func (i authProxyAction) ensureOAuthClient(ctx context.Context, instance *rhtasv1.Console) error {
+	host := instance.Spec.UI.Ingress.Host
+	if host == "" {
+		var err error
+		if host, err = kubernetes.CalculateHostname(ctx, i.Client, actions.UIDeploymentName, instance.Namespace); err != nil {
+			return err
+		}
+	}
+
+	sa := &core.ServiceAccount{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: actions.RBACUIName, Namespace: instance.Namespace}, sa); err != nil {
+		return err
+	}
+	redirect := "https://" + host
+	if sa.Annotations[oauthRedirectURIAnnotation] == redirect {
+		return nil
+	}
+	patch := client.MergeFrom(sa.DeepCopy())
+	if sa.Annotations == nil {
+		sa.Annotations = map[string]string{}
+	}
+	sa.Annotations[oauthRedirectURIAnnotation] = redirect
+	return i.Client.Patch(ctx, sa, patch)
+}
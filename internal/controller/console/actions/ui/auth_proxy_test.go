@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/controller/console/actions"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func authConsole(auth *rhtasv1.ConsoleAuth) *rhtasv1.Console {
+	return &rhtasv1.Console{
+		ObjectMeta: metav1.ObjectMeta{Name: "console", Namespace: "default"},
+		Spec:       rhtasv1.ConsoleSpec{Auth: auth},
+		Status: rhtasv1.ConsoleStatus{
+			Conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Creating.String()}},
+		},
+	}
+}
+
+func oidcAuth() *rhtasv1.ConsoleAuth {
+	return &rhtasv1.ConsoleAuth{
+		Type: rhtasv1.ConsoleAuthOIDC,
+		OIDC: &rhtasv1.ConsoleOIDCAuth{
+			IssuerURL: "https://issuer.example.com",
+			ClientID:  "console",
+			ClientSecretRef: rhtasv1.SecretKeySelector{
+				LocalObjectReference: rhtasv1.LocalObjectReference{Name: "oidc"},
+				Key:                  "secret",
+			},
+			AllowedGroups: []string{"admins", "auditors"},
+		},
+	}
+}
+
+func TestAuthProxy_CookieSecret(t *testing.T) {
+	g := NewWithT(t)
+	instance := authConsole(oidcAuth())
+	c := testAction.FakeClientBuilder().WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewAuthProxyAction())
+
+	g.Expect(a.CanHandle(t.Context(), instance)).To(BeTrue())
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+
+	key := types.NamespacedName{Name: fmt.Sprintf(actions.AuthProxySecret, instance.Name), Namespace: instance.Namespace}
+	secret := &core.Secret{}
+	g.Expect(c.Get(t.Context(), key, secret)).To(Succeed())
+	cookie := secret.Data[actions.AuthProxyCookieSecretKey]
+	g.Expect(cookie).To(HaveLen(32))
+
+	// the secret is generated once, sessions survive reconciliation
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+	g.Expect(c.Get(t.Context(), key, secret)).To(Succeed())
+	g.Expect(secret.Data[actions.AuthProxyCookieSecretKey]).To(Equal(cookie))
+
+	// disabling auth removes the secret
+	instance.Spec.Auth = nil
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+	g.Expect(c.Get(t.Context(), key, secret)).ToNot(Succeed())
+}
+
+func TestAuthProxy_OpenShiftUnsupported(t *testing.T) {
+	g := NewWithT(t)
+	instance := authConsole(&rhtasv1.ConsoleAuth{Type: rhtasv1.ConsoleAuthOpenShift})
+	c := testAction.FakeClientBuilder().WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewAuthProxyAction())
+
+	result := a.Handle(t.Context(), instance)
+	g.Expect(result).ToNot(BeNil())
+	g.Expect(result.Err).To(MatchError(ErrOpenShiftAuthUnsupported))
+	condition := meta.FindStatusCondition(instance.Status.Conditions, actions.UICondition)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Reason).To(Equal(state.Failure.String()))
+}
+
+func TestEnsureAuthProxy(t *testing.T) {
+	g := NewWithT(t)
+	instance := authConsole(oidcAuth())
+	dp := &apps.Deployment{}
+	dp.Spec.Template.Spec.Containers = []core.Container{{Name: actions.UIDeploymentName}}
+
+	g.Expect(ensureAuthProxy(instance)(dp)).To(Succeed())
+	g.Expect(dp.Spec.Template.Spec.Containers).To(HaveLen(2))
+	proxy := dp.Spec.Template.Spec.Containers[slices.IndexFunc(dp.Spec.Template.Spec.Containers, func(c core.Container) bool {
+		return c.Name == actions.AuthProxyContainerName
+	})]
+	g.Expect(proxy.Args).To(ContainElements(
+		"--provider=oidc",
+		"--oidc-issuer-url=https://issuer.example.com",
+		"--client-id=console",
+		"--oidc-groups-claim=groups",
+		"--allowed-group=admins",
+		"--allowed-group=auditors",
+		fmt.Sprintf("--upstream=http://localhost:%d", actions.UIPort),
+	))
+	g.Expect(proxy.Ports).To(ContainElement(HaveField("ContainerPort", actions.AuthProxyPort)))
+	g.Expect(proxy.Env).To(ContainElement(HaveField("Name", "OAUTH2_PROXY_CLIENT_SECRET")))
+
+	instance.Spec.Auth = &rhtasv1.ConsoleAuth{
+		Type: rhtasv1.ConsoleAuthOpenShift,
+		OpenShift: &rhtasv1.ConsoleOpenShiftAuth{
+			SubjectAccessReview: &rhtasv1.ConsoleSubjectAccessReview{Resource: "namespaces", Name: "default", Verb: "get"},
+		},
+	}
+	g.Expect(ensureAuthProxy(instance)(dp)).To(Succeed())
+	proxy = dp.Spec.Template.Spec.Containers[1]
+	g.Expect(proxy.Args).To(ContainElements(
+		"--provider=openshift",
+		"--openshift-service-account="+actions.RBACUIName,
+		`--openshift-sar={"group":"","namespace":"default","resource":"namespaces","resourceName":"default","verb":"get"}`,
+	))
+	g.Expect(proxy.ReadinessProbe.HTTPGet.Path).To(Equal("/oauth/healthz"))
+
+	instance.Spec.Auth = nil
+	g.Expect(ensureAuthProxy(instance)(dp)).To(Succeed())
+	g.Expect(dp.Spec.Template.Spec.Containers).To(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Name).To(Equal(actions.UIDeploymentName))
+}
+
+func TestService_AuthTargetPort(t *testing.T) {
+	g := NewWithT(t)
+	instance := authConsole(oidcAuth())
+	c := testAction.FakeClientBuilder().WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewCreateServiceAction())
+
+	a.Handle(t.Context(), instance)
+	svc := &core.Service{}
+	g.Expect(c.Get(t.Context(), client.ObjectKey{Name: actions.UIDeploymentName, Namespace: instance.Namespace}, svc)).To(Succeed())
+	g.Expect(svc.Spec.Ports[0].TargetPort.StrVal).To(Equal(actions.AuthProxyPortName))
+}
@@ -0,0 +1,22 @@
+package ui
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/autoscaling"
+	"github.com/securesign/operator/internal/controller/console/actions"
+)
+
+type uiAutoscalingConfig struct{}
+
+func (uiAutoscalingConfig) Autoscaling(i *rhtasv1.Console) rhtasv1.Autoscaling {
+	return i.Spec.UI.Autoscaling
+}
+
+func NewAutoscalingAction() action.Action[*rhtasv1.Console] {
+	return autoscaling.NewAction(
+		actions.UIComponentName,
+		actions.UIDeploymentName,
+		uiAutoscalingConfig{},
+	)
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"slices"
@@ -69,10 +70,13 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.Console) *ac
 		},
 		ensure.ControllerReference[*apps.Deployment](instance, i.Client),
 		ensure.Labels[*apps.Deployment](slices.Collect(maps.Keys(l)), l),
-		ensureUIDeployment(instance, rekorURL, l),
+		deployment.Autoscaling(instance.Spec.UI.Autoscaling,
+			ensureUIDeployment(instance, rekorURL, l),
+			deployment.PodRequirements(instance.Spec.UI.PodRequirements, actions.UIDeploymentName),
+		),
 		ensureUIInitContainer(instance),
 		ensureUIProbes(),
-		deployment.PodRequirements(instance.Spec.UI.PodRequirements, actions.UIDeploymentName),
+		ensureAuthProxy(instance),
 		deployment.Proxy(),
 		deployment.GODEBUG(instance.GetAnnotations()),
 		deployment.TrustedCA(instance.GetTrustedCA(), actions.UIDeploymentName),
@@ -199,3 +203,119 @@ func ensureUIProbes() func(*apps.Deployment) error {
 		return nil
 	}
 }
+
+// ensureAuthProxy adds the auth proxy sidecar serving the UI, or removes it when authentication is disabled.
+func ensureAuthProxy(instance *rhtasv1.Console) func(*apps.Deployment) error {
+	return func(dp *apps.Deployment) error {
+		podSpec := &dp.Spec.Template.Spec
+		if !actions.AuthEnabled(instance) {
+			podSpec.Containers = slices.DeleteFunc(podSpec.Containers, func(c core.Container) bool {
+				return c.Name == actions.AuthProxyContainerName
+			})
+			return nil
+		}
+		auth := instance.Spec.Auth
+
+		container := kubernetes.FindContainerByNameOrCreate(podSpec, actions.AuthProxyContainerName)
+		port := kubernetes.FindPortByNameOrCreate(container, actions.AuthProxyPortName)
+		port.ContainerPort = actions.AuthProxyPort
+		port.Protocol = core.ProtocolTCP
+
+		cookieSecret := kubernetes.FindEnvByNameOrCreate(container, "OAUTH2_PROXY_COOKIE_SECRET")
+		cookieSecret.ValueFrom = &core.EnvVarSource{
+			SecretKeyRef: &core.SecretKeySelector{
+				LocalObjectReference: core.LocalObjectReference{Name: fmt.Sprintf(actions.AuthProxySecret, instance.Name)},
+				Key:                  actions.AuthProxyCookieSecretKey,
+			},
+		}
+
+		args := []string{
+			fmt.Sprintf("--http-address=0.0.0.0:%d", actions.AuthProxyPort),
+			fmt.Sprintf("--upstream=http://localhost:%d", actions.UIPort),
+		}
+		healthPath := "/ping"
+
+		switch auth.Type {
+		case rhtasv1.ConsoleAuthOIDC:
+			if auth.OIDC == nil {
+				return fmt.Errorf("OIDC auth requires the oidc configuration")
+			}
+			container.Image = images.Registry.Get(images.ConsoleAuthProxy)
+			clientSecret := kubernetes.FindEnvByNameOrCreate(container, "OAUTH2_PROXY_CLIENT_SECRET")
+			clientSecret.ValueFrom = &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: auth.OIDC.ClientSecretRef.Name},
+					Key:                  auth.OIDC.ClientSecretRef.Key,
+				},
+			}
+			args = append(args, oidcProxyArgs(auth.OIDC)...)
+		case rhtasv1.ConsoleAuthOpenShift:
+			container.Image = images.Registry.Get(images.ConsoleOAuthProxy)
+			healthPath = "/oauth/healthz"
+			sar, err := json.Marshal(openShiftSAR(instance))
+			if err != nil {
+				return err
+			}
+			args = append(args,
+				"--provider=openshift",
+				"--https-address=",
+				"--openshift-service-account="+actions.RBACUIName,
+				"--openshift-sar="+string(sar),
+			)
+		default:
+			return fmt.Errorf("unsupported auth type %q", auth.Type)
+		}
+		container.Args = args
+
+		if container.ReadinessProbe == nil {
+			container.ReadinessProbe = &core.Probe{}
+		}
+		if container.ReadinessProbe.HTTPGet == nil {
+			container.ReadinessProbe.HTTPGet = &core.HTTPGetAction{}
+		}
+		container.ReadinessProbe.HTTPGet.Path = healthPath
+		container.ReadinessProbe.HTTPGet.Port = intstr.FromString(actions.AuthProxyPortName)
+		container.ReadinessProbe.InitialDelaySeconds = 5
+		container.ReadinessProbe.PeriodSeconds = 10
+		return nil
+	}
+}
+
+func oidcProxyArgs(oidc *rhtasv1.ConsoleOIDCAuth) []string {
+	groupsClaim := oidc.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+	args := []string{
+		"--provider=oidc",
+		"--oidc-issuer-url=" + oidc.IssuerURL,
+		"--client-id=" + oidc.ClientID,
+		"--oidc-groups-claim=" + groupsClaim,
+		"--email-domain=*",
+		"--reverse-proxy=true",
+		"--skip-provider-button=true",
+	}
+	for _, group := range oidc.AllowedGroups {
+		args = append(args, "--allowed-group="+group)
+	}
+	return args
+}
+
+// openShiftSAR returns the access check of the oauth-proxy --openshift-sar flag.
+func openShiftSAR(instance *rhtasv1.Console) map[string]string {
+	sar := map[string]string{
+		"namespace":    instance.Namespace,
+		"group":        rhtasv1.GroupVersion.Group,
+		"resource":     "consoles",
+		"resourceName": instance.Name,
+		"verb":         "get",
+	}
+	if instance.Spec.Auth.OpenShift != nil && instance.Spec.Auth.OpenShift.SubjectAccessReview != nil {
+		custom := instance.Spec.Auth.OpenShift.SubjectAccessReview
+		sar["group"] = custom.Group
+		sar["resource"] = custom.Resource
+		sar["resourceName"] = custom.Name
+		sar["verb"] = custom.Verb
+	}
+	return sar
+}
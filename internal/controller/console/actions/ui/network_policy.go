@@ -0,0 +1,37 @@
+package ui
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/controller/console/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type uiNetworkPolicyConfig struct{}
+
+// IsEnabled enforces the policy with authentication, otherwise the UI port would bypass the auth proxy.
+func (uiNetworkPolicyConfig) IsEnabled(i *rhtasv1.Console) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled) || actions.AuthEnabled(i)
+}
+
+func (uiNetworkPolicyConfig) Ingress(i *rhtasv1.Console) []networkingv1.NetworkPolicyIngressRule {
+	port := actions.UIPort
+	if actions.AuthEnabled(i) {
+		port = actions.AuthProxyPort
+	}
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{port},
+			networkpolicy.FromIngressControllers(),
+		),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Console] {
+	return networkpolicy.NewAction(
+		actions.UIComponentName,
+		actions.UIDeploymentName,
+		uiNetworkPolicyConfig{},
+	)
+}
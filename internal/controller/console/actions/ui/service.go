@@ -45,12 +45,18 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1.Conso
 
 	l := labels.For(actions.UIComponentName, actions.UIDeploymentName, instance.Name)
 
+	// with authentication the service routes through the auth proxy sidecar
+	targetPort := actions.UIPortName
+	if actions.AuthEnabled(instance) {
+		targetPort = actions.AuthProxyPortName
+	}
+
 	ports := []v1.ServicePort{
 		{
 			Name:       actions.UIPortName,
 			Protocol:   v1.ProtocolTCP,
 			Port:       actions.UIPort,
-			TargetPort: intstr.FromString(actions.UIPortName),
+			TargetPort: intstr.FromString(targetPort),
 		},
 	}
 
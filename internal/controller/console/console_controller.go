@@ -2,18 +2,22 @@ package console
 
 import (
 	"context"
+	"time"
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/action/transitions"
 	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/controller"
-	"k8s.io/apimachinery/pkg/types"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/utils/kubernetes"
 
 	olpredicate "github.com/operator-framework/operator-lib/predicate"
 	"github.com/securesign/operator/internal/controller/console/actions"
 	consoleapi "github.com/securesign/operator/internal/controller/console/actions/api"
 	"github.com/securesign/operator/internal/controller/console/actions/ui"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v12 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/events"
 
@@ -39,6 +43,7 @@ type consoleReconciler struct {
 	client.Client
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
 }
 
 func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
@@ -46,6 +51,7 @@ func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.Even
 		Client:   c,
 		scheme:   scheme,
 		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
 	}
 }
 
@@ -58,20 +64,26 @@ func (r *consoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	log := ctrllog.FromContext(ctx)
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	var namespace v12.Namespace
-	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, &namespace); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+	// Check if the namespace is marked for deletion
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
-
-	if !namespace.DeletionTimestamp.IsZero() {
+	if terminating {
 		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
 		return ctrl.Result{}, nil
 	}
 
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
 	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
 	conditionSupplier := func(_ *rhtasv1.Console) []string {
 		return []string{actions.ApiCondition, actions.UICondition}
 	}
@@ -86,11 +98,16 @@ func (r *consoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		ui.NewRBACAction(),
 
 		consoleapi.NewDeployAction(),
+		consoleapi.NewAutoscalingAction(),
 		consoleapi.NewCreateServiceAction(),
+		consoleapi.NewNetworkPolicyAction(),
 
+		ui.NewAuthProxyAction(),
 		ui.NewDeployAction(),
+		ui.NewAutoscalingAction(),
 		ui.NewCreateServiceAction(),
 		ui.NewIngressAction(),
+		ui.NewNetworkPolicyAction(),
 		ui.NewStatusUrlAction(),
 
 		transitions.NewToInitializePhaseAction[*rhtasv1.Console](),
@@ -99,6 +116,11 @@ func (r *consoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		ui.NewRolloutCheckAction(),
 
 		transitions.NewToReadyPhaseAction[*rhtasv1.Console](),
+		consoleapi.NewCertificateExpiryAction(),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), actionList)
 	}
 
 	for _, a := range actionList {
@@ -108,7 +130,9 @@ func (r *consoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 
 		if a.CanHandle(ctx, target) {
 			log.V(2).Info("Executing " + a.Name())
+			start := time.Now()
 			result := a.Handle(ctx, target)
+			metrics.ObserveAction("console", a.Name(), start, result)
 			if result != nil {
 				return result.Result, result.Err
 			}
@@ -129,6 +153,8 @@ func (r *consoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&v1.Deployment{}).
 		Owns(&v12.Service{}).
 		Owns(&v13.Ingress{}).
+		Owns(&v13.NetworkPolicy{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Watches(&rhtasv1.Rekor{}, handler.EnqueueRequestsFromMapFunc(
 			ctrlutil.ServiceRefWatch(mgr.GetClient(), &rhtasv1.ConsoleList{}, func(o client.Object) rhtasv1.ServiceReference {
 				return o.(*rhtasv1.Console).Spec.UI.Rekor
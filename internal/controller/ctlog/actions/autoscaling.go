@@ -0,0 +1,21 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/autoscaling"
+)
+
+type ctlogAutoscalingConfig struct{}
+
+func (ctlogAutoscalingConfig) Autoscaling(i *rhtasv1.CTlog) rhtasv1.Autoscaling {
+	return i.Spec.Autoscaling
+}
+
+func NewAutoscalingAction() action.Action[*rhtasv1.CTlog] {
+	return autoscaling.NewAction(
+		ComponentName,
+		DeploymentName,
+		ctlogAutoscalingConfig{},
+	)
+}
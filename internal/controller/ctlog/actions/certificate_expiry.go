@@ -0,0 +1,30 @@
+package actions
+
+import (
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certexpiry"
+)
+
+type ctlogCertificates struct{}
+
+func (ctlogCertificates) Certificates(i *rhtasv1.CTlog) []certexpiry.Certificate {
+	certs := []certexpiry.Certificate{{Name: "tls", Ref: i.Status.TLS.CertRef}}
+	for idx := range i.Status.RootCertificates {
+		certs = append(certs, certexpiry.Certificate{
+			Name: fmt.Sprintf("rootCertificate-%d", idx),
+			Ref:  &i.Status.RootCertificates[idx],
+		})
+	}
+	return certs
+}
+
+func (ctlogCertificates) Status(i *rhtasv1.CTlog) *[]rhtasv1.CertificateStatus {
+	return &i.Status.Certificates
+}
+
+func NewCertificateExpiryAction() action.Action[*rhtasv1.CTlog] {
+	return certexpiry.NewAction[*rhtasv1.CTlog](ctlogCertificates{})
+}
@@ -8,6 +8,8 @@ const (
 	MonitoringRoleName     = "prometheus-k8s-ctlog"
 	MonitorStatefulSetName = "ctlog-monitor"
 	MonitorComponentName   = "ctlog-monitor"
+	// FulcioComponentName is the component label of Fulcio pods submitting certificates to the log.
+	FulcioComponentName = "fulcio"
 
 	CertCondition    = "FulcioCertAvailable"
 	TLSCondition     = "ServerTLS"
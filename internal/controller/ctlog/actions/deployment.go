@@ -68,14 +68,16 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.CTlog) *acti
 			},
 		},
 		deployment.PodExtensions(instance.Spec.PodExtensions, containerName),
-		i.ensureDeployment(instance, RBACName, labels),
+		deployment.Autoscaling(instance.Spec.Autoscaling,
+			i.ensureDeployment(instance, RBACName, labels),
+			deployment.PodRequirements(instance.Spec.PodRequirements, containerName),
+		),
 		ensure.ControllerReference[*v1.Deployment](instance, i.Client),
 		ensure.Labels[*v1.Deployment](slices.Collect(maps.Keys(labels)), labels),
 		deployment.Auth(containerName, instance.Spec.Auth),
 		deployment.Proxy(),
 		deployment.GODEBUG(instance.GetAnnotations()),
 		deployment.TrustedCA(instance.GetTrustedCA(), containerName),
-		deployment.PodRequirements(instance.Spec.PodRequirements, containerName),
 		deployment.PodSecurityContext(),
 		ensure.Optional(
 			ctlogutils.TlsEnabled(instance),
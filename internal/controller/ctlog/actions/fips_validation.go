@@ -48,6 +48,7 @@ func ctlogCryptoMaterial(ctx context.Context, i *rhtasv1.CTlog, c client.Client)
 		"spec.signer.file.publicKeyRef", fipsutil.ValidatePublicKeyPEM, &refs); err != nil {
 		return nil, err
 	}
+	fipsAction.AppendKeyAlgorithm(i.Spec.Signer.KeyAlgorithm, "spec.signer.keyAlgorithm", &refs)
 
 	// TLS material
 	if err := fipsAction.AppendSecretRef(ctx, c, i.Namespace, i.Spec.TLS.CertRef,
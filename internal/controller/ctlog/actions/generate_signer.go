@@ -51,8 +51,8 @@ func resolveRef(ctx context.Context, instance *rhtasv1.CTlog, c client.Client) (
 	return generateSigner.ResolveStatusSecret(ctx, c, instance.Status.PrivateKeyRef, instance.Namespace, fmt.Sprintf(signerSecretNameFormat, instance.Name))
 }
 
-func generateData(_ context.Context, _ *rhtasv1.CTlog, _ client.Client) (map[string][]byte, error) {
-	keyConfig, err := utils.CreatePrivateKey()
+func generateData(_ context.Context, instance *rhtasv1.CTlog, _ client.Client) (map[string][]byte, error) {
+	keyConfig, err := utils.CreatePrivateKey(keyAlgorithm(instance))
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +62,13 @@ func generateData(_ context.Context, _ *rhtasv1.CTlog, _ client.Client) (map[str
 	}, nil
 }
 
+// keyAlgorithm returns the algorithm of the generated signer key.
+func keyAlgorithm(instance *rhtasv1.CTlog) rhtasv1.KeyAlgorithm {
+	signer := instance.Spec.Signer.DeepCopy()
+	signer.SetDefaults()
+	return signer.KeyAlgorithm
+}
+
 func alignStatus(instance *rhtasv1.CTlog, ref rhtasv1.SecretKeySelector) {
 	file := instance.Spec.Signer.File
 	if file != nil && file.PrivateKeyRef != nil {
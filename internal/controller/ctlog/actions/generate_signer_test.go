@@ -161,6 +161,30 @@ func TestCTlogKeys_GeneratesCorrectKeyData(t *testing.T) {
 	g.Expect(configCond.Status).To(Equal(metav1.ConditionFalse))
 }
 
+func TestCTlogKeys_GeneratesKeyWithAlgorithm(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := ctlogInstance()
+	instance.Spec.Signer.KeyAlgorithm = rhtasv1.KeyAlgorithmECDSAP384
+
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance).
+		WithStatusSubresource(instance).
+		Build()
+
+	a := testAction.PrepareAction(c, NewGenerateSignerAction())
+	g.Expect(a.Handle(ctx, instance)).To(Equal(testAction.Return()))
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "ctlog-keys-config-instance", Namespace: "default"}, secret)).To(Succeed())
+
+	block, _ := pem.Decode(secret.Data[constants.KeyPrivate])
+	g.Expect(block).ToNot(BeNil())
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key.Curve).To(Equal(elliptic.P384()))
+}
+
 func TestCTlogKeys_MigrationFromPreExistingSecret(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
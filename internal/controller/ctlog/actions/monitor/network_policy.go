@@ -0,0 +1,30 @@
+package monitor
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/controller/ctlog/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type ctlogMonitorNetworkPolicyConfig struct{}
+
+func (ctlogMonitorNetworkPolicyConfig) IsEnabled(i *rhtasv1.CTlog) bool {
+	return enabled(i) && utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (ctlogMonitorNetworkPolicyConfig) Ingress(_ *rhtasv1.CTlog) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{actions.MonitorMetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.CTlog] {
+	return networkpolicy.NewAction(
+		actions.MonitorComponentName,
+		actions.MonitorStatefulSetName,
+		ctlogMonitorNetworkPolicyConfig{},
+	)
+}
@@ -93,6 +93,9 @@ func (i statefulSetAction) Handle(ctx context.Context, instance *rhtasv1.CTlog)
 		func(object *v1.StatefulSet) error {
 			return tlsensure.TrustedCA(instance.GetTrustedCA(), actions.MonitorStatefulSetName)(&object.Spec.Template)
 		},
+		func(object *v1.StatefulSet) error {
+			return ensure.PodScheduling(instance.Spec.PodRequirements)(&object.Spec.Template.Spec)
+		},
 		func(object *v1.StatefulSet) error {
 			return ensure.PodSecurityContext(&object.Spec.Template.Spec)
 		},
@@ -0,0 +1,34 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type ctlogNetworkPolicyConfig struct{}
+
+func (ctlogNetworkPolicyConfig) IsEnabled(i *rhtasv1.CTlog) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (ctlogNetworkPolicyConfig) Ingress(_ *rhtasv1.CTlog) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{ServerTargetPort},
+			networkpolicy.FromComponents(FulcioComponentName, MonitorComponentName),
+			networkpolicy.FromIngressControllers(),
+			networkpolicy.FromOperator(),
+		),
+		networkpolicy.Rule([]int32{MetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.CTlog] {
+	return networkpolicy.NewAction(
+		ComponentName,
+		DeploymentName,
+		ctlogNetworkPolicyConfig{},
+	)
+}
@@ -0,0 +1,48 @@
+package actions
+
+import (
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/alerting"
+	"github.com/securesign/operator/internal/utils"
+)
+
+type ctlogAlertingConfig struct{}
+
+func (ctlogAlertingConfig) PrometheusRule(i *rhtasv1.CTlog) rhtasv1.PrometheusRuleConfig {
+	return i.Spec.Monitoring.PrometheusRule
+}
+
+func (ctlogAlertingConfig) Rules(i *rhtasv1.CTlog) []alerting.Rule {
+	rules := []alerting.Rule{
+		{
+			Alert:       "CTlogSCTLatencyHigh",
+			Threshold:   "2",
+			For:         "10m",
+			Severity:    alerting.SeverityWarning,
+			Summary:     "CT log is slow to issue SCTs",
+			Description: "The 99th percentile latency of add-chain and add-pre-chain requests is {{ $value | humanizeDuration }}.",
+			Expr: func(namespace, threshold string) string {
+				return fmt.Sprintf(`histogram_quantile(0.99, sum by (le) (rate(http_latency_bucket{namespace=%q,service=%q,ep=~"AddChain|AddPreChain"}[5m]))) > %s`,
+					namespace, ComponentName, threshold)
+			},
+		},
+		alerting.CertificateExpiryRule("CTlogCertificateExpiring", "CTlog", i.Name),
+	}
+	if utils.IsEnabled(i.Spec.Monitoring.TLog.Enabled) {
+		rules = append(rules, alerting.MonitorInconsistencyRule("CTlogMonitorInconsistency", MonitorComponentName))
+	}
+	return rules
+}
+
+func NewPrometheusRuleAction() action.Action[*rhtasv1.CTlog] {
+	return alerting.NewAction(
+		ComponentName,
+		MonitoringRoleName,
+		DeploymentName,
+		"",
+		ctlogAlertingConfig{},
+	)
+}
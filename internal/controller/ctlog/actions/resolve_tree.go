@@ -11,6 +11,9 @@ func NewResolveTreeAction() action.Action[*rhtasv1.CTlog] {
 		func(rekor *rhtasv1.CTlog) *int64 {
 			return rekor.Spec.TreeID
 		},
+		func(rekor *rhtasv1.CTlog) *rhtasv1.LocalObjectReference {
+			return rekor.Spec.TreeRef
+		},
 		func(rekor *rhtasv1.CTlog) *int64 {
 			return rekor.Status.TreeID
 		},
@@ -19,6 +22,9 @@ func NewResolveTreeAction() action.Action[*rhtasv1.CTlog] {
 		},
 		func(rekor *rhtasv1.CTlog) *rhtasv1.ServiceReference {
 			return &rekor.Spec.Trillian
+		},
+		func(rekor *rhtasv1.CTlog) rhtasv1.PodRequirements {
+			return rekor.Spec.PodRequirements
 		})
 	return tree.NewResolveTreeAction[*rhtasv1.CTlog]("ctlog", wrapper)
 }
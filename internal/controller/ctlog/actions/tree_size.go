@@ -0,0 +1,73 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/trustmaterial"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/state"
+	httputils "github.com/securesign/operator/internal/utils/http"
+)
+
+// signedTreeHead is the subset of the RFC 6962 get-sth response the operator reports.
+type signedTreeHead struct {
+	TreeSize int64 `json:"tree_size"`
+}
+
+func NewTreeSizeAction() action.Action[*rhtasv1.CTlog] {
+	return &treeSizeAction{}
+}
+
+type treeSizeAction struct {
+	action.BaseAction
+}
+
+func (i treeSizeAction) Name() string {
+	return "record tree size"
+}
+
+func (i treeSizeAction) CanHandle(_ context.Context, instance *rhtasv1.CTlog) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Ready && instance.Status.TreeID != nil
+}
+
+func (i treeSizeAction) Handle(ctx context.Context, instance *rhtasv1.CTlog) *action.Result {
+	sth, err := i.fetchSignedTreeHead(ctx, instance)
+	if err != nil {
+		// metrics are best effort, never block the reconciliation
+		i.Logger.V(1).Info("could not read tree size", "error", err.Error())
+		return i.Continue()
+	}
+	metrics.SetTreeSize(instance, strconv.FormatInt(*instance.Status.TreeID, 10), sth.TreeSize)
+	return i.Continue()
+}
+
+func (i treeSizeAction) fetchSignedTreeHead(ctx context.Context, instance *rhtasv1.CTlog) (*signedTreeHead, error) {
+	baseURL, err := trustmaterial.ResolveBaseURL(instance)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.JoinPath(baseURL, instance.Spec.Prefix, "/ct/v1/get-sth")
+	if err != nil {
+		return nil, err
+	}
+	cas, err := httputils.LoadTrustedCAs(ctx, i.Client, instance)
+	if err != nil {
+		return nil, err
+	}
+	body, err := httputils.FetchFromAPI(ctx, httputils.GetClientBuilder()(cas...), u)
+	if err != nil {
+		return nil, err
+	}
+	sth := &signedTreeHead{}
+	if err = json.Unmarshal(body, sth); err != nil {
+		return nil, fmt.Errorf("parsing signed tree head: %w", err)
+	}
+	return sth, nil
+}
@@ -0,0 +1,47 @@
+package actions
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	httpmock "github.com/securesign/operator/internal/testing/http"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestTreeSize(t *testing.T) {
+	g := NewWithT(t)
+
+	httpmock.StubClientBuilder(t, "http://ctlog.default.svc/trusted-artifact-signer/ct/v1/get-sth", http.StatusOK,
+		`{"tree_size":7,"timestamp":1700000000000,"sha256_root_hash":"","tree_head_signature":""}`)
+
+	instance := &rhtasv1.CTlog{
+		ObjectMeta: metav1.ObjectMeta{Name: "tree-size", Namespace: "default"},
+		Spec:       rhtasv1.CTlogSpec{Prefix: "trusted-artifact-signer"},
+		Status: rhtasv1.CTlogStatus{
+			Url:    "http://ctlog.default.svc/trusted-artifact-signer",
+			TreeID: ptr.To(int64(5678)),
+			Conditions: []metav1.Condition{
+				{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
+			},
+		},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewTreeSizeAction())
+
+	g.Expect(a.CanHandle(t.Context(), instance)).To(BeTrue())
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+	g.Expect(testutil.GatherAndCompare(crmetrics.Registry, strings.NewReader(`
+# HELP rhtas_operator_tree_size Number of entries in the active transparency log tree.
+# TYPE rhtas_operator_tree_size gauge
+rhtas_operator_tree_size{kind="CTlog",name="tree-size",namespace="default",tree_id="5678"} 7
+`), "rhtas_operator_tree_size")).To(Succeed())
+}
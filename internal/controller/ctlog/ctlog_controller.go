@@ -18,6 +18,7 @@ package ctlog
 
 import (
 	"context"
+	"time"
 
 	olpredicate "github.com/operator-framework/operator-lib/predicate"
 	"github.com/securesign/operator/internal/action"
@@ -26,9 +27,13 @@ import (
 	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/controller"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/utils/kubernetes"
 
 	"github.com/securesign/operator/internal/controller/ctlog/actions"
 	fipsutil "github.com/securesign/operator/internal/utils/fips"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v12 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,6 +43,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	v1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -57,6 +63,7 @@ type ctlogReconciler struct {
 	client.Client
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
 }
 
 func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
@@ -64,12 +71,14 @@ func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.Even
 		Client:   c,
 		scheme:   scheme,
 		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
 	}
 }
 
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=ctlogs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=ctlogs/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=ctlogs/finalizers,verbs=update
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trilliantrees,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -86,22 +95,26 @@ func (r *ctlogReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	rlog := log.FromContext(ctx)
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Fetch the namespace
-	var namespace v12.Namespace
-	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, &namespace); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
 	// Check if the namespace is marked for deletion
-	if !namespace.DeletionTimestamp.IsZero() {
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating {
 		rlog.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
 		return ctrl.Result{}, nil
 	}
 
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
 	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
 	conditionSupplier := func(_ *rhtasv1.CTlog) []string {
 		conditions := []string{actions.CertCondition, actions.SignerCondition, actions.ConfigCondition, actions.TLSCondition, trustmaterial.TrustMaterialCondition}
 		return fipsutil.AppendFIPSCondition(conditions)
@@ -122,15 +135,19 @@ func (r *ctlogReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 		actions.NewRBACAction(),
 		actions.NewDeployAction(),
+		actions.NewAutoscalingAction(),
 		actions.NewServiceAction(),
 		actions.NewIngressAction(),
+		actions.NewNetworkPolicyAction(),
 		actions.NewStatusUrlAction(),
 		actions.NewCreateMonitorAction(),
+		actions.NewPrometheusRuleAction(),
 
 		monitor.NewRBACAction(),
 		monitor.NewStatefulSetAction(),
 		monitor.NewCreateServiceAction(),
 		monitor.NewCreateMonitorAction(),
+		monitor.NewNetworkPolicyAction(),
 
 		transitions.NewToInitializePhaseAction[*rhtasv1.CTlog](),
 
@@ -138,6 +155,12 @@ func (r *ctlogReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		actions.NewResolvePubKeyAction(),
 
 		transitions.NewToReadyPhaseAction[*rhtasv1.CTlog](),
+		actions.NewTreeSizeAction(),
+		actions.NewCertificateExpiryAction(),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, rlog, target.DeepCopy(), acs)
 	}
 
 	for _, a := range acs {
@@ -148,7 +171,9 @@ func (r *ctlogReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 		if a.CanHandle(ctx, target) {
 			rlog.V(1).Info("Executing " + a.Name())
+			start := time.Now()
 			result := a.Handle(ctx, target)
+			metrics.ObserveAction("ctlog", a.Name(), start, result)
 			if result != nil {
 				return result.Result, result.Err
 			}
@@ -171,6 +196,8 @@ func (r *ctlogReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&v1.Deployment{}).
 		Owns(&v12.Service{}).
 		Owns(&networkingv1.Ingress{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		// receive update on Fulcio root cert change (pulled from status.certificateChain)
 		Watches(&rhtasv1.Fulcio{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
 			list := &rhtasv1.CTlogList{}
@@ -201,6 +228,20 @@ func (r *ctlogReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				return o.(*rhtasv1.CTlog).Spec.Trillian
 			}),
 		), builder.WithPredicates(crpredicate.GenerationChangedPredicate{})).
+		Watches(&rhtasv1.TrillianTree{}, handler.EnqueueRequestsFromMapFunc(
+			ctrlutil.LocalRefWatch(mgr.GetClient(), &rhtasv1.CTlogList{}, func(o client.Object) *rhtasv1.LocalObjectReference {
+				return o.(*rhtasv1.CTlog).Spec.TreeRef
+			}),
+		), builder.WithPredicates(crpredicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldT, ok1 := e.ObjectOld.(*rhtasv1.TrillianTree)
+				newT, ok2 := e.ObjectNew.(*rhtasv1.TrillianTree)
+				if !ok1 || !ok2 {
+					return true
+				}
+				return !equality.Semantic.DeepEqual(oldT.Status.TreeID, newT.Status.TreeID)
+			},
+		})).
 		Watches(&rhtasv1.Tuf{}, handler.EnqueueRequestsFromMapFunc(
 			ctrlutil.ServiceRefWatch(mgr.GetClient(), &rhtasv1.CTlogList{}, func(o client.Object) rhtasv1.ServiceReference {
 				return o.(*rhtasv1.CTlog).Spec.Monitoring.Tuf
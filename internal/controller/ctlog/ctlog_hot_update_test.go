@@ -189,7 +189,7 @@ var _ = Describe("CTlog update test", func() {
 			}).WithContext(ctx).Should(Succeed())
 
 			By("Private key has changed")
-			key, err := utils.CreatePrivateKey()
+			key, err := utils.CreatePrivateKey(rhtasv1.KeyAlgorithmECDSAP256)
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(suite.Client().Create(ctx, &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
@@ -2,7 +2,6 @@ package utils
 
 import (
 	"bytes"
-	"crypto/elliptic"
 	"encoding/pem"
 	"fmt"
 
@@ -32,12 +31,6 @@ const (
 	privateKeyFile = "/ctfe-keys/private"
 )
 
-var supportedCurves = map[string]elliptic.Curve{
-	"p256": elliptic.P256(),
-	"p384": elliptic.P384(),
-	"p521": elliptic.P521(),
-}
-
 // Config abstracts the proto munging to/from bytes suitable for working
 // with secrets / configmaps. Note that we keep fulcioCerts here though
 // technically they are not part of the config, however because we create a
@@ -3,15 +3,12 @@ package utils
 import (
 	"bytes"
 	"crypto"
-	"crypto/ecdsa"
-	"crypto/rand"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
-)
 
-const (
-	curveType = "p256"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/utils/keys"
 )
 
 type KeyConfig struct {
@@ -20,44 +17,26 @@ type KeyConfig struct {
 	PublicKey      []byte
 }
 
-func CreatePrivateKey() (*KeyConfig, error) {
-
-	key, err := ecdsa.GenerateKey(supportedCurves[curveType], rand.Reader)
+// CreatePrivateKey generates a new signer key with the given algorithm.
+func CreatePrivateKey(algorithm rhtasv1.KeyAlgorithm) (*KeyConfig, error) {
+	key, err := keys.Generate(algorithm)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate private key: %w", err)
 	}
 
-	mKey, err := x509.MarshalECPrivateKey(key)
-	if err != nil {
-		return nil, err
-	}
-
-	var pemKey bytes.Buffer
-	err = pem.Encode(&pemKey, &pem.Block{
-		Type:  "EC PRIVATE KEY",
-		Bytes: mKey,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	mPubKey, err := x509.MarshalPKIXPublicKey(key.Public())
+	pemKey, err := keys.MarshalPrivateKey(key)
 	if err != nil {
 		return nil, err
 	}
 
-	var pemPubKey bytes.Buffer
-	err = pem.Encode(&pemPubKey, &pem.Block{
-		Type:  "PUBLIC KEY",
-		Bytes: mPubKey,
-	})
+	pemPubKey, err := keys.MarshalPublicKey(key.Public())
 	if err != nil {
 		return nil, err
 	}
 
 	return &KeyConfig{
-		PrivateKey: pemKey.Bytes(),
-		PublicKey:  pemPubKey.Bytes(),
+		PrivateKey: pemKey,
+		PublicKey:  pemPubKey,
 	}, nil
 }
 
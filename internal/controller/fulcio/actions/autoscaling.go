@@ -0,0 +1,21 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/autoscaling"
+)
+
+type fulcioAutoscalingConfig struct{}
+
+func (fulcioAutoscalingConfig) Autoscaling(i *rhtasv1.Fulcio) rhtasv1.Autoscaling {
+	return i.Spec.Autoscaling
+}
+
+func NewAutoscalingAction() action.Action[*rhtasv1.Fulcio] {
+	return autoscaling.NewAction(
+		ComponentName,
+		DeploymentName,
+		fulcioAutoscalingConfig{},
+	)
+}
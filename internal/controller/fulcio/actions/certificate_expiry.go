@@ -0,0 +1,24 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certexpiry"
+)
+
+type fulcioCertificates struct{}
+
+func (fulcioCertificates) Certificates(i *rhtasv1.Fulcio) []certexpiry.Certificate {
+	if i.Status.Certificate == nil {
+		return nil
+	}
+	return []certexpiry.Certificate{{Name: "ca", Ref: i.Status.Certificate.CARef}}
+}
+
+func (fulcioCertificates) Status(i *rhtasv1.Fulcio) *[]rhtasv1.CertificateStatus {
+	return &i.Status.Certificates
+}
+
+func NewCertificateExpiryAction() action.Action[*rhtasv1.Fulcio] {
+	return certexpiry.NewAction[*rhtasv1.Fulcio](fulcioCertificates{})
+}
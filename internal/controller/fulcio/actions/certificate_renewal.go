@@ -0,0 +1,62 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certrenewal"
+	"github.com/securesign/operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fulcioRenewalConfig renews the operator-generated intermediate CA. The root is kept, so certificates
+// issued by the previous intermediate keep chaining to the same trust anchor.
+type fulcioRenewalConfig struct{}
+
+func (fulcioRenewalConfig) IsEnabled(i *rhtasv1.Fulcio) bool {
+	intermediate := i.Spec.Signer.CertificateChain.IntermediateCA
+	return intermediate != nil && utils.IsEnabled(intermediate.Renewal.Enabled)
+}
+
+func (fulcioRenewalConfig) RenewBefore(i *rhtasv1.Fulcio) time.Duration {
+	renewal := i.Spec.Signer.CertificateChain.IntermediateCA.Renewal.DeepCopy()
+	renewal.SetDefaults()
+	return renewal.RenewBefore.Duration
+}
+
+func (fulcioRenewalConfig) Overlap(i *rhtasv1.Fulcio) time.Duration {
+	renewal := i.Spec.Signer.CertificateChain.IntermediateCA.Renewal.DeepCopy()
+	renewal.SetDefaults()
+	return renewal.Overlap.Duration
+}
+
+func (fulcioRenewalConfig) CurrentRef(i *rhtasv1.Fulcio) *rhtasv1.SecretKeySelector {
+	if i.Status.Certificate == nil {
+		return nil
+	}
+	return i.Status.Certificate.CARef
+}
+
+func (fulcioRenewalConfig) Renew(ctx context.Context, i *rhtasv1.Fulcio, c client.Client, current map[string][]byte) (map[string][]byte, error) {
+	return renewIntermediateData(ctx, i, c, current)
+}
+
+func (fulcioRenewalConfig) AlignStatus(i *rhtasv1.Fulcio, ref rhtasv1.SecretKeySelector) {
+	alignStatus(i, ref)
+}
+
+func (fulcioRenewalConfig) MutateSecret(i *rhtasv1.Fulcio, secret *corev1.Secret) {
+	mutateSecret(i, secret)
+}
+
+func NewCertificateRenewalAction() action.Action[*rhtasv1.Fulcio] {
+	return certrenewal.NewAction(
+		certSecretNameFormat,
+		ComponentName,
+		DeploymentName,
+		fulcioRenewalConfig{},
+	)
+}
@@ -0,0 +1,142 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/controller/fulcio/utils"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	v1 "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// configReloadTimeout is how long Fulcio has to reload an updated server config before the pods are restarted.
+	configReloadTimeout = 3 * time.Minute
+	// restartedAtAnnotation is the pod template annotation `kubectl rollout restart` uses.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+var errNoRunningPods = errors.New("no running Fulcio pods")
+
+// NewConfigReloadAction confirms that Fulcio serves the OIDC issuers of the server config. An updated config is
+// reloaded by Fulcio without a rollout, the pods are restarted if the reload is not confirmed within the timeout.
+func NewConfigReloadAction() action.Action[*rhtasv1.Fulcio] {
+	return &configReloadAction{now: time.Now}
+}
+
+type configReloadAction struct {
+	action.BaseAction
+	now func() time.Time
+}
+
+func (i configReloadAction) Name() string {
+	return "config reload check"
+}
+
+func (i configReloadAction) CanHandle(_ context.Context, instance *rhtasv1.Fulcio) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Initialize && instance.Status.ServerConfigRef != nil
+}
+
+func (i configReloadAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *action.Result {
+	if kubernetes.IsDryRun(ctx) {
+		return i.Continue()
+	}
+
+	loaded, err := i.configurationLoaded(ctx, instance)
+	if err != nil {
+		i.Logger.V(1).Info("could not read Fulcio configuration", "error", err.Error())
+	}
+	if loaded {
+		return i.Continue()
+	}
+
+	ready := meta.FindStatusCondition(instance.Status.Conditions, constants.ReadyCondition)
+	updated := ready.LastTransitionTime.Time
+	if i.now().Sub(updated) < configReloadTimeout {
+		return i.wait(ctx, instance, "Waiting for Fulcio to reload the server config")
+	}
+
+	dp := &v1.Deployment{}
+	if err = i.Client.Get(ctx, client.ObjectKey{Namespace: instance.Namespace, Name: DeploymentName}, dp); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not get Fulcio deployment: %w", err), instance)
+	}
+	restartedAt, err := time.Parse(time.RFC3339, dp.Spec.Template.Annotations[restartedAtAnnotation])
+	switch {
+	case err != nil || restartedAt.Before(updated):
+		if dp.Spec.Template.Annotations == nil {
+			dp.Spec.Template.Annotations = map[string]string{}
+		}
+		dp.Spec.Template.Annotations[restartedAtAnnotation] = i.now().Format(time.RFC3339)
+		if err = i.Client.Update(ctx, dp); err != nil {
+			return i.Error(ctx, fmt.Errorf("could not restart Fulcio deployment: %w", err), instance)
+		}
+		i.Recorder.Eventf(instance, dp, core.EventTypeWarning, "FulcioConfigReloadTimeout", "Restart",
+			"Fulcio did not reload the server config within %s, restarting pods", configReloadTimeout)
+		return i.wait(ctx, instance, "Restarting Fulcio to load the server config")
+	case i.now().Sub(restartedAt) < configReloadTimeout:
+		return i.wait(ctx, instance, "Restarting Fulcio to load the server config")
+	default:
+		// the restarted pods read the current config, Fulcio may publish the issuers differently
+		i.Recorder.Eventf(instance, nil, core.EventTypeWarning, "FulcioConfigNotConfirmed", "ReloadCheck",
+			"OIDC issuers served by Fulcio do not match the server config %s", instance.Status.ServerConfigRef.Name)
+		return i.Continue()
+	}
+}
+
+func (i configReloadAction) wait(ctx context.Context, instance *rhtasv1.Fulcio, message string) *action.Result {
+	ready := meta.FindStatusCondition(instance.Status.Conditions, constants.ReadyCondition)
+	ready.Message = message
+	if _, err := i.PersistStatus(ctx, instance); err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	return i.RequeueAfter(10 * time.Second)
+}
+
+// configurationLoaded reports whether every running Fulcio pod serves the OIDC issuers of the server config. The pods
+// are queried directly, a request through the Service would reach one of them only.
+func (i configReloadAction) configurationLoaded(ctx context.Context, instance *rhtasv1.Fulcio) (bool, error) {
+	list := &core.PodList{}
+	if err := i.Client.List(ctx, list, client.InNamespace(instance.Namespace),
+		client.MatchingLabels(labels.For(ComponentName, DeploymentName, instance.Name))); err != nil {
+		return false, fmt.Errorf("could not list Fulcio pods: %w", err)
+	}
+
+	httpClient := httputils.GetClientBuilder()()
+	running := 0
+	for _, pod := range list.Items {
+		if pod.Status.Phase != core.PodRunning || pod.Status.PodIP == "" || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		running++
+		u := "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(TargetServerPort)) + utils.ConfigurationPath
+		body, err := httputils.FetchFromAPI(ctx, httpClient, u)
+		if err != nil {
+			return false, fmt.Errorf("pod %s: %w", pod.Name, err)
+		}
+		loaded, err := utils.ConfigurationLoaded(instance.Spec.Config, body)
+		if err != nil {
+			return false, fmt.Errorf("pod %s: %w", pod.Name, err)
+		}
+		if !loaded {
+			i.Logger.V(1).Info("Fulcio pod did not reload the server config yet", "pod", pod.Name)
+			return false, nil
+		}
+	}
+	if running == 0 {
+		return false, errNoRunningPods
+	}
+	return true, nil
+}
@@ -0,0 +1,219 @@
+package actions
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	httpmock "github.com/securesign/operator/internal/testing/http"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	appsv1 "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestConfigReload_Handle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	config := rhtasv1.FulcioConfig{
+		OIDCIssuers: []rhtasv1.OIDCIssuer{
+			{Issuer: "https://example.com", ClientID: "sigstore", Type: "email"},
+			{Issuer: "https://other.example.com", IssuerURL: "https://internal.example.com", ClientID: "sigstore", Type: "email"},
+		},
+		MetaIssuers: []rhtasv1.OIDCIssuer{
+			{Issuer: "https://oidc.eks.*.amazonaws.com/id/*", ClientID: "sigstore", Type: "kubernetes"},
+		},
+	}
+	loaded := `{"issuers":[
+		{"issuerUrl":"https://example.com","audience":"sigstore","challengeClaim":"email"},
+		{"issuerUrl":"https://internal.example.com","audience":"sigstore","challengeClaim":"email"},
+		{"wildcardIssuerUrl":"https://oidc.eks.*.amazonaws.com/id/*","audience":"sigstore","challengeClaim":"sub"}]}`
+	previous := `{"issuers":[{"issuerUrl":"https://example.com","audience":"sigstore","challengeClaim":"email"}]}`
+
+	tests := []struct {
+		name        string
+		response    string
+		stale       string
+		updated     time.Duration
+		restartedAt string
+		want        *action.Result
+		verify      func(Gomega, *rhtasv1.Fulcio, *appsv1.Deployment, []string)
+	}{
+		{
+			name:     "config loaded",
+			response: loaded,
+			updated:  time.Minute,
+			want:     testAction.Continue(),
+			verify: func(g Gomega, _ *rhtasv1.Fulcio, dp *appsv1.Deployment, events []string) {
+				g.Expect(dp.Spec.Template.Annotations).ToNot(HaveKey(restartedAtAnnotation))
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name:     "one pod did not reload",
+			response: loaded,
+			stale:    previous,
+			updated:  time.Minute,
+			want:     testAction.RequeueAfter(10 * time.Second),
+			verify: func(g Gomega, f *rhtasv1.Fulcio, dp *appsv1.Deployment, events []string) {
+				g.Expect(meta.FindStatusCondition(f.Status.Conditions, constants.ReadyCondition).Message).
+					To(Equal("Waiting for Fulcio to reload the server config"))
+				g.Expect(dp.Spec.Template.Annotations).ToNot(HaveKey(restartedAtAnnotation))
+			},
+		},
+		{
+			name:     "waiting for reload",
+			response: previous,
+			updated:  time.Minute,
+			want:     testAction.RequeueAfter(10 * time.Second),
+			verify: func(g Gomega, f *rhtasv1.Fulcio, dp *appsv1.Deployment, events []string) {
+				g.Expect(meta.FindStatusCondition(f.Status.Conditions, constants.ReadyCondition).Message).
+					To(Equal("Waiting for Fulcio to reload the server config"))
+				g.Expect(dp.Spec.Template.Annotations).ToNot(HaveKey(restartedAtAnnotation))
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name:     "reload timeout",
+			response: previous,
+			updated:  5 * time.Minute,
+			want:     testAction.RequeueAfter(10 * time.Second),
+			verify: func(g Gomega, f *rhtasv1.Fulcio, dp *appsv1.Deployment, events []string) {
+				g.Expect(meta.FindStatusCondition(f.Status.Conditions, constants.ReadyCondition).Message).
+					To(Equal("Restarting Fulcio to load the server config"))
+				g.Expect(dp.Spec.Template.Annotations).To(HaveKeyWithValue(restartedAtAnnotation, now.Format(time.RFC3339)))
+				g.Expect(events).To(ConsistOf(HavePrefix("Warning FulcioConfigReloadTimeout")))
+			},
+		},
+		{
+			name:        "restart in progress",
+			response:    previous,
+			updated:     5 * time.Minute,
+			restartedAt: now.Add(-time.Minute).Format(time.RFC3339),
+			want:        testAction.RequeueAfter(10 * time.Second),
+			verify: func(g Gomega, _ *rhtasv1.Fulcio, dp *appsv1.Deployment, events []string) {
+				g.Expect(dp.Spec.Template.Annotations).To(HaveKeyWithValue(restartedAtAnnotation, now.Add(-time.Minute).Format(time.RFC3339)))
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name:        "restarted before the update",
+			response:    previous,
+			updated:     5 * time.Minute,
+			restartedAt: now.Add(-time.Hour).Format(time.RFC3339),
+			want:        testAction.RequeueAfter(10 * time.Second),
+			verify: func(g Gomega, _ *rhtasv1.Fulcio, dp *appsv1.Deployment, events []string) {
+				g.Expect(dp.Spec.Template.Annotations).To(HaveKeyWithValue(restartedAtAnnotation, now.Format(time.RFC3339)))
+				g.Expect(events).To(HaveLen(1))
+			},
+		},
+		{
+			name:        "not confirmed after restart",
+			response:    previous,
+			updated:     10 * time.Minute,
+			restartedAt: now.Add(-5 * time.Minute).Format(time.RFC3339),
+			want:        testAction.Continue(),
+			verify: func(g Gomega, _ *rhtasv1.Fulcio, _ *appsv1.Deployment, events []string) {
+				g.Expect(events).To(ConsistOf(HavePrefix("Warning FulcioConfigNotConfirmed")))
+			},
+		},
+		{
+			name:        "configuration endpoint unavailable",
+			updated:     10 * time.Minute,
+			restartedAt: now.Add(-5 * time.Minute).Format(time.RFC3339),
+			want:        testAction.Continue(),
+			verify: func(g Gomega, _ *rhtasv1.Fulcio, _ *appsv1.Deployment, events []string) {
+				g.Expect(events).To(ConsistOf(HavePrefix("Warning FulcioConfigNotConfirmed")))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			respond := func(body string) httpmock.RoundTripFunc {
+				return func(_ *http.Request) *http.Response {
+					if body == "" {
+						return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(bytes.NewReader(nil)), Header: make(http.Header)}
+					}
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: make(http.Header)}
+				}
+			}
+			stale := tt.response
+			if tt.stale != "" {
+				stale = tt.stale
+			}
+			mockClient := &http.Client{}
+			httpmock.SetMockTransport(mockClient, map[string]httpmock.RoundTripFunc{
+				"http://10.0.0.1:5555/api/v2/configuration": respond(tt.response),
+				"http://10.0.0.2:5555/api/v2/configuration": respond(stale),
+			})
+			orig := httputils.GetClientBuilder()
+			httputils.SetClientBuilder(func(_ ...[]byte) *http.Client { return mockClient })
+			t.Cleanup(func() { httputils.SetClientBuilder(orig) })
+
+			instance := &rhtasv1.Fulcio{
+				ObjectMeta: metav1.ObjectMeta{Name: "fulcio", Namespace: "default"},
+				Spec:       rhtasv1.FulcioSpec{Config: config},
+				Status: rhtasv1.FulcioStatus{
+					Url:             "http://fulcio.example.com",
+					ServerConfigRef: &rhtasv1.LocalObjectReference{Name: "config"},
+				},
+			}
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:               constants.ReadyCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             state.Initialize.String(),
+				LastTransitionTime: metav1.NewTime(now.Add(-tt.updated)),
+			})
+			dp := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: "default"}}
+			if tt.restartedAt != "" {
+				dp.Spec.Template.Annotations = map[string]string{restartedAtAnnotation: tt.restartedAt}
+			}
+
+			pod := func(name, ip string, phase core.PodPhase) *core.Pod {
+				return &core.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels.For(ComponentName, DeploymentName, instance.Name)},
+					Status:     core.PodStatus{Phase: phase, PodIP: ip},
+				}
+			}
+
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance, dp,
+					pod("fulcio-a", "10.0.0.1", core.PodRunning),
+					pod("fulcio-b", "10.0.0.2", core.PodRunning),
+					pod("fulcio-c", "", core.PodPending),
+				).
+				WithStatusSubresource(instance).
+				Build()
+			recorder := events.NewFakeRecorder(10)
+			a := testAction.PrepareAction(c, &configReloadAction{now: func() time.Time { return now }})
+			a.InjectRecorder(recorder)
+
+			g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+			g.Expect(a.Handle(ctx, instance)).To(Equal(tt.want))
+
+			updated := &rhtasv1.Fulcio{}
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(instance), updated)).To(Succeed())
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(dp), dp)).To(Succeed())
+			close(recorder.Events)
+			var recorded []string
+			for e := range recorder.Events {
+				recorded = append(recorded, e)
+			}
+			tt.verify(g, updated, dp, recorded)
+		})
+	}
+}
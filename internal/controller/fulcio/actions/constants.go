@@ -6,12 +6,15 @@ const (
 	FulcioCALabel = labels.LabelNamespace + "/fulcio_v1.crt.pem"
 
 	DeploymentName     = "fulcio-server"
+	GrpcIngressName    = "fulcio-server-grpc"
+	GrpcTLSSecret      = "%s-fulcio-grpc-tls"
 	ComponentName      = "fulcio"
 	MonitoringRoleName = "prometheus-k8s-fulcio"
 	ServiceMonitorName = "fulcio-metrics"
 	RBACName           = "fulcio"
 
-	CertCondition = "FulcioCertAvailable"
+	CertCondition               = "FulcioCertAvailable"
+	OIDCIssuersHealthyCondition = "OIDCIssuersHealthy"
 
 	ServerPortName   = "http"
 	ServerPort       = 80
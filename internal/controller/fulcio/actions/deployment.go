@@ -17,6 +17,7 @@ import (
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure/deployment"
+	"github.com/securesign/operator/internal/utils/tls"
 	v1 "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -77,9 +78,13 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *act
 			},
 		},
 		deployment.PodExtensions(instance.Spec.PodExtensions, containerName),
-		i.ensureCommonDeployment(instance, RBACName, labels, ctlogUrl),
+		deployment.Autoscaling(instance.Spec.Autoscaling,
+			i.ensureCommonDeployment(instance, RBACName, labels, ctlogUrl),
+			deployment.PodRequirements(instance.Spec.PodRequirements, containerName),
+		),
 		ensure.Optional(instance.Spec.Signer.Type == rhtasv1.FulcioSignerTypeFile || instance.Spec.Signer.Type == "",
 			i.ensureFileCADeployment(instance)),
+		ensure.Optional(grpcTLSEnabled(instance), i.ensureGrpcTLS(instance)),
 		ensure.ControllerReference[*v1.Deployment](instance, i.Client),
 		ensure.Labels[*v1.Deployment](slices.Collect(maps.Keys(labels)), labels),
 		deployment.Auth(containerName, instance.Spec.Auth),
@@ -88,7 +93,6 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *act
 		deployment.Proxy("@fulcio-legacy-grpc-socket"),
 		deployment.GODEBUG(instance.GetAnnotations()),
 		deployment.TrustedCA(instance.GetTrustedCA(), containerName),
-		deployment.PodRequirements(instance.Spec.PodRequirements, containerName),
 		deployment.PodSecurityContext(),
 	); err != nil {
 		return i.Error(ctx, fmt.Errorf("could not create Fulcio: %w", err), instance)
@@ -186,6 +190,33 @@ func (i deployAction) ensureCommonDeployment(instance *rhtasv1.Fulcio, sa string
 	}
 }
 
+// ensureGrpcTLS serves the gRPC port with the service serving certificate so that the
+// OpenShift route can re-encrypt gRPC traffic to the pod.
+func (i deployAction) ensureGrpcTLS(instance *rhtasv1.Fulcio) func(*v1.Deployment) error {
+	secret := fmt.Sprintf(GrpcTLSSecret, instance.Name)
+	return func(dp *v1.Deployment) error {
+		if err := deployment.TLS(rhtasv1.TLS{
+			CertRef: &rhtasv1.SecretKeySelector{
+				LocalObjectReference: rhtasv1.LocalObjectReference{Name: secret},
+				Key:                  tls.KeyCert,
+			},
+			PrivateKeyRef: &rhtasv1.SecretKeySelector{
+				LocalObjectReference: rhtasv1.LocalObjectReference{Name: secret},
+				Key:                  tls.KeyPrivate,
+			},
+		}, containerName)(dp); err != nil {
+			return err
+		}
+
+		container := kubernetes.FindContainerByNameOrCreate(&dp.Spec.Template.Spec, containerName)
+		container.Args = append(container.Args,
+			"--grpc-tls-certificate", tls.TLSCertPath,
+			"--grpc-tls-key", tls.TLSKeyPath,
+		)
+		return nil
+	}
+}
+
 func (i deployAction) ensureFileCADeployment(instance *rhtasv1.Fulcio) func(deployment *v1.Deployment) error {
 	return func(dp *v1.Deployment) error {
 		if instance.Status.ServerConfigRef == nil {
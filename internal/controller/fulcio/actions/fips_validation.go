@@ -35,6 +35,23 @@ func NewFIPSValidationAction() action.Action[*rhtasv1.Fulcio] {
 					"spec.signer.certificateChain.certificateChainRef", fipsutil.ValidateCertificateChainPEM, &refs); err != nil {
 					return nil, err
 				}
+				fipsAction.AppendKeyAlgorithm(i.Spec.Signer.KeyAlgorithm, "spec.signer.keyAlgorithm", &refs)
+				if intermediate := i.Spec.Signer.CertificateChain.IntermediateCA; intermediate != nil {
+					fipsAction.AppendKeyAlgorithm(intermediate.KeyAlgorithm,
+						"spec.signer.certificateChain.intermediateCA.keyAlgorithm", &refs)
+				}
+				// the root key is only needed until the intermediate is issued and may be removed afterwards
+				if intermediate := i.Spec.Signer.CertificateChain.IntermediateCA; intermediate != nil &&
+					(i.Status.Certificate == nil || i.Status.Certificate.CARef == nil) {
+					if err := fipsAction.AppendSecretRef(ctx, c, i.Namespace, intermediate.RootPrivateKeyRef,
+						"spec.signer.certificateChain.intermediateCA.rootPrivateKeyRef", fipsutil.ValidatePrivateKeyPEM, &refs); err != nil {
+						return nil, err
+					}
+					if err := fipsAction.AppendSecretRef(ctx, c, i.Namespace, intermediate.RootCertificateRef,
+						"spec.signer.certificateChain.intermediateCA.rootCertificateRef", fipsutil.ValidateCertificateChainPEM, &refs); err != nil {
+						return nil, err
+					}
+				}
 				return refs, nil
 			},
 		}),
@@ -2,31 +2,42 @@ package actions
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/elliptic"
-	"crypto/rand"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/action"
 	generateSigner "github.com/securesign/operator/internal/action/generateSigner"
 	"github.com/securesign/operator/internal/constants"
 	fulcioutils "github.com/securesign/operator/internal/controller/fulcio/utils"
+	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/keys"
 	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 const (
 	certSecretNameFormat = "fulcio-cert-config-%s"
+	// rootKeySecretNameFormat names the secret keeping the generated root private key, it is not mounted by Fulcio.
+	rootKeySecretNameFormat = "fulcio-root-key-%s"
 )
 
 var (
 	ErrMissingPrivateKey = errors.New("missing private key for CA certificate")
 	ErrMissingCACert     = errors.New("missing CA certificate for private key")
+
+	ErrMissingRootPrivateKey = errors.New("missing root private key for intermediate CA renewal")
+
+	errStaleRoot = errors.New("stored root private key belongs to another instance")
 )
 
 func NewGenerateSignerAction() action.Action[*rhtasv1.Fulcio] {
@@ -39,16 +50,18 @@ func NewGenerateSignerAction() action.Action[*rhtasv1.Fulcio] {
 			ResolveRef:   resolveRef,
 			GenerateData: generateData,
 			AlignStatus:  alignStatus,
-			MutateSecret: func(_ *rhtasv1.Fulcio, secret *corev1.Secret) {
-				if secret.Labels == nil {
-					secret.Labels = make(map[string]string)
-				}
-				secret.Labels[FulcioCALabel] = constants.KeyCert
-			},
+			MutateSecret: mutateSecret,
 		}),
 	)
 }
 
+func mutateSecret(_ *rhtasv1.Fulcio, secret *corev1.Secret) {
+	if secret.Labels == nil {
+		secret.Labels = make(map[string]string)
+	}
+	secret.Labels[FulcioCALabel] = constants.KeyCert
+}
+
 func resolveRef(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client) (*rhtasv1.SecretKeySelector, error) {
 	var privateKeyRef *rhtasv1.SecretKeySelector
 	if instance.Spec.Signer.File != nil {
@@ -83,6 +96,10 @@ func generateData(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client
 		return nil, err
 	}
 
+	if instance.Spec.Signer.CertificateChain.IntermediateCA != nil {
+		return generateIntermediateData(ctx, instance, c, commonName)
+	}
+
 	config := &fulcioutils.FulcioCertConfig{
 		OrganizationEmail: instance.Spec.Signer.CertificateChain.OrganizationEmail,
 		OrganizationName:  instance.Spec.Signer.CertificateChain.OrganizationName,
@@ -104,18 +121,18 @@ func generateData(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client
 		}
 		config.PrivateKey = key
 	} else {
-		key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		key, err := keys.Generate(keyAlgorithm(instance))
 		if err != nil {
 			return nil, err
 		}
 
-		pemKey, err := fulcioutils.CreateCAKey(key)
+		pemKey, err := keys.MarshalPrivateKey(key)
 		if err != nil {
 			return nil, err
 		}
 		config.PrivateKey = pemKey
 
-		pemPubKey, err := fulcioutils.CreateCAPub(key.Public())
+		pemPubKey, err := keys.MarshalPublicKey(key.Public())
 		if err != nil {
 			return nil, err
 		}
@@ -139,6 +156,194 @@ func generateData(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client
 	return config.ToData(), nil
 }
 
+// generateIntermediateData issues an intermediate CA from the external or a freshly generated root.
+// Fulcio signs with the intermediate key, the generated root key is only kept when storeRootPrivateKey is set.
+func generateIntermediateData(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client, commonName string) (map[string][]byte, error) {
+	intermediate, err := intermediateConfig(ctx, instance, c, commonName)
+	if err != nil {
+		return nil, err
+	}
+
+	if intermediate.RootPrivateKey == nil {
+		if intermediate.RootPrivateKey, intermediate.RootCert, err = generatedRoot(ctx, instance, c, commonName); err != nil {
+			return nil, err
+		}
+	}
+	return issueIntermediate(intermediate)
+}
+
+// generatedRoot returns the root CA generated by the operator. A stored root is reused, so the intermediate issued
+// after a failed attempt chains to the same root. The stored root of a previous Fulcio resource with the same name is
+// removed before a new root is stored, the secret is immutable.
+func generatedRoot(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client, commonName string) ([]byte, []byte, error) {
+	store := utils.IsEnabled(instance.Spec.Signer.CertificateChain.IntermediateCA.StoreRootPrivateKey)
+	if store {
+		key, cert, err := storedRoot(ctx, instance, c)
+		switch {
+		case err == nil:
+			return key, cert, nil
+		case errors.Is(err, errStaleRoot):
+			if err = c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf(rootKeySecretNameFormat, instance.Name),
+				Namespace: instance.Namespace,
+			}}); client.IgnoreNotFound(err) != nil {
+				return nil, nil, fmt.Errorf("could not remove the stale root private key: %w", err)
+			}
+		case !apierrors.IsNotFound(err):
+			return nil, nil, err
+		}
+	}
+
+	chain := instance.Spec.Signer.CertificateChain
+	rootKey, err := keys.Generate(keyAlgorithm(instance))
+	if err != nil {
+		return nil, nil, err
+	}
+	pemRootKey, err := keys.MarshalPrivateKey(rootKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	rootCert, err := fulcioutils.CreateFulcioCA(&fulcioutils.FulcioCertConfig{
+		PrivateKey:        pemRootKey,
+		OrganizationName:  chain.OrganizationName,
+		OrganizationEmail: chain.OrganizationEmail,
+		CommonName:        commonName,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if store {
+		componentLabels := labels.For(ComponentName, DeploymentName, instance.Name)
+		if _, err = kubernetes.CreateOrUpdate(ctx, c, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf(rootKeySecretNameFormat, instance.Name),
+				Namespace: instance.Namespace,
+			},
+		},
+			ensure.ControllerReference[*corev1.Secret](instance, c),
+			ensure.Labels[*corev1.Secret](slices.Collect(maps.Keys(componentLabels)), componentLabels),
+			kubernetes.EnsureSecretData(true, map[string][]byte{
+				constants.KeyPrivate: pemRootKey,
+				constants.KeyCert:    rootCert,
+			}),
+		); err != nil {
+			return nil, nil, fmt.Errorf("could not store the root private key: %w", err)
+		}
+	}
+	return pemRootKey, rootCert, nil
+}
+
+// storedRoot reads the root CA kept in the secret of the generated root private key. A secret not controlled by the
+// instance or without the key is stale.
+func storedRoot(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client) ([]byte, []byte, error) {
+	secret, err := kubernetes.GetSecret(ctx, c, instance.Namespace, fmt.Sprintf(rootKeySecretNameFormat, instance.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !metav1.IsControlledBy(secret, instance) || len(secret.Data[constants.KeyPrivate]) == 0 {
+		return nil, nil, errStaleRoot
+	}
+	return secret.Data[constants.KeyPrivate], secret.Data[constants.KeyCert], nil
+}
+
+// renewIntermediateData issues a new intermediate CA signed by the root of the current one.
+func renewIntermediateData(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client, current map[string][]byte) (map[string][]byte, error) {
+	commonName, err := resolveCommonName(ctx, instance, c)
+	if err != nil {
+		return nil, err
+	}
+	intermediate, err := intermediateConfig(ctx, instance, c, commonName)
+	if err != nil {
+		return nil, err
+	}
+
+	if intermediate.RootPrivateKey == nil {
+		if !utils.IsEnabled(instance.Spec.Signer.CertificateChain.IntermediateCA.StoreRootPrivateKey) {
+			return nil, ErrMissingRootPrivateKey
+		}
+		rootKey, _, err := storedRoot(ctx, instance, c)
+		switch {
+		case apierrors.IsNotFound(err), errors.Is(err, errStaleRoot):
+			return nil, ErrMissingRootPrivateKey
+		case err != nil:
+			return nil, err
+		}
+		rootCert, err := fulcioutils.RootCertificate(current[constants.KeyCert])
+		if err != nil {
+			return nil, err
+		}
+		intermediate.RootPrivateKey = rootKey
+		intermediate.RootCert = rootCert
+	}
+	return issueIntermediate(intermediate)
+}
+
+// intermediateConfig resolves the intermediate CA settings. The root material is only set for an external root.
+func intermediateConfig(ctx context.Context, instance *rhtasv1.Fulcio, c client.Client, commonName string) (*fulcioutils.FulcioIntermediateConfig, error) {
+	chain := instance.Spec.Signer.CertificateChain
+	spec := chain.IntermediateCA.DeepCopy()
+	spec.SetDefaults()
+
+	intermediate := &fulcioutils.FulcioIntermediateConfig{
+		CommonName:        spec.CommonName,
+		OrganizationName:  spec.OrganizationName,
+		OrganizationEmail: spec.OrganizationEmail,
+		Validity:          spec.Validity.Duration,
+		MaxPathLen:        int(ptr.Deref(spec.MaxPathLen, 0)),
+		KeyAlgorithm:      spec.KeyAlgorithm,
+		NameConstraints:   spec.NameConstraints,
+	}
+	if intermediate.CommonName == "" {
+		intermediate.CommonName = commonName
+	}
+	if intermediate.OrganizationName == "" {
+		intermediate.OrganizationName = chain.OrganizationName
+	}
+	if intermediate.OrganizationEmail == "" {
+		intermediate.OrganizationEmail = chain.OrganizationEmail
+	}
+
+	if spec.RootPrivateKeyRef != nil && spec.RootCertificateRef != nil {
+		key, err := kubernetes.GetSecretData(ctx, c, instance.Namespace, spec.RootPrivateKeyRef)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := kubernetes.GetSecretData(ctx, c, instance.Namespace, spec.RootCertificateRef)
+		if err != nil {
+			return nil, err
+		}
+		intermediate.RootPrivateKey = key
+		intermediate.RootCert = cert
+	}
+	return intermediate, nil
+}
+
+func issueIntermediate(intermediate *fulcioutils.FulcioIntermediateConfig) (map[string][]byte, error) {
+	result := &fulcioutils.FulcioCertConfig{}
+	key, err := keys.Generate(intermediate.KeyAlgorithm)
+	if err != nil {
+		return nil, reconcile.TerminalError(err)
+	}
+	if result.PrivateKey, err = keys.MarshalPrivateKey(key); err != nil {
+		return nil, err
+	}
+	if result.PublicKey, err = keys.MarshalPublicKey(key.Public()); err != nil {
+		return nil, err
+	}
+	if result.RootCert, err = fulcioutils.CreateFulcioIntermediate(intermediate, key); err != nil {
+		return nil, err
+	}
+	return result.ToData(), nil
+}
+
+// keyAlgorithm returns the algorithm of the generated CA or root key.
+func keyAlgorithm(instance *rhtasv1.Fulcio) rhtasv1.KeyAlgorithm {
+	signer := instance.Spec.Signer.DeepCopy()
+	signer.SetDefaults()
+	return signer.KeyAlgorithm
+}
+
 func alignStatus(instance *rhtasv1.Fulcio, ref rhtasv1.SecretKeySelector) {
 	if instance.Status.Certificate == nil {
 		instance.Status.Certificate = &rhtasv1.FulcioCertStatus{}
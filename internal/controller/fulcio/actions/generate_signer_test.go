@@ -4,9 +4,12 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	rhtasv1 "github.com/securesign/operator/api/v1"
@@ -17,8 +20,10 @@ import (
 	testAction "github.com/securesign/operator/internal/testing/action"
 	"github.com/securesign/operator/internal/utils/fips"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -232,6 +237,242 @@ func TestFulcioCert_GeneratesCorrectData(t *testing.T) {
 	g.Expect(secret.Labels).To(HaveKeyWithValue(FulcioCALabel, constants.KeyCert))
 }
 
+func TestFulcioCert_GeneratesCAWithKeyAlgorithm(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := fulcioInstance()
+	instance.Spec.Signer = rhtasv1.FulcioSigner{
+		Type:         "file",
+		KeyAlgorithm: rhtasv1.KeyAlgorithmRSA3072,
+		CertificateChain: rhtasv1.FulcioCertificateChain{
+			OrganizationName: "RH",
+		},
+	}
+	c := testAction.FakeClientBuilder().Build()
+
+	data, err := generateData(ctx, instance, c)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(data[constants.KeyPrivate]).To(ContainSubstring("RSA PRIVATE KEY"))
+
+	certs := parseChain(g, data[constants.KeyCert])
+	g.Expect(certs).To(HaveLen(1))
+	g.Expect(certs[0].PublicKeyAlgorithm).To(Equal(x509.RSA))
+	g.Expect(certs[0].CheckSignatureFrom(certs[0])).To(Succeed())
+}
+
+func TestFulcioCert_GeneratesIntermediateCA(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := fulcioInstance()
+	instance.Spec.Signer = rhtasv1.FulcioSigner{
+		Type: "file",
+		CertificateChain: rhtasv1.FulcioCertificateChain{
+			OrganizationName: "RH",
+			CommonName:       "fulcio.local",
+			IntermediateCA: &rhtasv1.FulcioIntermediateCA{
+				CommonName:   "fulcio-intermediate",
+				Validity:     &metav1.Duration{Duration: 24 * time.Hour},
+				KeyAlgorithm: rhtasv1.KeyAlgorithmRSA2048,
+				NameConstraints: &rhtasv1.NameConstraints{
+					PermittedEmailAddresses: []string{"example.com"},
+				},
+			},
+		},
+	}
+
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance).
+		WithStatusSubresource(instance).
+		Build()
+
+	a := testAction.PrepareAction(c, NewGenerateSignerAction())
+	g.Expect(a.Handle(ctx, instance)).To(Equal(testAction.Return()))
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: fmt.Sprintf(certSecretNameFormat, "instance"), Namespace: "default"}, secret)).To(Succeed())
+	g.Expect(secret.Data[constants.KeyPrivate]).To(ContainSubstring("RSA PRIVATE KEY"))
+	g.Expect(secret.Data).To(HaveLen(3))
+	// the generated root private key is discarded by default
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: fmt.Sprintf(rootKeySecretNameFormat, "instance"), Namespace: "default"}, &corev1.Secret{})).
+		To(WithTransform(apierrors.IsNotFound, BeTrue()))
+
+	certs := parseChain(g, secret.Data[constants.KeyCert])
+	g.Expect(certs).To(HaveLen(2))
+	intermediate, root := certs[0], certs[1]
+	g.Expect(intermediate.CheckSignatureFrom(root)).To(Succeed())
+	g.Expect(intermediate.Subject.CommonName).To(Equal("fulcio-intermediate"))
+	g.Expect(intermediate.Subject.Organization).To(ConsistOf("RH"))
+	g.Expect(intermediate.IsCA).To(BeTrue())
+	g.Expect(intermediate.MaxPathLenZero).To(BeTrue())
+	g.Expect(intermediate.PermittedEmailAddresses).To(ConsistOf("example.com"))
+	g.Expect(intermediate.NotAfter).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+	g.Expect(root.Subject.CommonName).To(Equal("fulcio.local"))
+}
+
+func TestFulcioCert_IntermediateFromExternalRoot(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	rootKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+	pemRootKey, err := utils.CreateCAKey(rootKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	rootCert, err := utils.CreateFulcioCA(&utils.FulcioCertConfig{PrivateKey: pemRootKey, OrganizationName: "Offline Root"})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	instance := fulcioInstance()
+	instance.Spec.Signer = rhtasv1.FulcioSigner{
+		Type: "file",
+		CertificateChain: rhtasv1.FulcioCertificateChain{
+			OrganizationName: "RH",
+			IntermediateCA: &rhtasv1.FulcioIntermediateCA{
+				RootCertificateRef: &rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: "root"},
+					Key:                  "cert",
+				},
+				RootPrivateKeyRef: &rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: "root"},
+					Key:                  "private",
+				},
+				MaxPathLen: ptr.To(int32(1)),
+			},
+		},
+	}
+
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance).
+		WithStatusSubresource(instance).
+		WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "default"},
+			Data:       map[string][]byte{"cert": rootCert, "private": pemRootKey},
+		}).
+		Build()
+
+	a := testAction.PrepareAction(c, NewGenerateSignerAction())
+	g.Expect(a.Handle(ctx, instance)).To(Equal(testAction.Return()))
+	g.Expect(instance.Status.Certificate.CARef.Name).To(Equal(fmt.Sprintf(certSecretNameFormat, "instance")))
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: fmt.Sprintf(certSecretNameFormat, "instance"), Namespace: "default"}, secret)).To(Succeed())
+	g.Expect(secret.Data[constants.KeyPrivate]).To(ContainSubstring("EC PRIVATE KEY"))
+	g.Expect(secret.Data).ToNot(HaveKey("rootPrivate"))
+
+	certs := parseChain(g, secret.Data[constants.KeyCert])
+	g.Expect(certs).To(HaveLen(2))
+	g.Expect(certs[0].CheckSignatureFrom(certs[1])).To(Succeed())
+	g.Expect(certs[0].MaxPathLen).To(Equal(1))
+	g.Expect(certs[1].Subject.Organization).To(ConsistOf("Offline Root"))
+}
+
+func TestFulcioCert_RenewsIntermediateCA(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := fulcioInstance()
+	instance.Spec.Signer = rhtasv1.FulcioSigner{
+		Type: "file",
+		CertificateChain: rhtasv1.FulcioCertificateChain{
+			OrganizationName: "RH",
+			CommonName:       "fulcio.local",
+			IntermediateCA: &rhtasv1.FulcioIntermediateCA{
+				Validity:            &metav1.Duration{Duration: 24 * time.Hour},
+				Renewal:             rhtasv1.CertificateRenewal{Enabled: ptr.To(true)},
+				StoreRootPrivateKey: ptr.To(true),
+			},
+		},
+	}
+	c := testAction.FakeClientBuilder().Build()
+
+	current, err := generateData(ctx, instance, c)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(current).ToNot(HaveKey("rootPrivate"))
+	root := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: fmt.Sprintf(rootKeySecretNameFormat, "instance"), Namespace: "default"}, root)).To(Succeed())
+	g.Expect(root.Immutable).To(Equal(ptr.To(true)))
+	g.Expect(root.Data).To(HaveKey(constants.KeyPrivate))
+	g.Expect(metav1.IsControlledBy(root, instance)).To(BeTrue())
+
+	renewed, err := fulcioRenewalConfig{}.Renew(ctx, instance, c, current)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(renewed[constants.KeyPrivate]).ToNot(Equal(current[constants.KeyPrivate]))
+
+	previous := parseChain(g, current[constants.KeyCert])
+	certs := parseChain(g, renewed[constants.KeyCert])
+	g.Expect(certs).To(HaveLen(2))
+	g.Expect(certs[1].Equal(previous[1])).To(BeTrue())
+	g.Expect(certs[0].CheckSignatureFrom(previous[1])).To(Succeed())
+	g.Expect(certs[0].SerialNumber).ToNot(Equal(previous[0].SerialNumber))
+}
+
+func TestFulcioCert_RenewalRequiresRootKey(t *testing.T) {
+	g := NewWithT(t)
+	instance := fulcioInstance()
+	instance.Spec.Signer.CertificateChain.OrganizationName = "RH"
+	instance.Spec.Signer.CertificateChain.IntermediateCA = &rhtasv1.FulcioIntermediateCA{}
+
+	_, err := fulcioRenewalConfig{}.Renew(t.Context(), instance, testAction.FakeClientBuilder().Build(), map[string][]byte{})
+	g.Expect(err).To(MatchError(ErrMissingRootPrivateKey))
+
+	// the secret of the stored root was removed
+	instance.Spec.Signer.CertificateChain.IntermediateCA.StoreRootPrivateKey = ptr.To(true)
+	_, err = fulcioRenewalConfig{}.Renew(t.Context(), instance, testAction.FakeClientBuilder().Build(), map[string][]byte{})
+	g.Expect(err).To(MatchError(ErrMissingRootPrivateKey))
+}
+
+func TestFulcioCert_ReplacesStaleRootKey(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := fulcioInstance()
+	instance.UID = "current"
+	instance.Spec.Signer = rhtasv1.FulcioSigner{
+		Type: "file",
+		CertificateChain: rhtasv1.FulcioCertificateChain{
+			OrganizationName: "RH",
+			CommonName:       "fulcio.local",
+			IntermediateCA: &rhtasv1.FulcioIntermediateCA{
+				StoreRootPrivateKey: ptr.To(true),
+			},
+		},
+	}
+	// left behind by a previous Fulcio resource with the same name
+	stale := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf(rootKeySecretNameFormat, "instance"),
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: rhtasv1.GroupVersion.String(), Kind: "Fulcio", Name: "instance", UID: "previous", Controller: ptr.To(true),
+			}},
+		},
+		Immutable: ptr.To(true),
+		Data:      map[string][]byte{constants.KeyPrivate: []byte("stale"), constants.KeyCert: []byte("stale")},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(stale).Build()
+
+	_, err := generateData(ctx, instance, c)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	root := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(stale), root)).To(Succeed())
+	g.Expect(metav1.IsControlledBy(root, instance)).To(BeTrue())
+	g.Expect(root.Data[constants.KeyPrivate]).ToNot(Equal([]byte("stale")))
+
+	// the stale root is never used for a renewal
+	g.Expect(c.Delete(ctx, root)).To(Succeed())
+	stale.ResourceVersion = ""
+	g.Expect(c.Create(ctx, stale)).To(Succeed())
+	_, err = fulcioRenewalConfig{}.Renew(ctx, instance, c, map[string][]byte{})
+	g.Expect(err).To(MatchError(ErrMissingRootPrivateKey))
+}
+
+func parseChain(g Gomega, data []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		g.Expect(err).ToNot(HaveOccurred())
+		certs = append(certs, cert)
+	}
+	return certs
+}
+
 func TestFulcioCert_MigrationFromPreExistingSecret(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
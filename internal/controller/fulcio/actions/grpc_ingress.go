@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	v1 "k8s.io/api/core/v1"
+	v2 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewGrpcIngressAction() action.Action[*rhtasv1.Fulcio] {
+	return &grpcIngressAction{}
+}
+
+type grpcIngressAction struct {
+	action.BaseAction
+}
+
+func (i grpcIngressAction) Name() string {
+	return "grpc ingress"
+}
+
+func (i grpcIngressAction) CanHandle(_ context.Context, instance *rhtasv1.Fulcio) bool {
+	return utils.IsEnabled(instance.Spec.GrpcIngress.Enabled) && state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (i grpcIngressAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *action.Result {
+	var (
+		result controllerutil.OperationResult
+		err    error
+	)
+	ok := types.NamespacedName{Name: DeploymentName, Namespace: instance.Namespace}
+	labels := labels.For(ComponentName, GrpcIngressName, instance.Name)
+
+	svc := &v1.Service{}
+	if err := i.Client.Get(ctx, ok, svc); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not find service for gRPC ingress: %w", err), instance)
+	}
+
+	// the HTTP ingress derives its default host from the service name, the gRPC one needs its own
+	conf := instance.Spec.GrpcIngress
+	if conf.Host == "" {
+		if conf.Host, err = kubernetes.CalculateHostname(ctx, i.Client, GrpcIngressName, instance.Namespace); err != nil {
+			return i.Error(ctx, fmt.Errorf("could not calculate gRPC ingress hostname: %w", err), instance)
+		}
+	}
+
+	if result, err = kubernetes.CreateOrUpdate(ctx, i.Client,
+		&v2.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: GrpcIngressName, Namespace: instance.Namespace},
+		},
+		kubernetes.EnsureIngressSpec(ctx, i.Client, *svc, conf, GRPCPortName),
+		kubernetes.EnsureGRPCIngress(),
+		// add ingress labels
+		ensure.Labels[*v2.Ingress](slices.Collect(maps.Keys(conf.Labels)), conf.Labels),
+		// add common labels
+		ensure.Labels[*v2.Ingress](slices.Collect(maps.Keys(labels)), labels),
+		ensure.ControllerReference[*v2.Ingress](instance, i.Client),
+	); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not create gRPC ingress object: %w", err), instance)
+	}
+
+	if result != controllerutil.OperationResultNone {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.ReadyCondition,
+			Status: metav1.ConditionFalse, Reason: state.Creating.String(), Message: "gRPC Ingress created",
+			ObservedGeneration: instance.Generation})
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	} else {
+		return i.Continue()
+	}
+}
+
+// grpcTLSEnabled reports whether Fulcio must serve its gRPC port over TLS. That is the case
+// on OpenShift when the gRPC API is exposed, because the route re-encrypts traffic to the pod.
+func grpcTLSEnabled(instance *rhtasv1.Fulcio) bool {
+	return utils.IsEnabled(instance.Spec.GrpcIngress.Enabled) && kubernetes.IsOpenShift()
+}
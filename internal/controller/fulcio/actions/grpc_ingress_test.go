@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	v1 "k8s.io/api/core/v1"
+	v2 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGrpcIngress_CanHandle(t *testing.T) {
+	t.Parallel()
+	ctx := t.Context()
+	tests := []struct {
+		name       string
+		conditions []metav1.Condition
+		enabled    *bool
+		expected   bool
+	}{
+		{
+			name:       "grpc ingress is enabled and fulcio is ready",
+			conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()}},
+			enabled:    ptr.To(true),
+			expected:   true,
+		},
+		{
+			name:       "grpc ingress is enabled and fulcio is not ready",
+			conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Pending.String()}},
+			enabled:    ptr.To(true),
+			expected:   false,
+		},
+		{
+			name:       "grpc ingress is disabled",
+			conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()}},
+			enabled:    ptr.To(false),
+			expected:   false,
+		},
+		{
+			name:       "grpc ingress is not set",
+			conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()}},
+			expected:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			instance := rhtasv1.Fulcio{
+				Spec: rhtasv1.FulcioSpec{
+					GrpcIngress: rhtasv1.Ingress{
+						Enabled: tt.enabled,
+					},
+				},
+				Status: rhtasv1.FulcioStatus{
+					Conditions: tt.conditions,
+				},
+			}
+			action := NewGrpcIngressAction()
+			g.Expect(tt.expected).To(Equal(action.CanHandle(ctx, &instance)))
+		})
+	}
+}
+
+func TestGrpcIngress_Handle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+
+	instance := &rhtasv1.Fulcio{
+		ObjectMeta: metav1.ObjectMeta{Name: "fulcio", Namespace: "default"},
+		Spec: rhtasv1.FulcioSpec{
+			GrpcIngress: rhtasv1.Ingress{
+				Enabled: ptr.To(true),
+				Labels:  map[string]string{"shard": "internal"},
+			},
+		},
+		Status: rhtasv1.FulcioStatus{
+			Conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Creating.String()}},
+		},
+	}
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: "default"},
+	}
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance, svc).
+		WithStatusSubresource(instance).
+		Build()
+
+	a := testAction.PrepareAction(c, NewGrpcIngressAction())
+	_ = a.Handle(ctx, instance)
+
+	ingress := &v2.Ingress{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: GrpcIngressName, Namespace: "default"}, ingress)).To(Succeed())
+	g.Expect(ingress.Annotations).To(HaveKeyWithValue("nginx.ingress.kubernetes.io/backend-protocol", "GRPC"))
+	g.Expect(ingress.Labels).To(HaveKeyWithValue("shard", "internal"))
+	g.Expect(ingress.Spec.Rules).To(HaveLen(1))
+	g.Expect(ingress.Spec.Rules[0].Host).To(Equal("fulcio-server-grpc.local"))
+	g.Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name).To(Equal(DeploymentName))
+	g.Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Port.Name).To(Equal(GRPCPortName))
+}
@@ -0,0 +1,33 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type fulcioNetworkPolicyConfig struct{}
+
+func (fulcioNetworkPolicyConfig) IsEnabled(i *rhtasv1.Fulcio) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (fulcioNetworkPolicyConfig) Ingress(_ *rhtasv1.Fulcio) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{TargetServerPort, GRPCPort},
+			networkpolicy.FromIngressControllers(),
+			networkpolicy.FromOperator(),
+		),
+		networkpolicy.Rule([]int32{MetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Fulcio] {
+	return networkpolicy.NewAction(
+		ComponentName,
+		DeploymentName,
+		fulcioNetworkPolicyConfig{},
+	)
+}
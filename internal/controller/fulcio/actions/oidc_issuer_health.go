@@ -0,0 +1,143 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/controller/fulcio/utils"
+	"github.com/securesign/operator/internal/state"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// defaultIssuerHealthCheckInterval is the time between checks of an OIDC issuer if spec.issuerHealthCheck.interval is not set.
+const defaultIssuerHealthCheckInterval = 10 * time.Minute
+
+func NewOIDCIssuerHealthAction() action.Action[*rhtasv1.Fulcio] {
+	return &oidcIssuerHealthAction{now: time.Now}
+}
+
+type oidcIssuerHealthAction struct {
+	action.BaseAction
+	now func() time.Time
+}
+
+func (i oidcIssuerHealthAction) Name() string {
+	return "oidc issuer health"
+}
+
+func (i oidcIssuerHealthAction) CanHandle(_ context.Context, instance *rhtasv1.Fulcio) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Ready &&
+		(issuerHealthCheckEnabled(instance) || len(instance.Status.OIDCIssuers) > 0 ||
+			meta.FindStatusCondition(instance.Status.Conditions, OIDCIssuersHealthyCondition) != nil)
+}
+
+func (i oidcIssuerHealthAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *action.Result {
+	if !issuerHealthCheckEnabled(instance) {
+		instance.Status.OIDCIssuers = nil
+		meta.RemoveStatusCondition(&instance.Status.Conditions, OIDCIssuersHealthyCondition)
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+	if kubernetes.IsDryRun(ctx) || !IssuerHealthCheckDue(instance, i.now()) {
+		return i.Continue()
+	}
+
+	cas, err := httputils.LoadTrustedCAs(ctx, i.Client, instance)
+	if err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	client := httputils.GetClientBuilder()(cas...)
+
+	previous := make(map[string]rhtasv1.FulcioOIDCIssuerStatus, len(instance.Status.OIDCIssuers))
+	for _, s := range instance.Status.OIDCIssuers {
+		previous[s.Issuer] = s
+	}
+	statuses := make([]rhtasv1.FulcioOIDCIssuerStatus, 0, len(instance.Spec.Config.OIDCIssuers))
+	var unreachable []string
+	for _, issuer := range instance.Spec.Config.OIDCIssuers {
+		status := rhtasv1.FulcioOIDCIssuerStatus{
+			Issuer:        issuer.Issuer,
+			IssuerURL:     utils.IssuerURL(issuer),
+			LastCheckTime: metav1.NewTime(i.now()),
+		}
+		if status.KeyCount, err = utils.CheckOIDCIssuer(ctx, client, issuer); err != nil {
+			status.Error = err.Error()
+			unreachable = append(unreachable, issuer.Issuer)
+		} else {
+			status.Reachable = true
+		}
+
+		last, checked := previous[issuer.Issuer]
+		switch {
+		case !status.Reachable && (!checked || last.Reachable):
+			i.Recorder.Eventf(instance, nil, v1.EventTypeWarning, "OIDCIssuerUnreachable", "HealthCheck",
+				"OIDC issuer %s is unreachable: %s", issuer.Issuer, status.Error)
+		case status.Reachable && checked && !last.Reachable:
+			i.Recorder.Eventf(instance, nil, v1.EventTypeNormal, "OIDCIssuerReachable", "HealthCheck",
+				"OIDC issuer %s is reachable", issuer.Issuer)
+		}
+		statuses = append(statuses, status)
+	}
+
+	condition := metav1.Condition{
+		Type:               OIDCIssuersHealthyCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Reachable",
+		Message:            "All OIDC issuers are reachable",
+		ObservedGeneration: instance.Generation,
+	}
+	if len(unreachable) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Unreachable"
+		condition.Message = fmt.Sprintf("%d of %d OIDC issuers unreachable: %s", len(unreachable), len(statuses), strings.Join(unreachable, ", "))
+	}
+
+	if !equality.Semantic.DeepEqual(instance.Status.OIDCIssuers, statuses) {
+		instance.Status.OIDCIssuers = statuses
+	}
+	instance.SetCondition(condition)
+	if _, err = i.PersistStatus(ctx, instance); err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	return i.Continue()
+}
+
+// IssuerHealthCheckDue reports whether an OIDC issuer of the instance hasn't been checked within the interval.
+func IssuerHealthCheckDue(instance *rhtasv1.Fulcio, now time.Time) bool {
+	if !issuerHealthCheckEnabled(instance) {
+		return false
+	}
+	interval := defaultIssuerHealthCheckInterval
+	if instance.Spec.IssuerHealthCheck.Interval != nil {
+		interval = instance.Spec.IssuerHealthCheck.Interval.Duration
+	}
+
+	checked := make(map[string]rhtasv1.FulcioOIDCIssuerStatus, len(instance.Status.OIDCIssuers))
+	for _, s := range instance.Status.OIDCIssuers {
+		checked[s.Issuer] = s
+	}
+	if len(checked) != len(instance.Spec.Config.OIDCIssuers) {
+		return true
+	}
+	for _, issuer := range instance.Spec.Config.OIDCIssuers {
+		s, ok := checked[issuer.Issuer]
+		if !ok || s.IssuerURL != utils.IssuerURL(issuer) || now.Sub(s.LastCheckTime.Time) >= interval {
+			return true
+		}
+	}
+	return false
+}
+
+func issuerHealthCheckEnabled(instance *rhtasv1.Fulcio) bool {
+	return ptr.Deref(instance.Spec.IssuerHealthCheck.Enabled, true) && len(instance.Spec.Config.OIDCIssuers) > 0
+}
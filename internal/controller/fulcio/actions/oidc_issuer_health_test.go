@@ -0,0 +1,210 @@
+package actions
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	httpmock "github.com/securesign/operator/internal/testing/http"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestOIDCIssuerHealth_Handle(t *testing.T) {
+	respond := func(status int, body string) httpmock.RoundTripFunc {
+		return func(_ *http.Request) *http.Response {
+			return &http.Response{StatusCode: status, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: make(http.Header)}
+		}
+	}
+	mockClient := &http.Client{}
+	httpmock.SetMockTransport(mockClient, map[string]httpmock.RoundTripFunc{
+		"https://good.example.com/.well-known/openid-configuration": respond(http.StatusOK,
+			`{"issuer": "https://good.example.com", "jwks_uri": "https://good.example.com/keys"}`),
+		"https://good.example.com/keys": respond(http.StatusOK, `{"keys": [{"kid": "1"}, {"kid": "2"}]}`),
+		"https://internal.example.com/realms/sigstore/.well-known/openid-configuration": respond(http.StatusOK,
+			`{"issuer": "https://internal.example.com/realms/sigstore", "jwks_uri": "https://internal.example.com/keys"}`),
+		"https://down.example.com/.well-known/openid-configuration": respond(http.StatusServiceUnavailable, "unavailable"),
+		"https://empty.example.com/.well-known/openid-configuration": respond(http.StatusOK,
+			`{"issuer": "https://empty.example.com", "jwks_uri": "https://empty.example.com/keys"}`),
+		"https://empty.example.com/keys": respond(http.StatusOK, `{"keys": []}`),
+	})
+	orig := httputils.GetClientBuilder()
+	httputils.SetClientBuilder(func(_ ...[]byte) *http.Client { return mockClient })
+	t.Cleanup(func() { httputils.SetClientBuilder(orig) })
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	good := rhtasv1.OIDCIssuer{Issuer: "https://good.example.com", ClientID: "sigstore", Type: "email"}
+	down := rhtasv1.OIDCIssuer{Issuer: "https://down.example.com", ClientID: "sigstore", Type: "email"}
+
+	tests := []struct {
+		name      string
+		issuers   []rhtasv1.OIDCIssuer
+		check     rhtasv1.FulcioIssuerHealthCheck
+		status    []rhtasv1.FulcioOIDCIssuerStatus
+		condition *metav1.Condition
+		verify    func(Gomega, *rhtasv1.Fulcio, []string)
+	}{
+		{
+			name:    "reachable issuer",
+			issuers: []rhtasv1.OIDCIssuer{good},
+			verify: func(g Gomega, f *rhtasv1.Fulcio, events []string) {
+				g.Expect(f.Status.OIDCIssuers).To(HaveLen(1))
+				g.Expect(f.Status.OIDCIssuers[0].Issuer).To(Equal("https://good.example.com"))
+				g.Expect(f.Status.OIDCIssuers[0].Reachable).To(BeTrue())
+				g.Expect(f.Status.OIDCIssuers[0].KeyCount).To(Equal(int32(2)))
+				g.Expect(f.Status.OIDCIssuers[0].LastCheckTime.Time).To(BeTemporally("==", now))
+				g.Expect(meta.IsStatusConditionTrue(f.Status.Conditions, OIDCIssuersHealthyCondition)).To(BeTrue())
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name: "unreachable issuers",
+			issuers: []rhtasv1.OIDCIssuer{good, down,
+				{Issuer: "https://other.example.com", IssuerURL: "https://internal.example.com/realms/sigstore/", ClientID: "sigstore", Type: "email"},
+				{Issuer: "https://empty.example.com", ClientID: "sigstore", Type: "email"},
+			},
+			verify: func(g Gomega, f *rhtasv1.Fulcio, events []string) {
+				g.Expect(f.Status.OIDCIssuers).To(HaveLen(4))
+				g.Expect(f.Status.OIDCIssuers[1].Reachable).To(BeFalse())
+				g.Expect(f.Status.OIDCIssuers[1].Error).To(ContainSubstring("returned status 503"))
+				g.Expect(f.Status.OIDCIssuers[2].Reachable).To(BeFalse())
+				g.Expect(f.Status.OIDCIssuers[2].IssuerURL).To(Equal("https://internal.example.com/realms/sigstore/"))
+				g.Expect(f.Status.OIDCIssuers[2].Error).To(Equal(`issuer "https://internal.example.com/realms/sigstore" of the OpenID configuration does not match "https://other.example.com"`))
+				g.Expect(f.Status.OIDCIssuers[3].Error).To(Equal("no keys in the JWKS"))
+
+				condition := meta.FindStatusCondition(f.Status.Conditions, OIDCIssuersHealthyCondition)
+				g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(condition.Message).To(Equal("3 of 4 OIDC issuers unreachable: https://down.example.com, https://other.example.com, https://empty.example.com"))
+				g.Expect(events).To(HaveLen(3))
+				g.Expect(events[0]).To(HavePrefix("Warning OIDCIssuerUnreachable OIDC issuer https://down.example.com is unreachable"))
+			},
+		},
+		{
+			name:    "issuer recovered",
+			issuers: []rhtasv1.OIDCIssuer{good},
+			status: []rhtasv1.FulcioOIDCIssuerStatus{{
+				Issuer: "https://good.example.com", IssuerURL: "https://good.example.com",
+				Error: "connection refused", LastCheckTime: metav1.NewTime(now.Add(-time.Hour)),
+			}},
+			verify: func(g Gomega, f *rhtasv1.Fulcio, events []string) {
+				g.Expect(f.Status.OIDCIssuers[0].Reachable).To(BeTrue())
+				g.Expect(events).To(ConsistOf("Normal OIDCIssuerReachable OIDC issuer https://good.example.com is reachable"))
+			},
+		},
+		{
+			name:    "still unreachable",
+			issuers: []rhtasv1.OIDCIssuer{down},
+			status: []rhtasv1.FulcioOIDCIssuerStatus{{
+				Issuer: "https://down.example.com", IssuerURL: "https://down.example.com",
+				Error: "connection refused", LastCheckTime: metav1.NewTime(now.Add(-time.Hour)),
+			}},
+			verify: func(g Gomega, f *rhtasv1.Fulcio, events []string) {
+				g.Expect(f.Status.OIDCIssuers[0].LastCheckTime.Time).To(BeTemporally("==", now))
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name:    "checked within the interval",
+			issuers: []rhtasv1.OIDCIssuer{down},
+			check:   rhtasv1.FulcioIssuerHealthCheck{Interval: &metav1.Duration{Duration: 2 * time.Hour}},
+			status: []rhtasv1.FulcioOIDCIssuerStatus{{
+				Issuer: "https://down.example.com", IssuerURL: "https://down.example.com",
+				Reachable: true, LastCheckTime: metav1.NewTime(now.Add(-time.Hour)),
+			}},
+			verify: func(g Gomega, f *rhtasv1.Fulcio, _ []string) {
+				g.Expect(f.Status.OIDCIssuers[0].Reachable).To(BeTrue())
+				g.Expect(f.Status.OIDCIssuers[0].LastCheckTime.Time).To(BeTemporally("==", now.Add(-time.Hour)))
+			},
+		},
+		{
+			name:    "check disabled",
+			issuers: []rhtasv1.OIDCIssuer{good},
+			check:   rhtasv1.FulcioIssuerHealthCheck{Enabled: ptr.To(false)},
+			status: []rhtasv1.FulcioOIDCIssuerStatus{{
+				Issuer: "https://good.example.com", IssuerURL: "https://good.example.com",
+				Reachable: true, LastCheckTime: metav1.NewTime(now.Add(-time.Hour)),
+			}},
+			condition: &metav1.Condition{Type: OIDCIssuersHealthyCondition, Status: metav1.ConditionTrue, Reason: "Reachable"},
+			verify: func(g Gomega, f *rhtasv1.Fulcio, _ []string) {
+				g.Expect(f.Status.OIDCIssuers).To(BeEmpty())
+				g.Expect(meta.FindStatusCondition(f.Status.Conditions, OIDCIssuersHealthyCondition)).To(BeNil())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			instance := &rhtasv1.Fulcio{
+				ObjectMeta: metav1.ObjectMeta{Name: "fulcio", Namespace: "default"},
+				Spec: rhtasv1.FulcioSpec{
+					Config:            rhtasv1.FulcioConfig{OIDCIssuers: tt.issuers},
+					IssuerHealthCheck: tt.check,
+				},
+				Status: rhtasv1.FulcioStatus{OIDCIssuers: tt.status},
+			}
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:   constants.ReadyCondition,
+				Status: metav1.ConditionTrue,
+				Reason: state.Ready.String(),
+			})
+			if tt.condition != nil {
+				meta.SetStatusCondition(&instance.Status.Conditions, *tt.condition)
+			}
+
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance).
+				WithStatusSubresource(instance).
+				Build()
+			recorder := events.NewFakeRecorder(10)
+			a := testAction.PrepareAction(c, &oidcIssuerHealthAction{now: func() time.Time { return now }})
+			a.InjectRecorder(recorder)
+
+			g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+			a.Handle(ctx, instance)
+
+			updated := &rhtasv1.Fulcio{}
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(instance), updated)).To(Succeed())
+			close(recorder.Events)
+			var recorded []string
+			for e := range recorder.Events {
+				recorded = append(recorded, e)
+			}
+			tt.verify(g, updated, recorded)
+		})
+	}
+}
+
+func TestIssuerHealthCheckDue(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	instance := &rhtasv1.Fulcio{
+		Spec: rhtasv1.FulcioSpec{
+			Config: rhtasv1.FulcioConfig{OIDCIssuers: []rhtasv1.OIDCIssuer{{Issuer: "https://example.com"}}},
+		},
+	}
+	g.Expect(IssuerHealthCheckDue(instance, now)).To(BeTrue())
+
+	instance.Status.OIDCIssuers = []rhtasv1.FulcioOIDCIssuerStatus{{
+		Issuer: "https://example.com", IssuerURL: "https://example.com", LastCheckTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+	}}
+	g.Expect(IssuerHealthCheckDue(instance, now)).To(BeFalse())
+	g.Expect(IssuerHealthCheckDue(instance, now.Add(5*time.Minute))).To(BeTrue())
+
+	instance.Spec.Config.OIDCIssuers[0].IssuerURL = "https://internal.example.com"
+	g.Expect(IssuerHealthCheckDue(instance, now)).To(BeTrue())
+
+	instance.Spec.IssuerHealthCheck.Enabled = ptr.To(false)
+	g.Expect(IssuerHealthCheckDue(instance, now.Add(time.Hour))).To(BeFalse())
+}
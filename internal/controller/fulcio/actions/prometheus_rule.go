@@ -0,0 +1,29 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/alerting"
+)
+
+type fulcioAlertingConfig struct{}
+
+func (fulcioAlertingConfig) PrometheusRule(i *rhtasv1.Fulcio) rhtasv1.PrometheusRuleConfig {
+	return i.Spec.Monitoring.PrometheusRule
+}
+
+func (fulcioAlertingConfig) Rules(i *rhtasv1.Fulcio) []alerting.Rule {
+	return []alerting.Rule{
+		alerting.CertificateExpiryRule("FulcioCertificateExpiring", "Fulcio", i.Name),
+	}
+}
+
+func NewPrometheusRuleAction() action.Action[*rhtasv1.Fulcio] {
+	return alerting.NewAction(
+		ComponentName,
+		MonitoringRoleName,
+		DeploymentName,
+		"",
+		fulcioAlertingConfig{},
+	)
+}
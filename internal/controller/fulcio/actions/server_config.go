@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels2 "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -65,9 +66,17 @@ func (i serverConfig) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *act
 			return i.Error(ctx, fmt.Errorf("can't get FulcioConfig: %w", err), instance)
 		}
 		if cfg != nil {
-			if reflect.DeepEqual(cfg.Data[serverConfigName], string(config)) {
+			current, ok := cfg.Data[serverConfigName]
+			switch {
+			case reflect.DeepEqual(current, string(config)):
 				return i.Continue()
-			} else {
+			case ok && !ptr.Deref(cfg.Immutable, false) && utils.ReloadObservable([]byte(current), config):
+				return i.update(ctx, instance, cfg, config)
+			case ok && !ptr.Deref(cfg.Immutable, false):
+				// the reload of the change can't be confirmed, a new ConfigMap rolls out the deployment
+				i.Logger.Info("Fulcio server config change is not observable, rolling out a new ConfigMap", "name", cfg.Name)
+			default:
+				// ConfigMaps created by older versions of the operator are immutable or use the JSON format
 				i.Logger.Info("Remove invalid ConfigMap with fulcio-server configuration", "name", cfg.Name)
 				err = i.Client.Delete(ctx, cfg)
 				if err != nil {
@@ -92,7 +101,7 @@ func (i serverConfig) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *act
 		ensure.ControllerReference[*v1.ConfigMap](instance, i.Client),
 		ensure.Labels[*v1.ConfigMap](slices.Collect(maps.Keys(configLabel)), configLabel),
 		kubernetes.EnsureConfigMapData(
-			true,
+			false,
 			map[string]string{
 				serverConfigName: string(config),
 			},
@@ -124,6 +133,27 @@ func (i serverConfig) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *act
 	return i.Continue()
 }
 
+// update replaces the configuration in place. The name of the ConfigMap doesn't change, so the deployment is not
+// rolled out and Fulcio reloads the mounted file once kubelet refreshes it. Only changes the configuration endpoint
+// confirms are updated in place.
+func (i serverConfig) update(ctx context.Context, instance *rhtasv1.Fulcio, cfg *v1.ConfigMap, config []byte) *action.Result {
+	cfg.Data[serverConfigName] = string(config)
+	if err := i.Client.Update(ctx, cfg); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not update Server config: %w", err), instance)
+	}
+	i.Recorder.Eventf(instance, cfg, v1.EventTypeNormal, "FulcioConfigUpdated", "Updated", "Fulcio config updated: %s", cfg.Name)
+
+	meta.SetStatusCondition(&instance.Status.Conditions,
+		metav1.Condition{
+			Type:               constants.ReadyCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             state.Creating.String(),
+			Message:            "Server config updated",
+			ObservedGeneration: instance.Generation},
+	)
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
+
 func (i serverConfig) cleanup(ctx context.Context, instance *rhtasv1.Fulcio, configLabels map[string]string) {
 	if instance.Status.ServerConfigRef == nil || instance.Status.ServerConfigRef.Name == "" {
 		i.Logger.Error(errors.New("new ConfigMap name is empty"), "unable to clean old objects", "namespace", instance.Namespace)
@@ -14,6 +14,7 @@ import (
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	core "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
@@ -340,7 +341,106 @@ func TestConfig_Handle(t *testing.T) {
 							Name:      "config",
 							Labels:    labels,
 						},
-						Data: map[string]string{serverConfigName: string(configJson)},
+						Data: map[string]string{serverConfigName: string(configYaml)},
+					},
+				},
+			},
+			want: want{
+				result: testAction.Return(),
+				verify: func(ctx context.Context, g Gomega, status rhtasv1.FulcioStatus, cli client.WithWatch, events <-chan watch.Event) {
+					g.Expect(status.ServerConfigRef).ShouldNot(BeNil())
+					g.Expect(status.ServerConfigRef.Name).Should(Equal("config"))
+
+					g.Expect(events).To(HaveLen(1))
+					g.Expect(events).To(Receive(WithTransform(getEventType, Equal(watch.Modified))))
+					cm, err := kubernetes.GetConfigMap(ctx, cli, "default", status.ServerConfigRef.Name)
+					g.Expect(err).To(Not(HaveOccurred()))
+					g.Expect(cm.Data[serverConfigName]).To(ContainSubstring("clientIdUpdated"))
+				},
+			},
+		},
+		{
+			name: "spec update of an unpublished issuer field",
+			env: env{
+				spec: rhtasv1.FulcioConfig{
+					OIDCIssuers: []rhtasv1.OIDCIssuer{
+						{
+							Issuer:    "https://example.com",
+							IssuerURL: "https://example.com",
+							ClientID:  "client-id",
+							Type:      "kubernetes",
+						},
+					},
+				},
+				status: rhtasv1.FulcioStatus{
+					ServerConfigRef: &rhtasv1.LocalObjectReference{
+						Name: "config",
+					},
+					Conditions: []metav1.Condition{
+						{Type: constants.ReadyCondition, Reason: state.Creating.String()},
+					},
+				},
+				objects: []client.Object{
+					&core.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "config",
+							Labels:    labels,
+						},
+						Data: map[string]string{serverConfigName: string(configYaml)},
+					},
+				},
+			},
+			want: want{
+				result: testAction.Return(),
+				verify: func(ctx context.Context, g Gomega, status rhtasv1.FulcioStatus, cli client.WithWatch, events <-chan watch.Event) {
+					// the issuer type is not published on the configuration endpoint, the deployment is rolled out
+					g.Expect(status.ServerConfigRef).ShouldNot(BeNil())
+					g.Expect(status.ServerConfigRef.Name).Should(Not(Equal("config")))
+
+					g.Expect(events).To(HaveLen(2))
+					for e := range events {
+						g.Expect(e).To(Or(
+							WithTransform(getEventType, Equal(watch.Added)),
+							WithTransform(getEventType, Equal(watch.Deleted)),
+						))
+					}
+					cm, err := kubernetes.GetConfigMap(ctx, cli, "default", status.ServerConfigRef.Name)
+					g.Expect(err).To(Not(HaveOccurred()))
+					g.Expect(cm.Data[serverConfigName]).To(ContainSubstring("kubernetes"))
+				},
+			},
+		},
+		{
+			name: "spec update of immutable config",
+			env: env{
+				spec: rhtasv1.FulcioConfig{
+					OIDCIssuers: []rhtasv1.OIDCIssuer{
+						{
+							Issuer:    "https://example.com",
+							IssuerURL: "https://example.com",
+							ClientID:  "clientIdUpdated",
+							Type:      "email",
+						},
+					},
+				},
+				status: rhtasv1.FulcioStatus{
+					ServerConfigRef: &rhtasv1.LocalObjectReference{
+						Name: "config",
+					},
+					Conditions: []metav1.Condition{
+						{Type: constants.ReadyCondition, Reason: state.Creating.String()},
+					},
+				},
+				objects: []client.Object{
+					&core.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "config",
+							Labels:    labels,
+						},
+						Immutable: ptr.To(true),
+						Data:      map[string]string{serverConfigName: string(configYaml)},
 					},
 				},
 			},
@@ -8,6 +8,7 @@ import (
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
@@ -67,6 +68,10 @@ func (i serviceAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *ac
 		})
 	}
 
+	tlsAnnotations := map[string]string{
+		annotations.TLS: fmt.Sprintf(GrpcTLSSecret, instance.Name),
+	}
+
 	if result, err = kubernetes.CreateOrUpdate(ctx, i.Client,
 		&v1.Service{
 			ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: instance.Namespace},
@@ -74,6 +79,8 @@ func (i serviceAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *ac
 		kubernetes.EnsureServiceSpec(labels, ports...),
 		ensure.ControllerReference[*v1.Service](instance, i.Client),
 		ensure.Labels[*v1.Service](slices.Collect(maps.Keys(labels)), labels),
+		//TLS: Annotate service to issue the gRPC serving certificate
+		ensure.Optional(grpcTLSEnabled(instance), ensure.Annotations[*v1.Service]([]string{annotations.TLS}, tlsAnnotations)),
 	); err != nil {
 		return i.Error(ctx, fmt.Errorf("could not create service: %w", err), instance)
 	}
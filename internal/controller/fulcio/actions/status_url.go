@@ -3,6 +3,8 @@ package actions
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/constants"
@@ -52,10 +54,34 @@ func (i statusUrlAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *
 		}
 	}
 
-	if url == instance.Status.Url {
+	grpcUrl, err := i.resolveGrpcUrl(ctx, instance)
+	if err != nil {
+		return i.Error(ctx, fmt.Errorf("error resolving gRPC URL: %w", err), instance)
+	}
+
+	if url == instance.Status.Url && grpcUrl == instance.Status.GrpcUrl {
 		return i.Continue()
 	}
 
 	instance.Status.Url = url
+	instance.Status.GrpcUrl = grpcUrl
 	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 }
+
+// resolveGrpcUrl returns the host:port address of the gRPC API, either from the
+// gRPC ingress or the internal service.
+func (i statusUrlAction) resolveGrpcUrl(ctx context.Context, instance *rhtasv1.Fulcio) (string, error) {
+	if !utils.IsEnabled(instance.Spec.GrpcIngress.Enabled) {
+		return net.JoinHostPort(fmt.Sprintf("%s.%s.svc", DeploymentName, instance.Namespace), strconv.Itoa(GRPCPort)), nil
+	}
+
+	ingress := &v12.Ingress{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: GrpcIngressName, Namespace: instance.Namespace}, ingress); err != nil {
+		return "", err
+	}
+	port := "80"
+	if len(ingress.Spec.TLS) > 0 {
+		port = "443"
+	}
+	return net.JoinHostPort(ingress.Spec.Rules[0].Host, port), nil
+}
@@ -0,0 +1,96 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/controller/fulcio/utils"
+	"github.com/securesign/operator/internal/state"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewTemplatePreviewAction() action.Action[*rhtasv1.Fulcio] {
+	return &templatePreviewAction{}
+}
+
+type templatePreviewAction struct {
+	action.BaseAction
+}
+
+func (i templatePreviewAction) Name() string {
+	return "template-preview"
+}
+
+func (i templatePreviewAction) CanHandle(_ context.Context, instance *rhtasv1.Fulcio) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating &&
+		(instance.Spec.TemplatePreview != nil || len(instance.Status.TemplatePreview) > 0)
+}
+
+func (i templatePreviewAction) Handle(ctx context.Context, instance *rhtasv1.Fulcio) *action.Result {
+	var preview []rhtasv1.FulcioTemplatePreviewResult
+	if instance.Spec.TemplatePreview != nil {
+		var err error
+		if preview, err = i.render(ctx, instance); err != nil {
+			return i.Error(ctx, fmt.Errorf("could not render template preview: %w", err), instance)
+		}
+	}
+
+	if equality.Semantic.DeepEqual(preview, instance.Status.TemplatePreview) {
+		return i.Continue()
+	}
+	instance.Status.TemplatePreview = preview
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
+
+// render returns a result for every ciIssuerMetadata entry with sample claims and for every key of the claims
+// ConfigMap that doesn't match any entry.
+func (i templatePreviewAction) render(ctx context.Context, instance *rhtasv1.Fulcio) ([]rhtasv1.FulcioTemplatePreviewResult, error) {
+	metadata := instance.Spec.Config.CIIssuerMetadata
+	name := instance.Spec.TemplatePreview.ClaimsRef.Name
+
+	cm := &core.ConfigMap{}
+	err := i.Client.Get(ctx, client.ObjectKey{Namespace: instance.Namespace, Name: name}, cm)
+	switch {
+	case apierrors.IsNotFound(err):
+		preview := make([]rhtasv1.FulcioTemplatePreviewResult, 0, len(metadata))
+		for _, m := range metadata {
+			preview = append(preview, rhtasv1.FulcioTemplatePreviewResult{
+				IssuerName: m.IssuerName,
+				Error:      fmt.Sprintf("claims config map %q not found", name),
+			})
+		}
+		return preview, nil
+	case err != nil:
+		return nil, err
+	}
+
+	var preview []rhtasv1.FulcioTemplatePreviewResult
+	known := make(map[string]bool, len(metadata))
+	for _, m := range metadata {
+		known[m.IssuerName] = true
+		if claims, ok := cm.Data[m.IssuerName]; ok {
+			preview = append(preview, utils.RenderTemplatePreview(m, claims))
+		}
+	}
+	var unknown []string
+	for key := range cm.Data {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	slices.Sort(unknown)
+	for _, key := range unknown {
+		preview = append(preview, rhtasv1.FulcioTemplatePreviewResult{
+			IssuerName: key,
+			Error:      "no ciIssuerMetadata entry with this issuerName",
+		})
+	}
+	return preview, nil
+}
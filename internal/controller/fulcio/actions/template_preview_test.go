@@ -0,0 +1,211 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var gitlabMetadata = rhtasv1.CIIssuerMetadata{
+	IssuerName: "gitlab",
+	DefaultTemplateValues: map[string]string{
+		"url": "https://gitlab.example.com",
+	},
+	ExtensionTemplates: rhtasv1.Extensions{
+		BuildSignerURI:           "{{ .url }}/{{ .ci_config_ref_uri }}",
+		SourceRepositoryURI:      "{{ .url }}/{{ .project_path }}",
+		SourceRepositoryDigest:   "sha",
+		SourceRepositoryOwnerURI: "{{ .url }}/{{ .namespace_path }}",
+		RunInvocationURI:         "{{ .url }}/{{ .project_path }}/-/jobs/{{ .job_id }}",
+	},
+	SubjectAlternativeNameTemplate: "https://{{ .ci_config_ref_uri }}",
+}
+
+func TestTemplatePreview_CanHandle(t *testing.T) {
+	tests := []struct {
+		name      string
+		phase     state.State
+		spec      *rhtasv1.FulcioTemplatePreview
+		status    []rhtasv1.FulcioTemplatePreviewResult
+		canHandle bool
+	}{
+		{
+			name:      "preview disabled",
+			phase:     state.Ready,
+			canHandle: false,
+		},
+		{
+			name:      "pending phase",
+			phase:     state.Pending,
+			spec:      &rhtasv1.FulcioTemplatePreview{ClaimsRef: rhtasv1.LocalObjectReference{Name: "claims"}},
+			canHandle: false,
+		},
+		{
+			name:      "preview enabled",
+			phase:     state.Creating,
+			spec:      &rhtasv1.FulcioTemplatePreview{ClaimsRef: rhtasv1.LocalObjectReference{Name: "claims"}},
+			canHandle: true,
+		},
+		{
+			name:      "stale preview status",
+			phase:     state.Ready,
+			status:    []rhtasv1.FulcioTemplatePreviewResult{{IssuerName: "gitlab"}},
+			canHandle: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			instance := &rhtasv1.Fulcio{
+				Spec:   rhtasv1.FulcioSpec{TemplatePreview: tt.spec},
+				Status: rhtasv1.FulcioStatus{TemplatePreview: tt.status},
+			}
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:   constants.ReadyCondition,
+				Reason: tt.phase.String(),
+			})
+			a := testAction.PrepareAction(testAction.FakeClientBuilder().Build(), NewTemplatePreviewAction())
+			g.Expect(a.CanHandle(t.Context(), instance)).To(Equal(tt.canHandle))
+		})
+	}
+}
+
+func TestTemplatePreview_Handle(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    *rhtasv1.FulcioTemplatePreview
+		status  []rhtasv1.FulcioTemplatePreviewResult
+		objects []client.Object
+		result  any
+		verify  func(Gomega, []rhtasv1.FulcioTemplatePreviewResult)
+	}{
+		{
+			name: "render claims",
+			spec: &rhtasv1.FulcioTemplatePreview{ClaimsRef: rhtasv1.LocalObjectReference{Name: "claims"}},
+			objects: []client.Object{claimsConfigMap(map[string]string{
+				"gitlab": `{"ci_config_ref_uri": "gitlab.example.com/group/project//.gitlab-ci.yml@refs/heads/main",
+					"project_path": "group/project", "namespace_path": "group", "sha": "abc123", "job_id": 42}`,
+			})},
+			result: testAction.Return(),
+			verify: func(g Gomega, preview []rhtasv1.FulcioTemplatePreviewResult) {
+				g.Expect(preview).To(HaveLen(1))
+				g.Expect(preview[0].Error).To(BeEmpty())
+				g.Expect(preview[0].IssuerName).To(Equal("gitlab"))
+				g.Expect(preview[0].SubjectAlternativeName).To(Equal("https://gitlab.example.com/group/project//.gitlab-ci.yml@refs/heads/main"))
+				g.Expect(preview[0].Extensions).To(Equal(rhtasv1.Extensions{
+					BuildSignerURI:           "https://gitlab.example.com/gitlab.example.com/group/project//.gitlab-ci.yml@refs/heads/main",
+					SourceRepositoryURI:      "https://gitlab.example.com/group/project",
+					SourceRepositoryDigest:   "abc123",
+					SourceRepositoryOwnerURI: "https://gitlab.example.com/group",
+					RunInvocationURI:         "https://gitlab.example.com/group/project/-/jobs/42",
+				}))
+			},
+		},
+		{
+			name: "missing claims",
+			spec: &rhtasv1.FulcioTemplatePreview{ClaimsRef: rhtasv1.LocalObjectReference{Name: "claims"}},
+			objects: []client.Object{claimsConfigMap(map[string]string{
+				"gitlab": `{"ci_config_ref_uri": "gitlab.example.com/group/project//.gitlab-ci.yml@refs/heads/main"}`,
+			})},
+			result: testAction.Return(),
+			verify: func(g Gomega, preview []rhtasv1.FulcioTemplatePreviewResult) {
+				g.Expect(preview).To(HaveLen(1))
+				g.Expect(preview[0].Error).To(ContainSubstring("sourceRepositoryURI:"))
+				g.Expect(preview[0].Error).To(ContainSubstring(`map has no entry for key "project_path"`))
+				g.Expect(preview[0].Error).To(ContainSubstring("sourceRepositoryDigest: value <sha> not present in either claims or defaults"))
+				g.Expect(preview[0].SubjectAlternativeName).To(Equal("https://gitlab.example.com/group/project//.gitlab-ci.yml@refs/heads/main"))
+				g.Expect(preview[0].Extensions.SourceRepositoryURI).To(BeEmpty())
+			},
+		},
+		{
+			name: "invalid claims and unknown issuer",
+			spec: &rhtasv1.FulcioTemplatePreview{ClaimsRef: rhtasv1.LocalObjectReference{Name: "claims"}},
+			objects: []client.Object{claimsConfigMap(map[string]string{
+				"gitlab":  `not json`,
+				"jenkins": `{}`,
+			})},
+			result: testAction.Return(),
+			verify: func(g Gomega, preview []rhtasv1.FulcioTemplatePreviewResult) {
+				g.Expect(preview).To(HaveLen(2))
+				g.Expect(preview[0].Error).To(HavePrefix("invalid claims JSON"))
+				g.Expect(preview[1].IssuerName).To(Equal("jenkins"))
+				g.Expect(preview[1].Error).To(Equal("no ciIssuerMetadata entry with this issuerName"))
+			},
+		},
+		{
+			name:   "claims config map not found",
+			spec:   &rhtasv1.FulcioTemplatePreview{ClaimsRef: rhtasv1.LocalObjectReference{Name: "claims"}},
+			result: testAction.Return(),
+			verify: func(g Gomega, preview []rhtasv1.FulcioTemplatePreviewResult) {
+				g.Expect(preview).To(Equal([]rhtasv1.FulcioTemplatePreviewResult{
+					{IssuerName: "gitlab", Error: `claims config map "claims" not found`},
+				}))
+			},
+		},
+		{
+			name: "preview up to date",
+			spec: &rhtasv1.FulcioTemplatePreview{ClaimsRef: rhtasv1.LocalObjectReference{Name: "claims"}},
+			status: []rhtasv1.FulcioTemplatePreviewResult{
+				{IssuerName: "gitlab", Error: `claims config map "claims" not found`},
+			},
+			result: testAction.Continue(),
+			verify: func(g Gomega, preview []rhtasv1.FulcioTemplatePreviewResult) {
+				g.Expect(preview).To(HaveLen(1))
+			},
+		},
+		{
+			name:   "preview disabled",
+			status: []rhtasv1.FulcioTemplatePreviewResult{{IssuerName: "gitlab"}},
+			result: testAction.Return(),
+			verify: func(g Gomega, preview []rhtasv1.FulcioTemplatePreviewResult) {
+				g.Expect(preview).To(BeEmpty())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+			instance := &rhtasv1.Fulcio{
+				ObjectMeta: metav1.ObjectMeta{Name: "fulcio", Namespace: "default"},
+				Spec: rhtasv1.FulcioSpec{
+					Config:          rhtasv1.FulcioConfig{CIIssuerMetadata: []rhtasv1.CIIssuerMetadata{gitlabMetadata}},
+					TemplatePreview: tt.spec,
+				},
+				Status: rhtasv1.FulcioStatus{TemplatePreview: tt.status},
+			}
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:   constants.ReadyCondition,
+				Reason: state.Creating.String(),
+			})
+
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance).
+				WithStatusSubresource(instance).
+				WithObjects(tt.objects...).
+				Build()
+			a := testAction.PrepareAction(c, NewTemplatePreviewAction())
+
+			g.Expect(a.Handle(ctx, instance)).To(Equal(tt.result))
+
+			updated := &rhtasv1.Fulcio{}
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(instance), updated)).To(Succeed())
+			tt.verify(g, updated.Status.TemplatePreview)
+		})
+	}
+}
+
+func claimsConfigMap(data map[string]string) *core.ConfigMap {
+	return &core.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "claims", Namespace: "default"},
+		Data:       data,
+	}
+}
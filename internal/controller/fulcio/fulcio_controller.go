@@ -18,19 +18,23 @@ package fulcio
 
 import (
 	"context"
+	"time"
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/action/transitions"
 	"github.com/securesign/operator/internal/action/trustmaterial"
 	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/controller"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
 	fipsutil "github.com/securesign/operator/internal/utils/fips"
-	"k8s.io/apimachinery/pkg/types"
+	"github.com/securesign/operator/internal/utils/kubernetes"
 
 	olpredicate "github.com/operator-framework/operator-lib/predicate"
 	ctlogActions "github.com/securesign/operator/internal/controller/ctlog/actions"
 	"github.com/securesign/operator/internal/controller/fulcio/actions"
 	_ "github.com/securesign/operator/internal/controller/fulcio/serviceresolver"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v12 "k8s.io/api/core/v1"
 	v13 "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/events"
@@ -50,11 +54,15 @@ import (
 	ctrlutil "github.com/securesign/operator/internal/utils/controller"
 )
 
+// issuerHealthCheckTick is how often the Fulcio instances with a due OIDC issuer health check are enqueued.
+const issuerHealthCheckTick = time.Minute
+
 // fulcioReconciler reconciles a Fulcio object
 type fulcioReconciler struct {
 	client.Client
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
 }
 
 func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
@@ -62,6 +70,7 @@ func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.Even
 		Client:   c,
 		scheme:   scheme,
 		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
 	}
 }
 
@@ -84,22 +93,26 @@ func (r *fulcioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	log := ctrllog.FromContext(ctx)
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Fetch the namespace
-	var namespace v12.Namespace
-	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, &namespace); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
 	// Check if the namespace is marked for deletion
-	if !namespace.DeletionTimestamp.IsZero() {
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating {
 		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
 		return ctrl.Result{}, nil
 	}
 
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
 	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
 	conditionSupplier := func(_ *rhtasv1.Fulcio) []string {
 		conditions := []string{actions.CertCondition, trustmaterial.TrustMaterialCondition}
 		return fipsutil.AppendFIPSCondition(conditions)
@@ -109,18 +122,31 @@ func (r *fulcioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		transitions.NewEnsureConditionsAction[*rhtasv1.Fulcio](conditionSupplier),
 		actions.NewFIPSValidationAction(),
 		actions.NewGenerateSignerAction(),
+		actions.NewCertificateRenewalAction(),
 		transitions.NewToCreatePhaseAction[*rhtasv1.Fulcio](),
 		actions.NewRBACAction(),
 		actions.NewServerConfigAction(),
 		actions.NewDeployAction(),
+		actions.NewAutoscalingAction(),
 		actions.NewCreateMonitorAction(),
+		actions.NewPrometheusRuleAction(),
 		actions.NewServiceAction(),
 		actions.NewIngressAction(),
+		actions.NewGrpcIngressAction(),
+		actions.NewNetworkPolicyAction(),
 		actions.NewStatusUrlAction(),
+		actions.NewTemplatePreviewAction(),
 		transitions.NewToInitializePhaseAction[*rhtasv1.Fulcio](),
 		actions.NewRolloutCheckAction(),
+		actions.NewConfigReloadAction(),
 		actions.NewResolvePubKeyAction(),
 		transitions.NewToReadyPhaseAction[*rhtasv1.Fulcio](),
+		actions.NewOIDCIssuerHealthAction(),
+		actions.NewCertificateExpiryAction(),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), acs)
 	}
 
 	for _, a := range acs {
@@ -130,7 +156,9 @@ func (r *fulcioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 		if a.CanHandle(ctx, target) {
 			log.V(2).Info("Executing " + a.Name())
+			start := time.Now()
 			result := a.Handle(ctx, target)
+			metrics.ObserveAction("fulcio", a.Name(), start, result)
 			if result != nil {
 				return result.Result, result.Err
 			}
@@ -153,6 +181,8 @@ func (r *fulcioReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&v1.Deployment{}).
 		Owns(&v12.Service{}).
 		Owns(&v13.Ingress{}).
+		Owns(&v13.NetworkPolicy{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Watches(&rhtasv1.CTlog{}, handler.EnqueueRequestsFromMapFunc(
 			ctrlutil.ServiceRefWatch(mgr.GetClient(), &rhtasv1.FulcioList{}, func(o client.Object) rhtasv1.ServiceReference {
 				return o.(*rhtasv1.Fulcio).Spec.Ctlog
@@ -161,5 +191,19 @@ func (r *fulcioReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			crpredicate.GenerationChangedPredicate{},
 			predicate.ConditionChangedPredicate[*rhtasv1.CTlog](ctlogActions.TLSCondition),
 		))).
+		// the sample claims of the template preview, metadata only to keep the ConfigMap data out of the cache
+		Watches(&v12.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(
+			ctrlutil.LocalRefWatch(mgr.GetClient(), &rhtasv1.FulcioList{}, func(o client.Object) *rhtasv1.LocalObjectReference {
+				if preview := o.(*rhtasv1.Fulcio).Spec.TemplatePreview; preview != nil {
+					return &preview.ClaimsRef
+				}
+				return nil
+			}),
+		), builder.OnlyMetadata).
+		WatchesRawSource(ctrlutil.PeriodicSource(mgr.GetClient(), &rhtasv1.FulcioList{}, issuerHealthCheckTick, pause,
+			crpredicate.NewPredicateFuncs(func(o client.Object) bool {
+				return actions.IssuerHealthCheckDue(o.(*rhtasv1.Fulcio), time.Now())
+			}),
+		)).
 		Complete(r)
 }
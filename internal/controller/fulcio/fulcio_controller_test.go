@@ -101,6 +101,13 @@ var _ = Describe("Fulcio controller", func() {
 						Header:     make(http.Header),
 					}
 				},
+				"http://fulcio.localhost/api/v2/configuration": func(_ *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"issuers":[{"issuerUrl":"test","audience":"test"}]}`)),
+						Header:     make(http.Header),
+					}
+				},
 			})
 			DeferCleanup(func() {
 				httputils.ResetClientBuilder()
@@ -19,6 +19,7 @@ package fulcio
 import (
 	"context"
 	_ "embed"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -54,6 +55,22 @@ var rotatedRootCertRaw string
 // rotatedRootCert has no trailing newline, matching the format ParseTrustBundle produces.
 var rotatedRootCert = strings.TrimSpace(rotatedRootCertRaw)
 
+// configurationMock serves the OIDC issuers of the current Fulcio spec, as Fulcio does once it reloads its config.
+func configurationMock(key types.NamespacedName) httpmock.RoundTripFunc {
+	return func(req *http.Request) *http.Response {
+		instance := &rhtasv1.Fulcio{}
+		if err := suite.Client().Get(req.Context(), key, instance); err != nil {
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(err.Error())), Header: make(http.Header)}
+		}
+		var issuers []string
+		for _, issuer := range instance.Spec.Config.OIDCIssuers {
+			issuers = append(issuers, fmt.Sprintf(`{"issuerUrl":%q,"audience":%q}`, issuer.IssuerURL, issuer.ClientID))
+		}
+		body := `{"issuers":[` + strings.Join(issuers, ",") + `]}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: make(http.Header)}
+	}
+}
+
 var _ = Describe("Fulcio hot update", func() {
 	Context("Fulcio hot update test", func() {
 
@@ -91,6 +108,7 @@ var _ = Describe("Fulcio hot update", func() {
 						Header:     make(http.Header),
 					}
 				},
+				"http://fulcio.localhost/api/v2/configuration": configurationMock(typeNamespaceName),
 			})
 			DeferCleanup(func() {
 				httputils.ResetClientBuilder()
@@ -217,12 +235,23 @@ var _ = Describe("Fulcio hot update", func() {
 				return suite.Client().Update(ctx, found)
 			}).WithContext(ctx).Should(Succeed())
 
-			By("Fulcio deployment is updated")
-			Eventually(func(g Gomega, ctx context.Context) bool {
-				updated := &appsv1.Deployment{}
-				g.Expect(suite.Client().Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, updated)).To(Succeed())
-				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
-			}).WithContext(ctx).Should(BeFalse())
+			By("Server config is updated in place")
+			configName := found.Status.ServerConfigRef.Name
+			Eventually(func(g Gomega, ctx context.Context) string {
+				cm := &corev1.ConfigMap{}
+				g.Expect(suite.Client().Get(ctx, types.NamespacedName{Name: configName, Namespace: Namespace}, cm)).To(Succeed())
+				return cm.Data["config.yaml"]
+			}).WithContext(ctx).Should(ContainSubstring("fake"))
+
+			By("Fulcio is Ready without a rollout")
+			Eventually(func(g Gomega, ctx context.Context) {
+				g.Expect(suite.Client().Get(ctx, typeNamespaceName, found)).Should(Succeed())
+				g.Expect(meta.IsStatusConditionTrue(found.Status.Conditions, constants.ReadyCondition)).To(BeTrue())
+				g.Expect(found.Status.ServerConfigRef.Name).To(Equal(configName))
+			}).WithContext(ctx).Should(Succeed())
+			updated := &appsv1.Deployment{}
+			Expect(suite.Client().Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, updated)).To(Succeed())
+			Expect(equality.Semantic.DeepDerivative(deployment.Spec.Template, updated.Spec.Template)).To(BeTrue())
 
 			By("Root certificate still present after config update")
 			Eventually(func(g Gomega, ctx context.Context) {
@@ -246,6 +275,7 @@ var _ = Describe("Fulcio hot update", func() {
 						Header:     make(http.Header),
 					}
 				},
+				"http://fulcio.localhost/api/v2/configuration": configurationMock(typeNamespaceName),
 			})
 
 			By("Triggering another spec change to force re-resolution")
@@ -260,12 +290,12 @@ var _ = Describe("Fulcio hot update", func() {
 				return suite.Client().Update(ctx, found)
 			}).WithContext(ctx).Should(Succeed())
 
-			By("Fulcio deployment is updated again")
-			Eventually(func(g Gomega, ctx context.Context) bool {
-				updated := &appsv1.Deployment{}
-				g.Expect(suite.Client().Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, updated)).To(Succeed())
-				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
-			}).WithContext(ctx).Should(BeFalse())
+			By("Server config is updated in place again")
+			Eventually(func(g Gomega, ctx context.Context) string {
+				cm := &corev1.ConfigMap{}
+				g.Expect(suite.Client().Get(ctx, types.NamespacedName{Name: configName, Namespace: Namespace}, cm)).To(Succeed())
+				return cm.Data["config.yaml"]
+			}).WithContext(ctx).Should(ContainSubstring("fake2"))
 
 			By("Move to Ready phase")
 			deployment = &appsv1.Deployment{}
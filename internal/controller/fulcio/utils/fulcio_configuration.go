@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	yaml "sigs.k8s.io/yaml/goyaml.v2"
+)
+
+// ConfigurationPath is the Fulcio API endpoint with the OIDC issuers of the loaded server configuration.
+const ConfigurationPath = "/api/v2/configuration"
+
+type configuredIssuer struct {
+	IssuerURL         string `json:"issuerUrl"`
+	WildcardIssuerURL string `json:"wildcardIssuerUrl"`
+	Audience          string `json:"audience"`
+}
+
+// ConfigurationLoaded reports whether the response of the configuration endpoint lists exactly the OIDC and meta
+// issuers of the config. The CI issuer metadata is not published by Fulcio and can't be compared.
+func ConfigurationLoaded(config rhtasv1.FulcioConfig, body []byte) (bool, error) {
+	var configuration struct {
+		Issuers []configuredIssuer `json:"issuers"`
+	}
+	if err := json.Unmarshal(body, &configuration); err != nil {
+		return false, fmt.Errorf("invalid Fulcio configuration response: %w", err)
+	}
+	if len(configuration.Issuers) != len(config.OIDCIssuers)+len(config.MetaIssuers) {
+		return false, nil
+	}
+
+	loaded := make(map[configuredIssuer]bool, len(configuration.Issuers))
+	for _, issuer := range configuration.Issuers {
+		loaded[issuer] = true
+	}
+	for _, issuer := range config.OIDCIssuers {
+		// Fulcio publishes the issuer URL as configured, the issuer itself when it is not set
+		if !loaded[configuredIssuer{IssuerURL: issuer.IssuerURL, Audience: issuer.ClientID}] &&
+			!loaded[configuredIssuer{IssuerURL: issuer.Issuer, Audience: issuer.ClientID}] {
+			return false, nil
+		}
+	}
+	for _, issuer := range config.MetaIssuers {
+		if !loaded[configuredIssuer{WildcardIssuerURL: issuer.Issuer, Audience: issuer.ClientID}] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ReloadObservable reports whether the change from the current to the desired server config can be confirmed on the
+// configuration endpoint: issuers are added or removed, or their issuer URL or audience changes. The other issuer
+// fields and the CI issuer metadata are not published, a change of them is not observable.
+func ReloadObservable(current, desired []byte) bool {
+	var from, to FulcioServerConfig
+	if err := yaml.Unmarshal(current, &from); err != nil {
+		return false
+	}
+	if err := yaml.Unmarshal(desired, &to); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(from.CIIssuerMetadata, to.CIIssuerMetadata) &&
+		unpublishedEqual(from.OIDCIssuers, to.OIDCIssuers) && unpublishedEqual(from.MetaIssuers, to.MetaIssuers)
+}
+
+// unpublishedEqual reports whether the issuers kept in the desired config only differ in the published fields.
+func unpublishedEqual(current, desired map[string]OIDCIssuer) bool {
+	for name, issuer := range desired {
+		previous, ok := current[name]
+		if !ok {
+			continue
+		}
+		previous.IssuerURL, previous.ClientID = issuer.IssuerURL, issuer.ClientID
+		if previous != issuer {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+)
+
+const defaultIntermediateValidity = 3 * 365 * 24 * time.Hour
+
+var ErrUnsupportedKeyAlgorithm = errors.New("unsupported key algorithm")
+
+// FulcioIntermediateConfig holds the material needed to issue the Fulcio intermediate CA.
+type FulcioIntermediateConfig struct {
+	// RootPrivateKey and RootCert are the PEM encoded root CA key and certificate.
+	RootPrivateKey []byte
+	RootCert       []byte
+
+	CommonName        string
+	OrganizationName  string
+	OrganizationEmail string
+	Validity          time.Duration
+	MaxPathLen        int
+	KeyAlgorithm      rhtasv1.KeyAlgorithm
+	NameConstraints   *rhtasv1.NameConstraints
+}
+
+// CreateFulcioIntermediate issues an intermediate CA certificate signed by the root CA.
+// It returns the PEM encoded chain (intermediate followed by root) of the given intermediate key.
+func CreateFulcioIntermediate(config *FulcioIntermediateConfig, key crypto.Signer) ([]byte, error) {
+	if config.OrganizationName == "" {
+		return nil, fmt.Errorf("could not create certificate: missing OrganizationName from config")
+	}
+
+	rootKey, err := parseSigner(config.RootPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse root private key: %w", err)
+	}
+	rootCert, err := parseCertificate(config.RootCert)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse root certificate: %w", err)
+	}
+	if !rootCert.IsCA {
+		return nil, errors.New("root certificate is not a CA")
+	}
+
+	serialNumber, err := GenerateSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	validity := config.Validity
+	if validity <= 0 {
+		validity = defaultIntermediateValidity
+	}
+	notBefore := time.Now()
+	notAfter := notBefore.Add(validity)
+	if notAfter.After(rootCert.NotAfter) {
+		notAfter = rootCert.NotAfter
+	}
+
+	emailAddresses := make([]string, 0)
+	if config.OrganizationEmail != "" {
+		emailAddresses = append(emailAddresses, config.OrganizationEmail)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName:   config.CommonName,
+			Organization: []string{config.OrganizationName},
+		},
+		EmailAddresses:        emailAddresses,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            config.MaxPathLen,
+		MaxPathLenZero:        config.MaxPathLen == 0,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+	}
+	if nc := config.NameConstraints; nc != nil {
+		template.PermittedDNSDomainsCritical = true
+		template.PermittedDNSDomains = nc.PermittedDNSDomains
+		template.ExcludedDNSDomains = nc.ExcludedDNSDomains
+		template.PermittedEmailAddresses = nc.PermittedEmailAddresses
+		template.ExcludedEmailAddresses = nc.ExcludedEmailAddresses
+		template.PermittedURIDomains = nc.PermittedURIDomains
+		template.ExcludedURIDomains = nc.ExcludedURIDomains
+	}
+
+	intermediate, err := x509.CreateCertificate(rand.Reader, &template, rootCert, key.Public(), rootKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var chain bytes.Buffer
+	if err = pem.Encode(&chain, &pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: intermediate,
+	}); err != nil {
+		return nil, err
+	}
+	if err = pem.Encode(&chain, &pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: rootCert.Raw,
+	}); err != nil {
+		return nil, err
+	}
+
+	return chain.Bytes(), nil
+}
+
+// RootCertificate returns the PEM encoded last certificate of the chain, which is the root CA.
+func RootCertificate(chain []byte) ([]byte, error) {
+	var root *pem.Block
+	for block, rest := pem.Decode(chain); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "CERTIFICATE" {
+			root = block
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("failed to decode PEM block from certificate chain")
+	}
+	return pem.EncodeToMemory(root), nil
+}
+
+func parseSigner(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM block from private key")
+	}
+	return parseSignerDER(block.Bytes)
+}
+
+func parseSignerDER(der []byte) (crypto.Signer, error) {
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKeyAlgorithm, key)
+	}
+	return signer, nil
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM block from certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -15,8 +15,9 @@ import (
 )
 
 type FulcioCertConfig struct {
-	PrivateKey         []byte
-	PublicKey          []byte
+	PrivateKey []byte
+	PublicKey  []byte
+	// RootCert holds the CA certificate, or the intermediate followed by the root when an intermediate CA is used.
 	RootCert           []byte
 	PrivateKeyPassword []byte
 	OrganizationName   string
@@ -98,7 +99,7 @@ func CreateFulcioCA(config *FulcioCertConfig) ([]byte, error) {
 		}
 	}
 
-	key, err := x509.ParseECPrivateKey(keyBytes)
+	key, err := parseSignerDER(keyBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +127,6 @@ func CreateFulcioCA(config *FulcioCertConfig) ([]byte, error) {
 		SerialNumber:          serialNumber,
 		Subject:               issuer,
 		EmailAddresses:        emailAddresses,
-		SignatureAlgorithm:    x509.ECDSAWithSHA384,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
@@ -135,6 +135,10 @@ func CreateFulcioCA(config *FulcioCertConfig) ([]byte, error) {
 		NotAfter:              notAfter,
 	}
 
+	if _, ok := key.(*ecdsa.PrivateKey); ok {
+		template.SignatureAlgorithm = x509.ECDSAWithSHA384
+	}
+
 	fulcioRoot, err := x509.CreateCertificate(rand.Reader, &template, &template, key.Public(), key)
 	if err != nil {
 		return nil, err
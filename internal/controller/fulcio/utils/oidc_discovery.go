@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	httputils "github.com/securesign/operator/internal/utils/http"
+)
+
+// IssuerURL returns the URL Fulcio fetches the OpenID configuration of the issuer from.
+func IssuerURL(issuer rhtasv1.OIDCIssuer) string {
+	if issuer.IssuerURL != "" {
+		return issuer.IssuerURL
+	}
+	return issuer.Issuer
+}
+
+// CheckOIDCIssuer fetches the OpenID configuration and the JWKS of the issuer the same way Fulcio does when it
+// verifies a token of the issuer. It returns the number of keys in the JWKS.
+func CheckOIDCIssuer(ctx context.Context, client *http.Client, issuer rhtasv1.OIDCIssuer) (int32, error) {
+	body, err := httputils.FetchFromAPI(ctx, client, strings.TrimSuffix(IssuerURL(issuer), "/")+"/.well-known/openid-configuration")
+	if err != nil {
+		return 0, err
+	}
+	var configuration struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err = json.Unmarshal(body, &configuration); err != nil {
+		return 0, fmt.Errorf("invalid OpenID configuration: %w", err)
+	}
+	if configuration.Issuer != issuer.Issuer {
+		return 0, fmt.Errorf("issuer %q of the OpenID configuration does not match %q", configuration.Issuer, issuer.Issuer)
+	}
+	if configuration.JWKSURI == "" {
+		return 0, errors.New("jwks_uri missing in the OpenID configuration")
+	}
+
+	if body, err = httputils.FetchFromAPI(ctx, client, configuration.JWKSURI); err != nil {
+		return 0, err
+	}
+	var jwks struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err = json.Unmarshal(body, &jwks); err != nil {
+		return 0, fmt.Errorf("invalid JWKS: %w", err)
+	}
+	if len(jwks.Keys) == 0 {
+		return 0, errors.New("no keys in the JWKS")
+	}
+	return int32(len(jwks.Keys)), nil //nolint:gosec
+}
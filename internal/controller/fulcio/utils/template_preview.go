@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+)
+
+// RenderTemplatePreview renders the extension and SAN templates of the CI issuer metadata with the sample claims
+// the same way Fulcio does when it issues a certificate for an OIDC token with these claims.
+func RenderTemplatePreview(metadata rhtasv1.CIIssuerMetadata, claimsJSON string) rhtasv1.FulcioTemplatePreviewResult {
+	result := rhtasv1.FulcioTemplatePreviewResult{IssuerName: metadata.IssuerName}
+
+	claims, err := parseClaims(claimsJSON)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	// The defaults take precedence over the claims of the token.
+	data := make(map[string]string, len(claims)+len(metadata.DefaultTemplateValues))
+	for k, v := range claims {
+		data[k] = v
+	}
+	for k, v := range metadata.DefaultTemplateValues {
+		data[k] = v
+	}
+
+	var errs []string
+	templates := reflect.ValueOf(metadata.ExtensionTemplates)
+	extensions := reflect.ValueOf(&result.Extensions).Elem()
+	for i := 0; i < templates.NumField(); i++ {
+		value := templates.Field(i).String()
+		if value == "" {
+			continue
+		}
+		rendered, err := applyTemplateOrReplace(value, data)
+		if err != nil {
+			name, _, _ := strings.Cut(templates.Type().Field(i).Tag.Get("json"), ",")
+			errs = append(errs, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		extensions.Field(i).SetString(rendered)
+	}
+
+	if metadata.SubjectAlternativeNameTemplate != "" {
+		san, err := applyTemplateOrReplace(metadata.SubjectAlternativeNameTemplate, data)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("subjectAlternativeNameTemplate: %v", err))
+		}
+		result.SubjectAlternativeName = san
+	}
+	result.Error = strings.Join(errs, "; ")
+	return result
+}
+
+// parseClaims decodes the JSON object with the claims, the values that are not strings are formatted
+// as Fulcio formats them.
+func parseClaims(claimsJSON string) (map[string]string, error) {
+	decoder := json.NewDecoder(strings.NewReader(claimsJSON))
+	decoder.UseNumber()
+	var raw map[string]any
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid claims JSON: %w", err)
+	}
+	claims := make(map[string]string, len(raw))
+	for k, v := range raw {
+		claims[k] = fmt.Sprintf("%v", v)
+	}
+	return claims, nil
+}
+
+// applyTemplateOrReplace executes a value containing a template, any other value is the name of the claim to use.
+func applyTemplateOrReplace(value string, data map[string]string) (string, error) {
+	if !strings.Contains(value, "{{") {
+		claim, ok := data[value]
+		if !ok {
+			return "", fmt.Errorf("value <%s> not present in either claims or defaults", value)
+		}
+		return claim, nil
+	}
+	t, err := template.New("").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", err
+	}
+	var doc bytes.Buffer
+	if err := t.Execute(&doc, data); err != nil {
+		return "", err
+	}
+	return doc.String(), nil
+}
@@ -15,14 +15,17 @@ import (
 	"github.com/securesign/operator/internal/controller/rekor/actions/searchIndex/redis"
 	"github.com/securesign/operator/internal/images"
 	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/metrics"
 	"github.com/securesign/operator/internal/state"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	"github.com/securesign/operator/internal/utils/kubernetes/job"
 	tlsensure "github.com/securesign/operator/internal/utils/tls/ensure"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -70,6 +73,9 @@ func (i backfillRedisCronJob) Handle(ctx context.Context, instance *rhtasv1.Reko
 			},
 		},
 		i.ensureBacfillCronJob(instance),
+		func(object *batchv1.CronJob) error {
+			return ensure.PodScheduling(instance.Spec.PodRequirements)(&object.Spec.JobTemplate.Spec.Template.Spec)
+		},
 		func(object *batchv1.CronJob) error {
 			return ensure.PodSecurityContext(&object.Spec.JobTemplate.Spec.Template.Spec)
 		},
@@ -96,6 +102,12 @@ func (i backfillRedisCronJob) Handle(ctx context.Context, instance *rhtasv1.Reko
 		)
 	}
 
+	if failed, err := i.countFailedJobs(ctx, instance); err != nil {
+		i.Logger.V(1).Info("could not count failed backfill jobs", "error", err.Error())
+	} else {
+		metrics.SetFailedJobs(instance, actions.BackfillRedisCronJobName, failed)
+	}
+
 	if result != controllerutil.OperationResultNone {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:               constants.ReadyCondition,
@@ -110,6 +122,26 @@ func (i backfillRedisCronJob) Handle(ctx context.Context, instance *rhtasv1.Reko
 	}
 }
 
+// countFailedJobs returns the number of failed Jobs retained in the history of the backfill CronJob.
+func (i backfillRedisCronJob) countFailedJobs(ctx context.Context, instance *rhtasv1.Rekor) (int32, error) {
+	var (
+		list   batchv1.JobList
+		failed int32
+	)
+	if err := i.Client.List(ctx, &list, client.InNamespace(instance.Namespace)); err != nil {
+		return 0, err
+	}
+	for _, j := range list.Items {
+		owned := slices.ContainsFunc(j.OwnerReferences, func(ref metav1.OwnerReference) bool {
+			return ref.Kind == "CronJob" && ref.Name == actions.BackfillRedisCronJobName
+		})
+		if owned && job.IsFailed(j) {
+			failed++
+		}
+	}
+	return failed, nil
+}
+
 func (i backfillRedisCronJob) ensureBacfillCronJob(instance *rhtasv1.Rekor) func(*batchv1.CronJob) error {
 	return func(job *batchv1.CronJob) error {
 		job.Spec.Schedule = instance.Spec.BackFillRedis.Schedule
@@ -0,0 +1,30 @@
+package monitor
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/controller/rekor/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type rekorMonitorNetworkPolicyConfig struct{}
+
+func (rekorMonitorNetworkPolicyConfig) IsEnabled(i *rhtasv1.Rekor) bool {
+	return enabled(i) && utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (rekorMonitorNetworkPolicyConfig) Ingress(_ *rhtasv1.Rekor) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{actions.MonitorMetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Rekor] {
+	return networkpolicy.NewAction(
+		actions.MonitorComponentName,
+		actions.MonitorStatefulSetName,
+		rekorMonitorNetworkPolicyConfig{},
+	)
+}
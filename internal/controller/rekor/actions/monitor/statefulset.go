@@ -82,6 +82,9 @@ func (i statefulSetAction) Handle(ctx context.Context, instance *rhtasv1.Rekor)
 		i.ensureInitContainer(rekorServerHost, tufServerHost),
 		ensure.ControllerReference[*v1.StatefulSet](instance, i.Client),
 		ensure.Labels[*v1.StatefulSet](slices.Collect(maps.Keys(labels)), labels),
+		func(object *v1.StatefulSet) error {
+			return ensure.PodScheduling(instance.Spec.PodRequirements)(&object.Spec.Template.Spec)
+		},
 		func(object *v1.StatefulSet) error {
 			return ensure.PodSecurityContext(&object.Spec.Template.Spec)
 		},
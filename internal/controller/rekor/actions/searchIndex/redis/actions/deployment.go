@@ -13,7 +13,6 @@ import (
 	"github.com/securesign/operator/internal/images"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
-	cutils "github.com/securesign/operator/internal/utils"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure/deployment"
@@ -68,6 +67,7 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.Rekor) *acti
 			},
 		},
 		i.ensureRedisDeployment(instance, actions.RBACRedisName, labels),
+		deployment.PodRequirements(instance.Spec.SearchIndex.PodRequirements, actions.RedisDeploymentName),
 		deployment.TrustedCA(instance.GetTrustedCA(), actions.RedisDeploymentName, actions.RedisDeploymentName),
 		ensure.Optional(statusTLS(instance).CertRef != nil, i.ensureTLS(statusTLS(instance), caPath)),
 		ensure.ControllerReference[*v1.Deployment](instance, i.Client),
@@ -104,7 +104,6 @@ func (i deployAction) ensureRedisDeployment(instance *rhtasv1.Rekor, sa string,
 	return func(dp *v1.Deployment) error {
 
 		spec := &dp.Spec
-		spec.Replicas = cutils.Pointer[int32](1)
 		spec.Selector = &metav1.LabelSelector{
 			MatchLabels: labels,
 		}
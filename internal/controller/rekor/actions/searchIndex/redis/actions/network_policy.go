@@ -0,0 +1,32 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/controller/rekor/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type redisNetworkPolicyConfig struct{}
+
+func (redisNetworkPolicyConfig) IsEnabled(i *rhtasv1.Rekor) bool {
+	return enabled(i) && utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (redisNetworkPolicyConfig) Ingress(_ *rhtasv1.Rekor) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{actions.RedisDeploymentPort},
+			networkpolicy.FromComponents(actions.ServerComponentName, actions.BackfillRedisCronJobName),
+		),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Rekor] {
+	return networkpolicy.NewAction(
+		actions.RedisComponentName,
+		actions.RedisDeploymentName,
+		redisNetworkPolicyConfig{},
+	)
+}
@@ -0,0 +1,22 @@
+package server
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/autoscaling"
+	"github.com/securesign/operator/internal/controller/rekor/actions"
+)
+
+type rekorAutoscalingConfig struct{}
+
+func (rekorAutoscalingConfig) Autoscaling(i *rhtasv1.Rekor) rhtasv1.Autoscaling {
+	return i.Spec.Autoscaling
+}
+
+func NewAutoscalingAction() action.Action[*rhtasv1.Rekor] {
+	return autoscaling.NewAction(
+		actions.ServerComponentName,
+		actions.ServerDeploymentName,
+		rekorAutoscalingConfig{},
+	)
+}
@@ -0,0 +1,21 @@
+package server
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certexpiry"
+)
+
+type rekorCertificates struct{}
+
+func (rekorCertificates) Certificates(i *rhtasv1.Rekor) []certexpiry.Certificate {
+	return []certexpiry.Certificate{{Name: "searchIndex-tls", Ref: i.Status.SearchIndex.TLS.CertRef}}
+}
+
+func (rekorCertificates) Status(i *rhtasv1.Rekor) *[]rhtasv1.CertificateStatus {
+	return &i.Status.Certificates
+}
+
+func NewCertificateExpiryAction() action.Action[*rhtasv1.Rekor] {
+	return certexpiry.NewAction[*rhtasv1.Rekor](rekorCertificates{})
+}
@@ -79,8 +79,10 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.Rekor) *acti
 				Namespace: instance.Namespace,
 			},
 		},
-		i.ensureServerDeployment(instance, actions.RBACName, labels, trillianHost, trillianPort),
-		deployment.PodRequirements(instance.Spec.PodRequirements, actions.ServerDeploymentName),
+		deployment.Autoscaling(instance.Spec.Autoscaling,
+			i.ensureServerDeployment(instance, actions.RBACName, labels, trillianHost, trillianPort),
+			deployment.PodRequirements(instance.Spec.PodRequirements, actions.ServerDeploymentName),
+		),
 		deployment.PodSecurityContext(),
 		i.ensureAttestation(instance),
 		ensure.ControllerReference[*v2.Deployment](instance, i.Client),
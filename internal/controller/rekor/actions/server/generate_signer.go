@@ -1,13 +1,7 @@
 package server
 
 import (
-	"bytes"
 	"context"
-	"crypto/ecdsa"
-	"crypto/elliptic"
-	"crypto/rand"
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
@@ -15,6 +9,7 @@ import (
 	generateSigner "github.com/securesign/operator/internal/action/generateSigner"
 	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/controller/rekor/actions"
+	"github.com/securesign/operator/internal/utils/keys"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -50,8 +45,8 @@ func resolveRef(ctx context.Context, instance *rhtasv1.Rekor, c client.Client) (
 	return generateSigner.ResolveStatusSecret(ctx, c, instance.Status.Signer.KeyRef, instance.Namespace, fmt.Sprintf(signerSecretNameFormat, instance.Name))
 }
 
-func generateData(_ context.Context, _ *rhtasv1.Rekor, _ client.Client) (map[string][]byte, error) {
-	privateKey, publicKey, err := createSignerKey()
+func generateData(_ context.Context, instance *rhtasv1.Rekor, _ client.Client) (map[string][]byte, error) {
+	privateKey, publicKey, err := createSignerKey(keyAlgorithm(instance))
 	if err != nil {
 		return nil, err
 	}
@@ -82,31 +77,28 @@ func alignStatus(instance *rhtasv1.Rekor, ref rhtasv1.SecretKeySelector) {
 	instance.Status.Signer = newSigner
 }
 
-func createSignerKey() ([]byte, []byte, error) {
-	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
-	if err != nil {
-		return nil, nil, err
-	}
+// keyAlgorithm returns the algorithm of the generated signer key.
+func keyAlgorithm(instance *rhtasv1.Rekor) rhtasv1.KeyAlgorithm {
+	signer := instance.Spec.Signer.DeepCopy()
+	signer.SetDefaults()
+	return signer.KeyAlgorithm
+}
 
-	mKey, err := x509.MarshalECPrivateKey(key)
+func createSignerKey(algorithm rhtasv1.KeyAlgorithm) ([]byte, []byte, error) {
+	key, err := keys.Generate(algorithm)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	mPubKey, err := x509.MarshalPKIXPublicKey(key.Public())
+	privateKey, err := keys.MarshalPrivateKey(key)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var pemPrivKey bytes.Buffer
-	if err = pem.Encode(&pemPrivKey, &pem.Block{Type: "EC PRIVATE KEY", Bytes: mKey}); err != nil {
-		return nil, nil, err
-	}
-
-	var pemPubKey bytes.Buffer
-	if err = pem.Encode(&pemPubKey, &pem.Block{Type: "PUBLIC KEY", Bytes: mPubKey}); err != nil {
+	publicKey, err := keys.MarshalPublicKey(key.Public())
+	if err != nil {
 		return nil, nil, err
 	}
 
-	return pemPrivKey.Bytes(), pemPubKey.Bytes(), nil
+	return privateKey, publicKey, nil
 }
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"testing"
 
@@ -121,6 +124,30 @@ func TestRekorSigner_GeneratesCorrectKeyData(t *testing.T) {
 	g.Expect(secret.Labels).ToNot(BeEmpty())
 }
 
+func TestRekorSigner_GeneratesEd25519Key(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := rekorInstance()
+	instance.Spec.Signer.KeyAlgorithm = rhtasv1.KeyAlgorithmEd25519
+
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance).
+		WithStatusSubresource(instance).
+		Build()
+
+	a := testAction.PrepareAction(c, NewGenerateSignerAction())
+	g.Expect(a.Handle(ctx, instance)).To(Equal(testAction.Return()))
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "rekor-signer-config-rekor", Namespace: "default"}, secret)).To(Succeed())
+
+	block, _ := pem.Decode(secret.Data[constants.KeyPrivate])
+	g.Expect(block).ToNot(BeNil())
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(key).To(BeAssignableToTypeOf(ed25519.PrivateKey{}))
+}
+
 func TestRekorSigner_MigrationFromPreExistingSecret(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -262,7 +289,7 @@ func TestRekorSigner_UnencryptedKeyAllowedInFIPS(t *testing.T) {
 	fips.Enabled = func() bool { return true }
 	t.Cleanup(func() { fips.Enabled = original })
 
-	unencryptedKey, _, err := createSignerKey()
+	unencryptedKey, _, err := createSignerKey(rhtasv1.KeyAlgorithmECDSAP256)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	instance := rekorInstance()
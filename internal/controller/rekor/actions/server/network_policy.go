@@ -0,0 +1,36 @@
+package server
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	consoleActions "github.com/securesign/operator/internal/controller/console/actions"
+	"github.com/securesign/operator/internal/controller/rekor/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type rekorNetworkPolicyConfig struct{}
+
+func (rekorNetworkPolicyConfig) IsEnabled(i *rhtasv1.Rekor) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (rekorNetworkPolicyConfig) Ingress(_ *rhtasv1.Rekor) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{actions.ServerTargetDeploymentPort},
+			networkpolicy.FromComponents(actions.MonitorComponentName, actions.BackfillRedisCronJobName, consoleActions.UIComponentName),
+			networkpolicy.FromIngressControllers(),
+			networkpolicy.FromOperator(),
+		),
+		networkpolicy.Rule([]int32{actions.MetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Rekor] {
+	return networkpolicy.NewAction(
+		actions.ServerComponentName,
+		actions.ServerDeploymentName,
+		rekorNetworkPolicyConfig{},
+	)
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/alerting"
+	"github.com/securesign/operator/internal/controller/rekor/actions"
+	"github.com/securesign/operator/internal/utils"
+)
+
+type rekorAlertingConfig struct{}
+
+func (rekorAlertingConfig) PrometheusRule(i *rhtasv1.Rekor) rhtasv1.PrometheusRuleConfig {
+	return i.Spec.Monitoring.PrometheusRule
+}
+
+func (rekorAlertingConfig) Rules(i *rhtasv1.Rekor) []alerting.Rule {
+	rules := []alerting.Rule{
+		{
+			Alert:       "RekorHigh5xxRate",
+			Threshold:   "0.05",
+			For:         "10m",
+			Severity:    alerting.SeverityCritical,
+			Summary:     "Rekor server returns server errors",
+			Description: "{{ $value | humanizePercentage }} of the Rekor API requests fail with a 5xx status code.",
+			Expr: func(namespace, threshold string) string {
+				selector := fmt.Sprintf(`namespace=%q,service=%q`, namespace, actions.ServerDeploymentName)
+				return fmt.Sprintf(`sum(rate(rekor_qps_by_api{%s,code=~"5.."}[5m])) / sum(rate(rekor_qps_by_api{%s}[5m])) > %s`,
+					selector, selector, threshold)
+			},
+		},
+		alerting.CertificateExpiryRule("RekorCertificateExpiring", "Rekor", i.Name),
+	}
+	if utils.IsEnabled(i.Spec.Monitoring.TLog.Enabled) {
+		rules = append(rules, alerting.MonitorInconsistencyRule("RekorMonitorInconsistency", actions.MonitorComponentName))
+	}
+	return rules
+}
+
+func NewPrometheusRuleAction() action.Action[*rhtasv1.Rekor] {
+	return alerting.NewAction(
+		actions.ServerComponentName,
+		actions.MonitoringRoleName,
+		actions.ServerDeploymentName,
+		actions.ServerCondition,
+		rekorAlertingConfig{},
+	)
+}
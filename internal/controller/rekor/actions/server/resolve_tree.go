@@ -11,6 +11,9 @@ func NewResolveTreeAction() action.Action[*rhtasv1.Rekor] {
 		func(rekor *rhtasv1.Rekor) *int64 {
 			return rekor.Spec.TreeID
 		},
+		func(rekor *rhtasv1.Rekor) *rhtasv1.LocalObjectReference {
+			return rekor.Spec.TreeRef
+		},
 		func(rekor *rhtasv1.Rekor) *int64 {
 			return rekor.Status.TreeID
 		},
@@ -19,6 +22,9 @@ func NewResolveTreeAction() action.Action[*rhtasv1.Rekor] {
 		},
 		func(rekor *rhtasv1.Rekor) *rhtasv1.ServiceReference {
 			return &rekor.Spec.Trillian
+		},
+		func(rekor *rhtasv1.Rekor) rhtasv1.PodRequirements {
+			return rekor.Spec.PodRequirements
 		})
 	return tree.NewResolveTreeAction[*rhtasv1.Rekor]("rekor", wrapper)
 }
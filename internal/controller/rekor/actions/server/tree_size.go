@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/trustmaterial"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/state"
+	httputils "github.com/securesign/operator/internal/utils/http"
+)
+
+// logInfo is the subset of the Rekor /api/v1/log response the operator reports.
+type logInfo struct {
+	TreeID   string `json:"treeID"`
+	TreeSize int64  `json:"treeSize"`
+}
+
+func NewTreeSizeAction() action.Action[*rhtasv1.Rekor] {
+	return &treeSizeAction{}
+}
+
+type treeSizeAction struct {
+	action.BaseAction
+}
+
+func (i treeSizeAction) Name() string {
+	return "record tree size"
+}
+
+func (i treeSizeAction) CanHandle(_ context.Context, instance *rhtasv1.Rekor) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Ready
+}
+
+func (i treeSizeAction) Handle(ctx context.Context, instance *rhtasv1.Rekor) *action.Result {
+	info, err := i.fetchLogInfo(ctx, instance)
+	if err != nil {
+		// metrics are best effort, never block the reconciliation
+		i.Logger.V(1).Info("could not read tree size", "error", err.Error())
+		return i.Continue()
+	}
+	metrics.SetTreeSize(instance, info.TreeID, info.TreeSize)
+	return i.Continue()
+}
+
+func (i treeSizeAction) fetchLogInfo(ctx context.Context, instance *rhtasv1.Rekor) (*logInfo, error) {
+	baseURL, err := trustmaterial.ResolveBaseURL(instance)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.JoinPath(baseURL, "/api/v1/log")
+	if err != nil {
+		return nil, err
+	}
+	cas, err := httputils.LoadTrustedCAs(ctx, i.Client, instance)
+	if err != nil {
+		return nil, err
+	}
+	body, err := httputils.FetchFromAPI(ctx, httputils.GetClientBuilder()(cas...), u)
+	if err != nil {
+		return nil, err
+	}
+	info := &logInfo{}
+	if err = json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("parsing log info: %w", err)
+	}
+	return info, nil
+}
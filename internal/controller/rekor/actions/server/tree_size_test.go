@@ -0,0 +1,61 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	httpmock "github.com/securesign/operator/internal/testing/http"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestTreeSize(t *testing.T) {
+	g := NewWithT(t)
+
+	httpmock.StubClientBuilder(t, "http://rekor-server.default.svc/api/v1/log", http.StatusOK,
+		`{"rootHash":"abc","signedTreeHead":"sth","treeID":"1234","treeSize":42}`)
+
+	instance := &rhtasv1.Rekor{
+		ObjectMeta: metav1.ObjectMeta{Name: "tree-size", Namespace: "default"},
+		Status: rhtasv1.RekorStatus{
+			Url: "http://rekor-server.default.svc",
+			Conditions: []metav1.Condition{
+				{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
+			},
+		},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewTreeSizeAction())
+
+	g.Expect(a.CanHandle(t.Context(), instance)).To(BeTrue())
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+	g.Expect(testutil.GatherAndCompare(crmetrics.Registry, strings.NewReader(`
+# HELP rhtas_operator_tree_size Number of entries in the active transparency log tree.
+# TYPE rhtas_operator_tree_size gauge
+rhtas_operator_tree_size{kind="Rekor",name="tree-size",namespace="default",tree_id="1234"} 42
+`), "rhtas_operator_tree_size")).To(Succeed())
+}
+
+func TestTreeSize_Unavailable(t *testing.T) {
+	g := NewWithT(t)
+
+	httpmock.StubClientBuilder(t, "http://rekor-server.default.svc/api/v1/log", http.StatusServiceUnavailable, "unavailable")
+
+	instance := &rhtasv1.Rekor{
+		ObjectMeta: metav1.ObjectMeta{Name: "tree-size-unavailable", Namespace: "default"},
+		Status:     rhtasv1.RekorStatus{Url: "http://rekor-server.default.svc"},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewTreeSizeAction())
+
+	g.Expect(a.CanHandle(t.Context(), instance)).To(BeFalse())
+	// metrics are best effort and must not block the reconciliation
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+}
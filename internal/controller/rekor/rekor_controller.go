@@ -18,6 +18,7 @@ package rekor
 
 import (
 	"context"
+	"time"
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/action/transitions"
@@ -25,9 +26,11 @@ import (
 	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/controller"
 	redis "github.com/securesign/operator/internal/controller/rekor/actions/searchIndex/redis/actions"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
 	"github.com/securesign/operator/internal/utils"
 	fipsutil "github.com/securesign/operator/internal/utils/fips"
-	"k8s.io/apimachinery/pkg/types"
+	"github.com/securesign/operator/internal/utils/kubernetes"
 
 	olpredicate "github.com/operator-framework/operator-lib/predicate"
 	actions2 "github.com/securesign/operator/internal/controller/rekor/actions"
@@ -36,6 +39,7 @@ import (
 	"github.com/securesign/operator/internal/controller/rekor/actions/server"
 	"github.com/securesign/operator/internal/controller/rekor/actions/ui"
 	_ "github.com/securesign/operator/internal/controller/rekor/serviceresolver"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v13 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/events"
@@ -46,10 +50,12 @@ import (
 	ctrlutil "github.com/securesign/operator/internal/utils/controller"
 	v12 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 	crpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -61,6 +67,7 @@ type rekorReconciler struct {
 	client.Client
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
 }
 
 func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
@@ -68,12 +75,14 @@ func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.Even
 		Client:   c,
 		scheme:   scheme,
 		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
 	}
 }
 
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=rekors,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=rekors/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=rekors/finalizers,verbs=update
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trilliantrees,verbs=get;list;watch
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=consoles,verbs=get;create;update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -90,22 +99,26 @@ func (r *rekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	log := ctrllog.FromContext(ctx)
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Fetch the namespace
-	var namespace v13.Namespace
-	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, &namespace); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
 	// Check if the namespace is marked for deletion
-	if !namespace.DeletionTimestamp.IsZero() {
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating {
 		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
 		return ctrl.Result{}, nil
 	}
 
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
 	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
 	conditionSupplier := func(rekor *rhtasv1.Rekor) []string {
 		components := fipsutil.AppendFIPSCondition([]string{actions2.ServerCondition, actions2.SignerCondition, trustmaterial.TrustMaterialCondition})
 		if utils.OptionalBool(rekor.Spec.SearchIndex.Create) {
@@ -136,19 +149,24 @@ func (r *rekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		server.NewResolveTreeAction(),
 		server.NewCreatePvcAction(),
 		server.NewDeployAction(),
+		server.NewAutoscalingAction(),
 		server.NewCreateServiceAction(),
 		server.NewCreateMonitorAction(),
+		server.NewPrometheusRuleAction(),
 		server.NewIngressAction(),
+		server.NewNetworkPolicyAction(),
 		server.NewStatusUrlAction(),
 
 		redis.NewDeployAction(),
 		redis.NewCreateServiceAction(),
+		redis.NewNetworkPolicyAction(),
 
 		backfillredis.NewBackfillRedisCronJobAction(),
 
 		monitor.NewStatefulSetAction(),
 		monitor.NewCreateServiceAction(),
 		monitor.NewCreateMonitorAction(),
+		monitor.NewNetworkPolicyAction(),
 
 		transitions.NewToInitializePhaseAction[*rhtasv1.Rekor](),
 
@@ -157,6 +175,12 @@ func (r *rekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		redis.NewRolloutCheckAction(),
 
 		transitions.NewToReadyPhaseAction[*rhtasv1.Rekor](),
+		server.NewTreeSizeAction(),
+		server.NewCertificateExpiryAction(),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), actions)
 	}
 
 	for _, a := range actions {
@@ -166,7 +190,9 @@ func (r *rekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 		if a.CanHandle(ctx, target) {
 			log.V(2).Info("Executing " + a.Name())
+			start := time.Now()
 			result := a.Handle(ctx, target)
+			metrics.ObserveAction("rekor", a.Name(), start, result)
 			if result != nil {
 				return result.Result, result.Err
 			}
@@ -190,12 +216,28 @@ func (r *rekorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&v12.StatefulSet{}).
 		Owns(&v13.Service{}).
 		Owns(&v1.Ingress{}).
+		Owns(&v1.NetworkPolicy{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
 		Owns(&batchv1.CronJob{}).
 		Watches(&rhtasv1.Trillian{}, handler.EnqueueRequestsFromMapFunc(
 			ctrlutil.ServiceRefWatch(mgr.GetClient(), &rhtasv1.RekorList{}, func(o client.Object) rhtasv1.ServiceReference {
 				return o.(*rhtasv1.Rekor).Spec.Trillian
 			}),
 		), builder.WithPredicates(crpredicate.GenerationChangedPredicate{})).
+		Watches(&rhtasv1.TrillianTree{}, handler.EnqueueRequestsFromMapFunc(
+			ctrlutil.LocalRefWatch(mgr.GetClient(), &rhtasv1.RekorList{}, func(o client.Object) *rhtasv1.LocalObjectReference {
+				return o.(*rhtasv1.Rekor).Spec.TreeRef
+			}),
+		), builder.WithPredicates(crpredicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldT, ok1 := e.ObjectOld.(*rhtasv1.TrillianTree)
+				newT, ok2 := e.ObjectNew.(*rhtasv1.TrillianTree)
+				if !ok1 || !ok2 {
+					return true
+				}
+				return !equality.Semantic.DeepEqual(oldT.Status.TreeID, newT.Status.TreeID)
+			},
+		})).
 		Watches(&rhtasv1.Tuf{}, handler.EnqueueRequestsFromMapFunc(
 			ctrlutil.ServiceRefWatch(mgr.GetClient(), &rhtasv1.RekorList{}, func(o client.Object) rhtasv1.ServiceReference {
 				return o.(*rhtasv1.Rekor).Spec.Monitoring.Tuf
@@ -0,0 +1,24 @@
+package actions
+
+const (
+	DeploymentName     = "rekor-v2-server"
+	ComponentName      = "rekor-v2"
+	RBACName           = "rekor-v2"
+	MonitoringRoleName = "prometheus-k8s-rekor-v2"
+
+	ServerContainerName = "rekor-v2-server"
+	TilesContainerName  = "rekor-v2-tiles"
+
+	ServerPortName   = "http"
+	ServerPort       = 80
+	ServerTargetPort = 3000
+	GrpcPortName     = "grpc"
+	GrpcPort         = 3001
+	TilesPortName    = "tiles"
+	TilesPort        = 8080
+	MetricsPortName  = "metrics"
+	MetricsPort      = 2112
+
+	ServerCondition = "ServerAvailable"
+	SignerCondition = "SignerAvailable"
+)
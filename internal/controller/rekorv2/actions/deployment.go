@@ -0,0 +1,342 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/images"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/fips"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure/deployment"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	storageVolumeName    = "storage"
+	storageMountPath     = "/var/run/rekor-v2"
+	privateKeyVolumeName = "rekor-v2-private-key"
+)
+
+var (
+	ErrOriginNotSpecified    = errors.New("checkpoint origin not specified")
+	ErrSignerKeyNotSpecified = errors.New("signer key not specified")
+	ErrPvcNotSpecified       = errors.New("storage PVC not specified")
+	ErrS3NotSpecified        = errors.New("s3 storage not specified")
+)
+
+func NewDeployAction() action.Action[*rhtasv1.RekorV2] {
+	return &deployAction{}
+}
+
+type deployAction struct {
+	action.BaseAction
+}
+
+func (i deployAction) Name() string {
+	return "deploy"
+}
+
+func (i deployAction) CanHandle(_ context.Context, instance *rhtasv1.RekorV2) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.RekorV2) *action.Result {
+	var (
+		err    error
+		result controllerutil.OperationResult
+	)
+	l := labels.For(ComponentName, DeploymentName, instance.Name)
+
+	if result, err = kubernetes.CreateOrUpdate(ctx, i.Client,
+		&apps.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      DeploymentName,
+				Namespace: instance.Namespace,
+			},
+		},
+		i.ensureDeployment(instance, RBACName, l),
+		deployment.PodRequirements(instance.Spec.PodRequirements, ServerContainerName),
+		deployment.PodSecurityContext(),
+		ensure.ControllerReference[*apps.Deployment](instance, i.Client),
+		ensure.Labels[*apps.Deployment](slices.Collect(maps.Keys(l)), l),
+		deployment.Auth(ServerContainerName, instance.Spec.Auth),
+		deployment.Proxy(),
+		deployment.GODEBUG(instance.GetAnnotations()),
+		deployment.TrustedCA(instance.GetTrustedCA(), ServerContainerName),
+	); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not create server Deployment: %w", err), instance)
+	}
+
+	if result != controllerutil.OperationResultNone {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    ServerCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Creating.String(),
+			Message: "Deployment created",
+		})
+		i.Recorder.Eventf(instance, nil, core.EventTypeNormal, "DeploymentUpdated", "Updated", "Deployment updated: %s", instance.Name)
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+	return i.Continue()
+}
+
+func (i deployAction) ensureDeployment(instance *rhtasv1.RekorV2, sa string, labels map[string]string) func(*apps.Deployment) error {
+	return func(dp *apps.Deployment) error {
+		if instance.Status.Origin == "" {
+			return ErrOriginNotSpecified
+		}
+
+		spec := &dp.Spec
+		spec.Selector = &metav1.LabelSelector{
+			MatchLabels: labels,
+		}
+		if posixStorage(instance) {
+			// the log directory is locked by a single writer
+			spec.Strategy = apps.DeploymentStrategy{Type: apps.RecreateDeploymentStrategyType}
+		} else {
+			spec.Strategy = apps.DeploymentStrategy{Type: apps.RollingUpdateDeploymentStrategyType}
+		}
+
+		template := &spec.Template
+		template.Labels = labels
+		template.Spec.ServiceAccountName = sa
+
+		container := kubernetes.FindContainerByNameOrCreate(&template.Spec, ServerContainerName)
+		container.Image = images.Registry.Get(images.RekorV2Server)
+
+		args := []string{
+			"serve",
+			"--http-address=0.0.0.0",
+			"--http-port=" + strconv.Itoa(ServerTargetPort),
+			"--grpc-address=0.0.0.0",
+			"--grpc-port=" + strconv.Itoa(GrpcPort),
+			"--http-metrics-port=" + strconv.Itoa(MetricsPort),
+			"--hostname=" + instance.Status.Origin,
+		}
+		if instance.Spec.CheckpointInterval != nil {
+			args = append(args, "--checkpoint-interval="+instance.Spec.CheckpointInterval.Duration.String())
+		}
+		if instance.Spec.MaxRequestBodySize != nil {
+			args = append(args, "--max-request-body-size="+strconv.FormatInt(*instance.Spec.MaxRequestBodySize, 10))
+		}
+		if fips.Enabled() {
+			args = append(args, "--client-signing-algorithms", fips.ClientSigningAlgorithms)
+		}
+
+		signerArgs, err := ensureSigner(instance, &template.Spec)
+		if err != nil {
+			return err
+		}
+		args = append(args, signerArgs...)
+
+		storageArgs, err := ensureStorage(instance, &template.Spec)
+		if err != nil {
+			return err
+		}
+		// the tiles sidecar may have reallocated the containers
+		container = kubernetes.FindContainerByNameOrCreate(&template.Spec, ServerContainerName)
+		container.Args = append(args, storageArgs...)
+
+		port := kubernetes.FindPortByNameOrCreate(container, ServerPortName)
+		port.ContainerPort = ServerTargetPort
+		port.Protocol = core.ProtocolTCP
+
+		grpcPort := kubernetes.FindPortByNameOrCreate(container, GrpcPortName)
+		grpcPort.ContainerPort = GrpcPort
+		grpcPort.Protocol = core.ProtocolTCP
+
+		if utils.IsEnabled(instance.Spec.Monitoring.Metrics.Enabled) {
+			monitoringPort := kubernetes.FindPortByNameOrCreate(container, MetricsPortName)
+			monitoringPort.ContainerPort = MetricsPort
+			monitoringPort.Protocol = core.ProtocolTCP
+		}
+
+		if container.LivenessProbe == nil {
+			container.LivenessProbe = &core.Probe{}
+		}
+		if container.LivenessProbe.HTTPGet == nil {
+			container.LivenessProbe.HTTPGet = &core.HTTPGetAction{}
+		}
+		container.LivenessProbe.HTTPGet.Path = "/healthz"
+		container.LivenessProbe.HTTPGet.Port = intstr.FromInt32(ServerTargetPort)
+		container.LivenessProbe.PeriodSeconds = 10
+		container.LivenessProbe.TimeoutSeconds = 1
+		container.LivenessProbe.FailureThreshold = 3
+
+		if container.ReadinessProbe == nil {
+			container.ReadinessProbe = &core.Probe{}
+		}
+		if container.ReadinessProbe.HTTPGet == nil {
+			container.ReadinessProbe.HTTPGet = &core.HTTPGetAction{}
+		}
+		container.ReadinessProbe.HTTPGet.Path = "/healthz"
+		container.ReadinessProbe.HTTPGet.Port = intstr.FromInt32(ServerTargetPort)
+		container.ReadinessProbe.PeriodSeconds = 10
+		container.ReadinessProbe.TimeoutSeconds = 5
+		container.ReadinessProbe.FailureThreshold = 3
+
+		if container.StartupProbe == nil {
+			container.StartupProbe = &core.Probe{}
+		}
+		if container.StartupProbe.HTTPGet == nil {
+			container.StartupProbe.HTTPGet = &core.HTTPGetAction{}
+		}
+		container.StartupProbe.HTTPGet.Path = "/healthz"
+		container.StartupProbe.HTTPGet.Port = intstr.FromInt32(ServerTargetPort)
+		container.StartupProbe.PeriodSeconds = 5
+		container.StartupProbe.TimeoutSeconds = 5
+		container.StartupProbe.FailureThreshold = 12
+
+		return nil
+	}
+}
+
+// ensureSigner mounts the checkpoint signer key and returns the signer arguments.
+func ensureSigner(instance *rhtasv1.RekorV2, spec *core.PodSpec) ([]string, error) {
+	if instance.Spec.Signer.Kms != nil {
+		return []string{"--signer-kmskey=" + instance.Spec.Signer.Kms.KeyResource}, nil
+	}
+	if instance.Status.PrivateKeyRef == nil {
+		return nil, ErrSignerKeyNotSpecified
+	}
+
+	args := []string{"--signer-filepath=/key/" + constants.KeyPrivate}
+
+	volume := kubernetes.FindVolumeByNameOrCreate(spec, privateKeyVolumeName)
+	if volume.Secret == nil {
+		volume.Secret = &core.SecretVolumeSource{}
+	}
+	volume.Secret.SecretName = instance.Status.PrivateKeyRef.Name
+	volume.Secret.Items = []core.KeyToPath{
+		{
+			Key:  instance.Status.PrivateKeyRef.Key,
+			Path: constants.KeyPrivate,
+		},
+	}
+
+	container := kubernetes.FindContainerByNameOrCreate(spec, ServerContainerName)
+	volumeMount := kubernetes.FindVolumeMountByNameOrCreate(container, privateKeyVolumeName)
+	volumeMount.MountPath = "/key"
+	volumeMount.ReadOnly = true
+
+	if instance.Status.PasswordRef != nil {
+		args = append(args, "--signer-password=$(SIGNER_PASSWORD)")
+		env := kubernetes.FindEnvByNameOrCreate(container, "SIGNER_PASSWORD")
+		env.ValueFrom = &core.EnvVarSource{
+			SecretKeyRef: &core.SecretKeySelector{
+				Key:                  instance.Status.PasswordRef.Key,
+				LocalObjectReference: core.LocalObjectReference{Name: instance.Status.PasswordRef.Name},
+			},
+		}
+	}
+	return args, nil
+}
+
+// ensureStorage configures the storage backend and returns the storage arguments.
+// The posix backend shares the log directory with a web server serving the tiles.
+func ensureStorage(instance *rhtasv1.RekorV2, spec *core.PodSpec) ([]string, error) {
+	container := kubernetes.FindContainerByNameOrCreate(spec, ServerContainerName)
+
+	if posixStorage(instance) {
+		if instance.Status.PvcName == "" {
+			return nil, ErrPvcNotSpecified
+		}
+		volume := kubernetes.FindVolumeByNameOrCreate(spec, storageVolumeName)
+		if volume.PersistentVolumeClaim == nil {
+			volume.PersistentVolumeClaim = &core.PersistentVolumeClaimVolumeSource{}
+		}
+		volume.PersistentVolumeClaim.ClaimName = instance.Status.PvcName
+
+		volumeMount := kubernetes.FindVolumeMountByNameOrCreate(container, storageVolumeName)
+		volumeMount.MountPath = storageMountPath
+
+		ensureTilesContainer(spec)
+		return []string{"--posix-storage-dir=" + storageMountPath}, nil
+	}
+
+	s3 := instance.Spec.Storage.S3
+	if s3 == nil {
+		return nil, ErrS3NotSpecified
+	}
+
+	dsn := kubernetes.FindEnvByNameOrCreate(container, "MYSQL_DSN")
+	dsn.ValueFrom = &core.EnvVarSource{
+		SecretKeyRef: &core.SecretKeySelector{
+			Key:                  s3.DatabaseRef.Key,
+			LocalObjectReference: core.LocalObjectReference{Name: s3.DatabaseRef.Name},
+		},
+	}
+	if s3.Region != "" {
+		kubernetes.FindEnvByNameOrCreate(container, "AWS_REGION").Value = s3.Region
+	}
+	if s3.Endpoint != "" {
+		kubernetes.FindEnvByNameOrCreate(container, "AWS_ENDPOINT_URL_S3").Value = s3.Endpoint
+	}
+	if s3.CredentialsRef != nil {
+		for _, key := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"} {
+			env := kubernetes.FindEnvByNameOrCreate(container, key)
+			env.ValueFrom = &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					Key:                  key,
+					LocalObjectReference: core.LocalObjectReference{Name: s3.CredentialsRef.Name},
+				},
+			}
+		}
+	}
+
+	return []string{
+		"--aws-bucket=" + s3.Bucket,
+		"--aws-mysql-dsn=$(MYSQL_DSN)",
+	}, nil
+}
+
+func ensureTilesContainer(spec *core.PodSpec) {
+	container := kubernetes.FindContainerByNameOrCreate(spec, TilesContainerName)
+	container.Image = images.Registry.Get(images.HttpServer)
+
+	port := kubernetes.FindPortByNameOrCreate(container, TilesPortName)
+	port.ContainerPort = TilesPort
+	port.Protocol = core.ProtocolTCP
+
+	volumeMount := kubernetes.FindVolumeMountByNameOrCreate(container, storageVolumeName)
+	volumeMount.MountPath = "/var/www/html"
+	volumeMount.ReadOnly = true
+
+	if container.LivenessProbe == nil {
+		container.LivenessProbe = &core.Probe{}
+	}
+	if container.LivenessProbe.TCPSocket == nil {
+		container.LivenessProbe.TCPSocket = &core.TCPSocketAction{}
+	}
+	container.LivenessProbe.TCPSocket.Port = intstr.FromInt32(TilesPort)
+	container.LivenessProbe.PeriodSeconds = 10
+	container.LivenessProbe.TimeoutSeconds = 1
+	container.LivenessProbe.FailureThreshold = 3
+
+	if container.ReadinessProbe == nil {
+		container.ReadinessProbe = &core.Probe{}
+	}
+	if container.ReadinessProbe.TCPSocket == nil {
+		container.ReadinessProbe.TCPSocket = &core.TCPSocketAction{}
+	}
+	container.ReadinessProbe.TCPSocket.Port = intstr.FromInt32(TilesPort)
+	container.ReadinessProbe.PeriodSeconds = 10
+	container.ReadinessProbe.TimeoutSeconds = 1
+	container.ReadinessProbe.FailureThreshold = 3
+}
@@ -0,0 +1,138 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testNamespace = "default"
+
+func createInstance(mutate func(*rhtasv1.RekorV2)) *rhtasv1.RekorV2 {
+	instance := &rhtasv1.RekorV2{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rekor",
+			Namespace: testNamespace,
+		},
+		Status: rhtasv1.RekorV2Status{
+			Origin:  "rekor.example.com",
+			PvcName: "rekor-v2-pvc",
+			PrivateKeyRef: &rhtasv1.SecretKeySelector{
+				LocalObjectReference: rhtasv1.LocalObjectReference{Name: "signer"},
+				Key:                  "private",
+			},
+		},
+	}
+	instance.Spec.SetDefaults()
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:   constants.ReadyCondition,
+		Status: metav1.ConditionFalse,
+		Reason: state.Creating.String(),
+	})
+	if mutate != nil {
+		mutate(instance)
+	}
+	return instance
+}
+
+func deploy(t *testing.T, instance *rhtasv1.RekorV2) (*apps.Deployment, error) {
+	t.Helper()
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance).
+		WithStatusSubresource(instance).
+		Build()
+
+	result := testAction.PrepareAction(c, NewDeployAction()).Handle(t.Context(), instance)
+	if result != nil && result.Err != nil {
+		return nil, result.Err
+	}
+
+	dp := &apps.Deployment{}
+	return dp, c.Get(t.Context(), client.ObjectKey{Name: DeploymentName, Namespace: testNamespace}, dp)
+}
+
+func TestDeploy_Posix(t *testing.T) {
+	g := NewWithT(t)
+
+	dp, err := deploy(t, createInstance(nil))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dp.Spec.Strategy.Type).To(Equal(apps.RecreateDeploymentStrategyType))
+
+	server := kubernetes.FindContainerByNameOrCreate(&dp.Spec.Template.Spec, ServerContainerName)
+	g.Expect(server.Args).To(ContainElements(
+		"serve",
+		"--hostname=rekor.example.com",
+		"--signer-filepath=/key/private",
+		"--posix-storage-dir="+storageMountPath,
+		"--checkpoint-interval=1s",
+	))
+	g.Expect(server.Args).ToNot(ContainElement(HavePrefix("--signer-password")))
+
+	tiles := kubernetes.FindContainerByNameOrCreate(&dp.Spec.Template.Spec, TilesContainerName)
+	g.Expect(tiles.VolumeMounts).To(ContainElement(core.VolumeMount{Name: storageVolumeName, MountPath: "/var/www/html", ReadOnly: true}))
+
+	volume := kubernetes.FindVolumeByNameOrCreate(&dp.Spec.Template.Spec, storageVolumeName)
+	g.Expect(volume.PersistentVolumeClaim.ClaimName).To(Equal("rekor-v2-pvc"))
+}
+
+func TestDeploy_S3(t *testing.T) {
+	g := NewWithT(t)
+
+	dp, err := deploy(t, createInstance(func(i *rhtasv1.RekorV2) {
+		i.Spec.Storage = rhtasv1.RekorV2Storage{
+			Type: rhtasv1.RekorV2StorageS3,
+			S3: &rhtasv1.RekorV2S3Storage{
+				Bucket:         "tiles",
+				Endpoint:       "https://minio.example.com",
+				CredentialsRef: &rhtasv1.LocalObjectReference{Name: "s3"},
+				DatabaseRef: rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: "mysql"},
+					Key:                  "dsn",
+				},
+			},
+		}
+		i.Status.PvcName = ""
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dp.Spec.Strategy.Type).To(Equal(apps.RollingUpdateDeploymentStrategyType))
+	g.Expect(dp.Spec.Template.Spec.Containers).To(HaveLen(1))
+
+	server := dp.Spec.Template.Spec.Containers[0]
+	g.Expect(server.Args).To(ContainElements("--aws-bucket=tiles", "--aws-mysql-dsn=$(MYSQL_DSN)"))
+	g.Expect(server.Env).To(ContainElement(core.EnvVar{Name: "AWS_ENDPOINT_URL_S3", Value: "https://minio.example.com"}))
+	g.Expect(kubernetes.FindEnvByNameOrCreate(&server, "MYSQL_DSN").ValueFrom.SecretKeyRef.Name).To(Equal("mysql"))
+	g.Expect(kubernetes.FindEnvByNameOrCreate(&server, "AWS_SECRET_ACCESS_KEY").ValueFrom.SecretKeyRef.Name).To(Equal("s3"))
+}
+
+func TestDeploy_Kms(t *testing.T) {
+	g := NewWithT(t)
+
+	dp, err := deploy(t, createInstance(func(i *rhtasv1.RekorV2) {
+		i.Spec.Signer.Kms = &rhtasv1.KMS{KeyResource: "awskms:///alias/rekor"}
+		i.Status.PrivateKeyRef = nil
+	}))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	server := kubernetes.FindContainerByNameOrCreate(&dp.Spec.Template.Spec, ServerContainerName)
+	g.Expect(server.Args).To(ContainElement("--signer-kmskey=awskms:///alias/rekor"))
+	g.Expect(server.Args).ToNot(ContainElement(HavePrefix("--signer-filepath")))
+}
+
+func TestDeploy_MissingOrigin(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := deploy(t, createInstance(func(i *rhtasv1.RekorV2) {
+		i.Status.Origin = ""
+	}))
+	g.Expect(err).To(MatchError(ErrOriginNotSpecified))
+}
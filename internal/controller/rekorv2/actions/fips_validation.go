@@ -0,0 +1,42 @@
+package actions
+
+import (
+	"context"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	fipsAction "github.com/securesign/operator/internal/action/fips"
+	fipsutil "github.com/securesign/operator/internal/utils/fips"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewFIPSValidationAction() action.Action[*rhtasv1.RekorV2] {
+	return fipsAction.NewAction(
+		fipsutil.FIPSCondition,
+		ComponentName,
+		fipsAction.Wrapper(fipsAction.Config[*rhtasv1.RekorV2]{
+			PasswordRef: func(i *rhtasv1.RekorV2) *rhtasv1.SecretKeySelector {
+				if i.Spec.Signer.KeyRef != nil {
+					return i.Spec.Signer.PasswordRef
+				}
+				return nil
+			},
+			CryptoMaterial: func(ctx context.Context, i *rhtasv1.RekorV2, c client.Client) ([]fipsAction.CryptoRef, error) {
+				var refs []fipsAction.CryptoRef
+				// the private key of a KMS signer never leaves the KMS
+				if i.Spec.Signer.Kms == nil {
+					if err := fipsAction.AppendSecretRef(ctx, c, i.Namespace, i.Spec.Signer.KeyRef,
+						"spec.signer.keyRef", fipsutil.ValidatePrivateKeyPEM, &refs); err != nil {
+						return nil, err
+					}
+					fipsAction.AppendKeyAlgorithm(i.Spec.Signer.KeyAlgorithm, "spec.signer.keyAlgorithm", &refs)
+				}
+				if err := fipsAction.AppendSecretRef(ctx, c, i.Namespace, i.Spec.Signer.PublicKeyRef,
+					"spec.signer.publicKeyRef", fipsutil.ValidatePublicKeyPEM, &refs); err != nil {
+					return nil, err
+				}
+				return refs, nil
+			},
+		}),
+	)
+}
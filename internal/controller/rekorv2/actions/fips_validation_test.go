@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	"github.com/securesign/operator/internal/utils/fips"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestFIPSValidation(t *testing.T) {
+	original := fips.Enabled
+	fips.Enabled = func() bool { return true }
+	t.Cleanup(func() { fips.Enabled = original })
+
+	tests := []struct {
+		name   string
+		mutate func(*rhtasv1.RekorV2)
+		valid  bool
+	}{
+		{
+			name:  "generated ecdsa signer",
+			valid: true,
+		},
+		{
+			name: "generated ed25519 signer",
+			mutate: func(i *rhtasv1.RekorV2) {
+				i.Spec.Signer.KeyAlgorithm = rhtasv1.KeyAlgorithmEd25519
+			},
+			valid: true,
+		},
+		{
+			name: "signer key on a non-approved curve",
+			mutate: func(i *rhtasv1.RekorV2) {
+				i.Spec.Signer.KeyRef = &rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: "signer"},
+					Key:                  "private",
+				}
+			},
+		},
+		{
+			name: "encrypted signer key",
+			mutate: func(i *rhtasv1.RekorV2) {
+				i.Spec.Signer.KeyRef = &rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: "signer"},
+					Key:                  "private",
+				}
+				i.Spec.Signer.PasswordRef = &rhtasv1.SecretKeySelector{
+					LocalObjectReference: rhtasv1.LocalObjectReference{Name: "signer"},
+					Key:                  "password",
+				}
+			},
+		},
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "signer", Namespace: testNamespace},
+		Data: map[string][]byte{
+			"private": pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			instance := createInstance(tt.mutate)
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance, secret.DeepCopy()).
+				WithStatusSubresource(instance).
+				Build()
+
+			a := testAction.PrepareAction(c, NewFIPSValidationAction())
+			g.Expect(a.CanHandle(t.Context(), instance)).To(BeTrue())
+			result := a.Handle(t.Context(), instance)
+
+			cond := meta.FindStatusCondition(instance.Status.Conditions, fips.FIPSCondition)
+			g.Expect(cond).ToNot(BeNil())
+			if tt.valid {
+				g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				return
+			}
+			g.Expect(result.Err).To(HaveOccurred())
+			g.Expect(errors.Is(result.Err, reconcile.TerminalError(result.Err))).To(BeTrue())
+			g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(meta.FindStatusCondition(instance.Status.Conditions, constants.ReadyCondition).Status).
+				To(Equal(metav1.ConditionFalse))
+		})
+	}
+}
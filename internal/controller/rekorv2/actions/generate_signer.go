@@ -0,0 +1,93 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/generateSigner"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/utils/keys"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const signerSecretNameFormat = "rekor-v2-signer-%s"
+
+func NewGenerateSignerAction() action.Action[*rhtasv1.RekorV2] {
+	return generateSigner.NewAction(
+		SignerCondition,
+		signerSecretNameFormat,
+		ComponentName,
+		DeploymentName,
+		generateSigner.Wrapper(generateSigner.Config[*rhtasv1.RekorV2]{
+			ResolveRef:   resolveRef,
+			GenerateData: generateData,
+			AlignStatus:  alignStatus,
+			IsEnabled:    isEnabled,
+		}),
+	)
+}
+
+func isEnabled(instance *rhtasv1.RekorV2) bool {
+	return instance.Spec.Signer.Kms == nil
+}
+
+func resolveRef(ctx context.Context, instance *rhtasv1.RekorV2, c client.Client) (*rhtasv1.SecretKeySelector, error) {
+	if instance.Spec.Signer.KeyRef != nil {
+		if err := generateSigner.RequireSecret(ctx, c, instance.Namespace, instance.Spec.Signer.KeyRef); err != nil {
+			return nil, err
+		}
+		return instance.Spec.Signer.KeyRef, nil
+	}
+	return generateSigner.ResolveStatusSecret(ctx, c, instance.Status.PrivateKeyRef, instance.Namespace, fmt.Sprintf(signerSecretNameFormat, instance.Name))
+}
+
+func generateData(_ context.Context, instance *rhtasv1.RekorV2, _ client.Client) (map[string][]byte, error) {
+	key, err := keys.Generate(keyAlgorithm(instance))
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := keys.MarshalPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := keys.MarshalPublicKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		constants.KeyPrivate: privateKey,
+		constants.KeyPublic:  publicKey,
+	}, nil
+}
+
+func alignStatus(instance *rhtasv1.RekorV2, ref rhtasv1.SecretKeySelector) {
+	signer := instance.Spec.Signer
+	if signer.KeyRef != nil {
+		instance.Status.PrivateKeyRef = signer.KeyRef.DeepCopy()
+		instance.Status.PasswordRef = signer.PasswordRef.DeepCopy()
+	} else {
+		instance.Status.PrivateKeyRef = &rhtasv1.SecretKeySelector{
+			Key:                  constants.KeyPrivate,
+			LocalObjectReference: ref.LocalObjectReference,
+		}
+		instance.Status.PasswordRef = nil
+	}
+
+	if signer.PublicKeyRef != nil {
+		instance.Status.PublicKeyRef = signer.PublicKeyRef.DeepCopy()
+	} else {
+		instance.Status.PublicKeyRef = &rhtasv1.SecretKeySelector{
+			Key:                  constants.KeyPublic,
+			LocalObjectReference: instance.Status.PrivateKeyRef.LocalObjectReference,
+		}
+	}
+}
+
+// keyAlgorithm returns the algorithm of the generated signer key.
+func keyAlgorithm(instance *rhtasv1.RekorV2) rhtasv1.KeyAlgorithm {
+	signer := instance.Spec.Signer.DeepCopy()
+	signer.SetDefaults()
+	return signer.KeyAlgorithm
+}
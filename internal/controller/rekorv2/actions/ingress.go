@@ -0,0 +1,114 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// apiPath is the prefix of the Rekor v2 write API, the rest of the host serves the tiles.
+const apiPath = "/api/v2"
+
+func NewIngressAction() action.Action[*rhtasv1.RekorV2] {
+	return &ingressAction{}
+}
+
+type ingressAction struct {
+	action.BaseAction
+}
+
+func (i ingressAction) Name() string {
+	return "ingress"
+}
+
+func (i ingressAction) CanHandle(_ context.Context, instance *rhtasv1.RekorV2) bool {
+	return utils.IsEnabled(instance.Spec.Ingress.Enabled) && state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1.RekorV2) *action.Result {
+	var (
+		result controllerutil.OperationResult
+		err    error
+	)
+	ok := types.NamespacedName{Name: DeploymentName, Namespace: instance.Namespace}
+	labels := labels.For(ComponentName, DeploymentName, instance.Name)
+
+	svc := &v1.Service{}
+	if err := i.Client.Get(ctx, ok, svc); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not find service for ingress: %w", err), instance)
+	}
+
+	port := ServerPortName
+	if posixStorage(instance) {
+		port = TilesPortName
+	}
+
+	if result, err = kubernetes.CreateOrUpdate(ctx, i.Client,
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: svc.Name, Namespace: svc.Namespace},
+		},
+		kubernetes.EnsureIngressSpec(ctx, i.Client, *svc, instance.Spec.Ingress, port),
+		ensure.Optional(posixStorage(instance), ensureAPIPath(svc.Name)),
+		ensure.Optional(kubernetes.IsOpenShift(), kubernetes.EnsureIngressTLS()),
+		// add ingress labels
+		ensure.Labels[*networkingv1.Ingress](slices.Collect(maps.Keys(instance.Spec.Ingress.Labels)), instance.Spec.Ingress.Labels),
+		// add common labels
+		ensure.Labels[*networkingv1.Ingress](slices.Collect(maps.Keys(labels)), labels),
+		ensure.ControllerReference[*networkingv1.Ingress](instance, i.Client),
+	); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not create ingress object: %w", err), instance)
+	}
+
+	if result != controllerutil.OperationResultNone {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    ServerCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Creating.String(),
+			Message: "Ingress created",
+		})
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+	return i.Continue()
+}
+
+// ensureAPIPath routes the write API to the server when the tiles are served from the same host.
+func ensureAPIPath(svcName string) func(*networkingv1.Ingress) error {
+	return func(ingress *networkingv1.Ingress) error {
+		path := networkingv1.PathTypePrefix
+		for idx := range ingress.Spec.Rules {
+			rule := &ingress.Spec.Rules[idx]
+			if rule.HTTP == nil {
+				continue
+			}
+			rule.HTTP.Paths = append([]networkingv1.HTTPIngressPath{
+				{
+					Path:     apiPath,
+					PathType: &path,
+					Backend: networkingv1.IngressBackend{
+						Service: &networkingv1.IngressServiceBackend{
+							Name: svcName,
+							Port: networkingv1.ServiceBackendPort{Name: ServerPortName},
+						},
+					},
+				},
+			}, rule.HTTP.Paths...)
+		}
+		return nil
+	}
+}
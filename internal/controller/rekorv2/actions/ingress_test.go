@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	core "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestIngress_Paths(t *testing.T) {
+	tests := []struct {
+		name     string
+		storage  rhtasv1.RekorV2Storage
+		expected map[string]string
+	}{
+		{
+			name:     "posix serves the tiles from the same host",
+			storage:  rhtasv1.RekorV2Storage{Type: rhtasv1.RekorV2StoragePOSIX},
+			expected: map[string]string{apiPath: ServerPortName, "/": TilesPortName},
+		},
+		{
+			name:     "s3 serves the api only",
+			storage:  rhtasv1.RekorV2Storage{Type: rhtasv1.RekorV2StorageS3, S3: &rhtasv1.RekorV2S3Storage{Bucket: "tiles"}},
+			expected: map[string]string{"/": ServerPortName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			instance := createInstance(func(i *rhtasv1.RekorV2) {
+				i.Spec.Storage = tt.storage
+				i.Spec.Ingress.Enabled = ptr.To(true)
+				i.Spec.Ingress.Host = "rekor.example.com"
+			})
+			svc := &core.Service{ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: testNamespace}}
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance, svc).
+				WithStatusSubresource(instance).
+				Build()
+
+			result := testAction.PrepareAction(c, NewIngressAction()).Handle(t.Context(), instance)
+			g.Expect(result).ToNot(BeNil())
+			g.Expect(result.Err).ToNot(HaveOccurred())
+
+			ingress := &networkingv1.Ingress{}
+			g.Expect(c.Get(t.Context(), client.ObjectKey{Name: DeploymentName, Namespace: testNamespace}, ingress)).To(Succeed())
+			g.Expect(ingress.Spec.Rules).To(HaveLen(1))
+			g.Expect(ingress.Spec.Rules[0].Host).To(Equal("rekor.example.com"))
+
+			paths := map[string]string{}
+			for _, p := range ingress.Spec.Rules[0].HTTP.Paths {
+				paths[p.Path] = p.Backend.Service.Port.Name
+			}
+			g.Expect(paths).To(Equal(tt.expected))
+			// the more specific API path must precede the catch-all
+			g.Expect(ingress.Spec.Rules[0].HTTP.Paths[len(paths)-1].Path).To(Equal("/"))
+		})
+	}
+}
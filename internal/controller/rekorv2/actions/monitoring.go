@@ -0,0 +1,26 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/monitoring"
+	"github.com/securesign/operator/internal/utils"
+)
+
+type rekorV2MonitoringConfig struct{}
+
+func (rekorV2MonitoringConfig) IsEnabled(i *rhtasv1.RekorV2) bool {
+	return utils.IsEnabled(i.Spec.Monitoring.ServiceMonitor.Enabled)
+}
+
+func (rekorV2MonitoringConfig) TLS(_ *rhtasv1.RekorV2) rhtasv1.TLS { return rhtasv1.TLS{} }
+
+func NewCreateMonitorAction() action.Action[*rhtasv1.RekorV2] {
+	return monitoring.NewAction(
+		ComponentName,
+		MonitoringRoleName,
+		DeploymentName,
+		ServerCondition,
+		rekorV2MonitoringConfig{},
+	)
+}
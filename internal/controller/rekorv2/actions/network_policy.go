@@ -0,0 +1,37 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type rekorV2NetworkPolicyConfig struct{}
+
+func (rekorV2NetworkPolicyConfig) IsEnabled(i *rhtasv1.RekorV2) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (rekorV2NetworkPolicyConfig) Ingress(i *rhtasv1.RekorV2) []networkingv1.NetworkPolicyIngressRule {
+	ports := []int32{ServerTargetPort, GrpcPort}
+	if posixStorage(i) {
+		ports = append(ports, TilesPort)
+	}
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule(ports,
+			networkpolicy.FromIngressControllers(),
+			networkpolicy.FromOperator(),
+		),
+		networkpolicy.Rule([]int32{MetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.RekorV2] {
+	return networkpolicy.NewAction(
+		ComponentName,
+		DeploymentName,
+		rekorV2NetworkPolicyConfig{},
+	)
+}
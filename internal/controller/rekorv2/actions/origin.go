@@ -0,0 +1,53 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+)
+
+func NewOriginAction() action.Action[*rhtasv1.RekorV2] {
+	return &originAction{}
+}
+
+// originAction resolves the checkpoint origin of the log. The origin is signed in every checkpoint,
+// so it is resolved once and kept in the status even if the hostname or the ingress change later.
+type originAction struct {
+	action.BaseAction
+}
+
+func (i originAction) Name() string {
+	return "resolve origin"
+}
+
+func (i originAction) CanHandle(_ context.Context, instance *rhtasv1.RekorV2) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Pending && instance.Status.Origin == ""
+}
+
+func (i originAction) Handle(ctx context.Context, instance *rhtasv1.RekorV2) *action.Result {
+	origin, err := i.resolve(ctx, instance)
+	if err != nil {
+		return i.Error(ctx, fmt.Errorf("could not resolve checkpoint origin: %w", err), instance)
+	}
+	instance.Status.Origin = origin
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
+
+func (i originAction) resolve(ctx context.Context, instance *rhtasv1.RekorV2) (string, error) {
+	switch {
+	case instance.Spec.Hostname != "":
+		return instance.Spec.Hostname, nil
+	case instance.Spec.Ingress.Host != "":
+		return instance.Spec.Ingress.Host, nil
+	case utils.IsEnabled(instance.Spec.Ingress.Enabled):
+		return kubernetes.CalculateHostname(ctx, i.Client, DeploymentName, instance.Namespace)
+	default:
+		return fmt.Sprintf("%s.%s.svc", DeploymentName, instance.Namespace), nil
+	}
+}
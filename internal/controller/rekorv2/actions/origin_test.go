@@ -0,0 +1,63 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	"k8s.io/utils/ptr"
+)
+
+func TestOrigin(t *testing.T) {
+	tests := []struct {
+		name     string
+		mutate   func(*rhtasv1.RekorV2)
+		expected string
+	}{
+		{
+			name: "hostname",
+			mutate: func(i *rhtasv1.RekorV2) {
+				i.Spec.Hostname = "log.example.com"
+				i.Spec.Ingress.Host = "rekor.example.com"
+			},
+			expected: "log.example.com",
+		},
+		{
+			name: "ingress host",
+			mutate: func(i *rhtasv1.RekorV2) {
+				i.Spec.Ingress.Enabled = ptr.To(true)
+				i.Spec.Ingress.Host = "rekor.example.com"
+			},
+			expected: "rekor.example.com",
+		},
+		{
+			name: "service hostname",
+			mutate: func(i *rhtasv1.RekorV2) {
+				i.Spec.Ingress.Enabled = ptr.To(false)
+			},
+			expected: "rekor-v2-server.default.svc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			instance := createInstance(func(i *rhtasv1.RekorV2) {
+				i.Status.Origin = ""
+				tt.mutate(i)
+			})
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance).
+				WithStatusSubresource(instance).
+				Build()
+
+			a := testAction.PrepareAction(c, NewOriginAction())
+			g.Expect(a.CanHandle(t.Context(), instance)).To(BeTrue())
+			result := a.Handle(t.Context(), instance)
+			g.Expect(result).ToNot(BeNil())
+			g.Expect(result.Err).ToNot(HaveOccurred())
+			g.Expect(instance.Status.Origin).To(Equal(tt.expected))
+			g.Expect(a.CanHandle(t.Context(), instance)).To(BeFalse())
+		})
+	}
+}
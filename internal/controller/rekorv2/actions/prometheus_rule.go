@@ -0,0 +1,55 @@
+package actions
+
+import (
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/alerting"
+)
+
+type rekorV2AlertingConfig struct{}
+
+func (rekorV2AlertingConfig) PrometheusRule(i *rhtasv1.RekorV2) rhtasv1.PrometheusRuleConfig {
+	return i.Spec.Monitoring.PrometheusRule
+}
+
+func (rekorV2AlertingConfig) Rules(_ *rhtasv1.RekorV2) []alerting.Rule {
+	return []alerting.Rule{
+		{
+			Alert:       "RekorV2High5xxRate",
+			Threshold:   "0.05",
+			For:         "10m",
+			Severity:    alerting.SeverityCritical,
+			Summary:     "Rekor v2 server returns server errors",
+			Description: "{{ $value | humanizePercentage }} of the Rekor v2 API requests fail with a 5xx status code.",
+			Expr: func(namespace, threshold string) string {
+				selector := fmt.Sprintf(`namespace=%q,service=%q`, namespace, DeploymentName)
+				return fmt.Sprintf(`sum(rate(rekor_v2_http_requests_total{%s,code=~"5.."}[5m])) / sum(rate(rekor_v2_http_requests_total{%s}[5m])) > %s`,
+					selector, selector, threshold)
+			},
+		},
+		{
+			Alert:       "RekorV2InclusionProofFailure",
+			Threshold:   "0",
+			For:         "5m",
+			Severity:    alerting.SeverityCritical,
+			Summary:     "Rekor v2 fails to build inclusion proofs",
+			Description: "Rekor v2 failed to build inclusion proofs for new entries, the log storage may be inconsistent.",
+			Expr: func(namespace, threshold string) string {
+				return fmt.Sprintf(`sum(increase(rekor_v2_inclusion_proof_failure_total{namespace=%q,service=%q}[5m])) > %s`,
+					namespace, DeploymentName, threshold)
+			},
+		},
+	}
+}
+
+func NewPrometheusRuleAction() action.Action[*rhtasv1.RekorV2] {
+	return alerting.NewAction(
+		ComponentName,
+		MonitoringRoleName,
+		DeploymentName,
+		ServerCondition,
+		rekorV2AlertingConfig{},
+	)
+}
@@ -0,0 +1,35 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/pvc"
+)
+
+const PvcNameFormat = "rekor-v2-%s-pvc"
+
+func NewCreatePvcAction() action.Action[*rhtasv1.RekorV2] {
+	wrapper := pvc.Wrapper[*rhtasv1.RekorV2](
+		func(r *rhtasv1.RekorV2) rhtasv1.Pvc {
+			return r.Spec.Storage.Pvc
+		},
+		func(r *rhtasv1.RekorV2) string {
+			return r.Status.PvcName
+		},
+		func(r *rhtasv1.RekorV2, s string) {
+			r.Status.PvcName = s
+		},
+		posixStorage,
+	)
+
+	return pvc.NewAction[*rhtasv1.RekorV2](
+		PvcNameFormat,
+		ComponentName,
+		DeploymentName,
+		wrapper,
+	)
+}
+
+func posixStorage(instance *rhtasv1.RekorV2) bool {
+	return instance.Spec.Storage.Type == rhtasv1.RekorV2StoragePOSIX || instance.Spec.Storage.Type == ""
+}
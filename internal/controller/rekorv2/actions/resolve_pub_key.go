@@ -0,0 +1,55 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/trustmaterial"
+	"github.com/securesign/operator/internal/state"
+	k8sutils "github.com/securesign/operator/internal/utils/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	ErrPublicKeyRefNotSet = errors.New("PublicKeyRef not set")
+	ErrSecretRead         = errors.New("failed to read public key from secret")
+)
+
+type rekorV2TrustMaterialResolver struct{}
+
+func (r rekorV2TrustMaterialResolver) ComponentName() string { return ComponentName }
+
+func (r rekorV2TrustMaterialResolver) CanHandle(_ context.Context, instance *rhtasv1.RekorV2) bool {
+	return state.FromInstance(instance, ServerCondition) >= state.Initialize
+}
+
+func (r rekorV2TrustMaterialResolver) GetTrustMaterial(instance *rhtasv1.RekorV2) string {
+	return instance.Status.PublicKey
+}
+
+func (r rekorV2TrustMaterialResolver) SetTrustMaterial(instance *rhtasv1.RekorV2, pem string) {
+	instance.Status.PublicKey = pem
+}
+
+// Resolve reads the public key from the secret because Rekor v2 does not publish it over HTTP.
+func (r rekorV2TrustMaterialResolver) Resolve(ctx context.Context, cli client.Client, instance *rhtasv1.RekorV2) ([]byte, error) {
+	ref := instance.Spec.Signer.PublicKeyRef
+	if ref == nil {
+		ref = instance.Status.PublicKeyRef
+	}
+	if ref == nil {
+		return nil, fmt.Errorf("%w: rekor-v2", ErrPublicKeyRefNotSet)
+	}
+	data, err := k8sutils.GetSecretData(ctx, cli, instance.Namespace, ref)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrSecretRead, ref.Name, err)
+	}
+	return data, nil
+}
+
+func NewResolvePubKeyAction() action.Action[*rhtasv1.RekorV2] {
+	return trustmaterial.NewAction[*rhtasv1.RekorV2](rekorV2TrustMaterialResolver{})
+}
@@ -0,0 +1,16 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/deploymentRollout"
+)
+
+func NewRolloutCheckAction() action.Action[*rhtasv1.RekorV2] {
+	return deploymentRollout.NewAction(deploymentRollout.Config[*rhtasv1.RekorV2]{
+		Name:             "server rollout check",
+		ConditionType:    ServerCondition,
+		DeploymentName:   DeploymentName,
+		PromoteOnSuccess: true,
+	})
+}
@@ -0,0 +1,100 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewCreateServiceAction() action.Action[*rhtasv1.RekorV2] {
+	return &createServiceAction{}
+}
+
+type createServiceAction struct {
+	action.BaseAction
+}
+
+func (i createServiceAction) Name() string {
+	return "create service"
+}
+
+func (i createServiceAction) CanHandle(_ context.Context, instance *rhtasv1.RekorV2) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1.RekorV2) *action.Result {
+	var (
+		err    error
+		result controllerutil.OperationResult
+	)
+
+	labels := labels.For(ComponentName, DeploymentName, instance.Name)
+
+	ports := []v1.ServicePort{
+		{
+			Name:       ServerPortName,
+			Protocol:   v1.ProtocolTCP,
+			Port:       ServerPort,
+			TargetPort: intstr.FromInt32(ServerTargetPort),
+		},
+		{
+			Name:       GrpcPortName,
+			Protocol:   v1.ProtocolTCP,
+			Port:       GrpcPort,
+			TargetPort: intstr.FromInt32(GrpcPort),
+		},
+	}
+	if posixStorage(instance) {
+		ports = append(ports, v1.ServicePort{
+			Name:       TilesPortName,
+			Protocol:   v1.ProtocolTCP,
+			Port:       TilesPort,
+			TargetPort: intstr.FromInt32(TilesPort),
+		})
+	}
+	if utils.IsEnabled(instance.Spec.Monitoring.Metrics.Enabled) {
+		ports = append(ports, v1.ServicePort{
+			Name:       MetricsPortName,
+			Protocol:   v1.ProtocolTCP,
+			Port:       MetricsPort,
+			TargetPort: intstr.FromInt32(MetricsPort),
+		})
+	}
+
+	if result, err = kubernetes.CreateOrUpdate(ctx, i.Client,
+		&v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: instance.Namespace},
+		},
+		kubernetes.EnsureServiceSpec(labels, ports...),
+		ensure.ControllerReference[*v1.Service](instance, i.Client),
+		ensure.Labels[*v1.Service](slices.Collect(maps.Keys(labels)), labels),
+	); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not create service: %w", err), instance)
+	}
+
+	if result != controllerutil.OperationResultNone {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    ServerCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Creating.String(),
+			Message: "Service created",
+		})
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+	return i.Continue()
+}
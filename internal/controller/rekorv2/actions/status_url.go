@@ -0,0 +1,90 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/serviceresolver"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func NewStatusUrlAction() action.Action[*rhtasv1.RekorV2] {
+	return &statusUrlAction{}
+}
+
+type statusUrlAction struct {
+	action.BaseAction
+}
+
+func (i statusUrlAction) Name() string {
+	return "status url"
+}
+
+func (i statusUrlAction) CanHandle(_ context.Context, instance *rhtasv1.RekorV2) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating
+}
+
+func (i statusUrlAction) Handle(ctx context.Context, instance *rhtasv1.RekorV2) *action.Result {
+	var (
+		serverUrl, tilesUrl string
+		err                 error
+	)
+	if utils.IsEnabled(instance.Spec.Ingress.Enabled) {
+		protocol := "http://"
+		ingress := &networkingv1.Ingress{}
+		err = i.Client.Get(ctx, types.NamespacedName{Name: DeploymentName, Namespace: instance.Namespace}, ingress)
+		if err != nil {
+			return i.Error(ctx, err, instance)
+		}
+		if len(ingress.Spec.TLS) > 0 {
+			protocol = "https://"
+		}
+		serverUrl = protocol + ingress.Spec.Rules[0].Host
+		tilesUrl = serverUrl
+	} else {
+		serverUrl, err = serviceresolver.Resolve(instance)
+		if err != nil {
+			return i.Error(ctx, fmt.Errorf("error resolving internal URL: %w", err), instance)
+		}
+		tilesUrl = fmt.Sprintf("http://%s.%s.svc:%d", DeploymentName, instance.Namespace, TilesPort)
+	}
+
+	if !posixStorage(instance) {
+		if tilesUrl, err = bucketUrl(instance.Spec.Storage.S3); err != nil {
+			return i.Error(ctx, fmt.Errorf("error resolving tiles URL: %w", err), instance)
+		}
+	}
+
+	if serverUrl == instance.Status.Url && tilesUrl == instance.Status.TilesUrl {
+		return i.Continue()
+	}
+
+	instance.Status.Url = serverUrl
+	instance.Status.TilesUrl = tilesUrl
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
+
+// bucketUrl returns the public URL of the tiles stored in the s3 bucket.
+func bucketUrl(s3 *rhtasv1.RekorV2S3Storage) (string, error) {
+	switch {
+	case s3 == nil:
+		return "", ErrS3NotSpecified
+	case s3.TilesURL != "":
+		return s3.TilesURL, nil
+	case s3.Endpoint != "":
+		// S3-compatible services commonly use path-style addressing
+		return url.JoinPath(s3.Endpoint, s3.Bucket)
+	case s3.Region != "":
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", s3.Bucket, strings.ToLower(s3.Region)), nil
+	default:
+		return fmt.Sprintf("https://%s.s3.amazonaws.com", s3.Bucket), nil
+	}
+}
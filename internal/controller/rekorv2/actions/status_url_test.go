@@ -0,0 +1,45 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+)
+
+func TestBucketUrl(t *testing.T) {
+	tests := []struct {
+		name     string
+		s3       *rhtasv1.RekorV2S3Storage
+		expected string
+	}{
+		{
+			name:     "explicit tiles url",
+			s3:       &rhtasv1.RekorV2S3Storage{Bucket: "tiles", Endpoint: "https://minio.example.com", TilesURL: "https://cdn.example.com"},
+			expected: "https://cdn.example.com",
+		},
+		{
+			name:     "s3-compatible endpoint",
+			s3:       &rhtasv1.RekorV2S3Storage{Bucket: "tiles", Endpoint: "https://minio.example.com/"},
+			expected: "https://minio.example.com/tiles",
+		},
+		{
+			name:     "aws region",
+			s3:       &rhtasv1.RekorV2S3Storage{Bucket: "tiles", Region: "eu-west-1"},
+			expected: "https://tiles.s3.eu-west-1.amazonaws.com",
+		},
+		{
+			name:     "aws default",
+			s3:       &rhtasv1.RekorV2S3Storage{Bucket: "tiles"},
+			expected: "https://tiles.s3.amazonaws.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			url, err := bucketUrl(tt.s3)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(url).To(Equal(tt.expected))
+		})
+	}
+}
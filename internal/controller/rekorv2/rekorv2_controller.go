@@ -0,0 +1,169 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rekorv2
+
+import (
+	"context"
+	"time"
+
+	olpredicate "github.com/operator-framework/operator-lib/predicate"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/transitions"
+	"github.com/securesign/operator/internal/action/trustmaterial"
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/controller"
+	"github.com/securesign/operator/internal/controller/predicate"
+	"github.com/securesign/operator/internal/controller/rekorv2/actions"
+	_ "github.com/securesign/operator/internal/controller/rekorv2/serviceresolver"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
+	fipsutil "github.com/securesign/operator/internal/utils/fips"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// rekorV2Reconciler reconciles a RekorV2 object
+type rekorV2Reconciler struct {
+	client.Client
+	scheme   *runtime.Scheme
+	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
+}
+
+func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
+	return &rekorV2Reconciler{
+		Client:   c,
+		scheme:   scheme,
+		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
+	}
+}
+
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=rekorv2s,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=rekorv2s/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=rekorv2s/finalizers,verbs=update
+
+// Reconcile moves the Rekor v2 deployment closer to the state specified by the RekorV2 object.
+func (r *rekorV2Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var instance rhtasv1.RekorV2
+	log := ctrllog.FromContext(ctx)
+
+	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Check if the namespace is marked for deletion
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating {
+		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
+		return ctrl.Result{}, nil
+	}
+
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
+	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
+	conditionSupplier := func(instance *rhtasv1.RekorV2) []string {
+		components := []string{actions.ServerCondition, trustmaterial.TrustMaterialCondition}
+		if instance.Spec.Signer.Kms == nil {
+			components = append(components, actions.SignerCondition)
+		}
+		return fipsutil.AppendFIPSCondition(components)
+	}
+	acs := []action.Action[*rhtasv1.RekorV2]{
+		transitions.NewToPendingPhaseAction[*rhtasv1.RekorV2](),
+		transitions.NewEnsureConditionsAction[*rhtasv1.RekorV2](conditionSupplier),
+		actions.NewFIPSValidationAction(),
+
+		actions.NewGenerateSignerAction(),
+		actions.NewOriginAction(),
+
+		transitions.NewToCreatePhaseAction[*rhtasv1.RekorV2](),
+
+		actions.NewRBACAction(),
+		actions.NewCreatePvcAction(),
+		actions.NewDeployAction(),
+		actions.NewCreateServiceAction(),
+		actions.NewCreateMonitorAction(),
+		actions.NewPrometheusRuleAction(),
+		actions.NewIngressAction(),
+		actions.NewNetworkPolicyAction(),
+		actions.NewStatusUrlAction(),
+
+		transitions.NewToInitializePhaseAction[*rhtasv1.RekorV2](),
+
+		actions.NewRolloutCheckAction(),
+		actions.NewResolvePubKeyAction(),
+
+		transitions.NewToReadyPhaseAction[*rhtasv1.RekorV2](),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), acs)
+	}
+
+	for _, a := range acs {
+		a.InjectClient(r.Client)
+		a.InjectLogger(log.WithName(a.Name()))
+		a.InjectRecorder(r.recorder)
+
+		if a.CanHandle(ctx, target) {
+			log.V(2).Info("Executing " + a.Name())
+			start := time.Now()
+			result := a.Handle(ctx, target)
+			metrics.ObserveAction("rekorv2", a.Name(), start, result)
+			if result != nil {
+				return result.Result, result.Err
+			}
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *rekorV2Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Filter out with the pause annotation.
+	pause, err := olpredicate.NewPause[client.Object](annotations.PausedReconciliation)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithEventFilter(pause).
+		For(&rhtasv1.RekorV2{}, builder.WithPredicates(predicate.ConfigurationChangedOnFailurePredicate[*rhtasv1.RekorV2]())).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.Service{}).
+		Owns(&networkingv1.Ingress{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Complete(r)
+}
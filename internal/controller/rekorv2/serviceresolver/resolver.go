@@ -0,0 +1,16 @@
+package serviceresolver
+
+import (
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/controller/rekorv2/actions"
+	"github.com/securesign/operator/internal/serviceresolver"
+)
+
+func init() {
+	serviceresolver.Register(
+		func(obj *rhtasv1.RekorV2) (string, error) {
+			return fmt.Sprintf("http://%s.%s.svc", actions.DeploymentName, obj.Namespace), nil
+		})
+}
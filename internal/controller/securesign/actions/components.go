@@ -0,0 +1,189 @@
+package actions
+
+import (
+	"context"
+	"reflect"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Component describes a child resource created by the Securesign and how its state maps to the Securesign status.
+type Component struct {
+	// Kind of the child resource.
+	Kind string
+	// Condition is the Securesign condition type reflecting the child Ready condition.
+	Condition string
+	// New returns an empty child object.
+	New func() apis.ConditionsAwareObject
+	// Defined reports whether the Securesign spec requests the child.
+	Defined func(*rhtasv1.Securesign) bool
+	// URL returns the service endpoint of the child.
+	URL func(apis.ConditionsAwareObject) string
+	// SetURL copies the child endpoints to the Securesign status.
+	SetURL func(apis.ConditionsAwareObject, *rhtasv1.SecuresignStatus)
+}
+
+func always(*rhtasv1.Securesign) bool {
+	return true
+}
+
+// Components lists the children of the Securesign in the order of their reconciliation.
+var Components = []Component{
+	{
+		Kind:      "Trillian",
+		Condition: TrillianCondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.Trillian{} },
+		Defined:   always,
+		URL:       func(apis.ConditionsAwareObject) string { return "" },
+		SetURL:    func(apis.ConditionsAwareObject, *rhtasv1.SecuresignStatus) {},
+	},
+	{
+		Kind:      "Fulcio",
+		Condition: FulcioCondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.Fulcio{} },
+		Defined:   always,
+		URL:       func(o apis.ConditionsAwareObject) string { return o.(*rhtasv1.Fulcio).Status.Url },
+		SetURL: func(o apis.ConditionsAwareObject, status *rhtasv1.SecuresignStatus) {
+			status.FulcioStatus.Url = o.(*rhtasv1.Fulcio).Status.Url
+			status.FulcioStatus.GrpcUrl = o.(*rhtasv1.Fulcio).Status.GrpcUrl
+		},
+	},
+	{
+		Kind:      "Rekor",
+		Condition: RekorCondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.Rekor{} },
+		Defined: func(instance *rhtasv1.Securesign) bool {
+			return instance.Spec.RekorVersion != rhtasv1.RekorVersionV2
+		},
+		URL: func(o apis.ConditionsAwareObject) string { return o.(*rhtasv1.Rekor).Status.Url },
+		SetURL: func(o apis.ConditionsAwareObject, status *rhtasv1.SecuresignStatus) {
+			status.RekorStatus.Url = o.(*rhtasv1.Rekor).Status.Url
+		},
+	},
+	{
+		Kind:      "RekorV2",
+		Condition: RekorCondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.RekorV2{} },
+		Defined: func(instance *rhtasv1.Securesign) bool {
+			return instance.Spec.RekorVersion == rhtasv1.RekorVersionV2
+		},
+		URL: func(o apis.ConditionsAwareObject) string { return o.(*rhtasv1.RekorV2).Status.Url },
+		SetURL: func(o apis.ConditionsAwareObject, status *rhtasv1.SecuresignStatus) {
+			// the Rekor endpoint is shared with the Rekor component, keep it when Rekor v2 is not defined
+			if url := o.(*rhtasv1.RekorV2).Status.Url; url != "" {
+				status.RekorStatus.Url = url
+			}
+		},
+	},
+	{
+		Kind:      "CTlog",
+		Condition: CTlogCondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.CTlog{} },
+		Defined:   always,
+		URL:       func(o apis.ConditionsAwareObject) string { return o.(*rhtasv1.CTlog).Status.Url },
+		SetURL:    func(apis.ConditionsAwareObject, *rhtasv1.SecuresignStatus) {},
+	},
+	{
+		Kind:      "Tuf",
+		Condition: TufCondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.Tuf{} },
+		Defined:   always,
+		URL:       func(o apis.ConditionsAwareObject) string { return o.(*rhtasv1.Tuf).Status.Url },
+		SetURL: func(o apis.ConditionsAwareObject, status *rhtasv1.SecuresignStatus) {
+			status.TufStatus.Url = o.(*rhtasv1.Tuf).Status.Url
+		},
+	},
+	{
+		Kind:      "TimestampAuthority",
+		Condition: TSACondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.TimestampAuthority{} },
+		Defined: func(instance *rhtasv1.Securesign) bool {
+			return !reflect.ValueOf(instance.Spec.TimestampAuthority).IsZero()
+		},
+		URL: func(o apis.ConditionsAwareObject) string { return o.(*rhtasv1.TimestampAuthority).Status.Url },
+		SetURL: func(o apis.ConditionsAwareObject, status *rhtasv1.SecuresignStatus) {
+			status.TSAStatus.Url = o.(*rhtasv1.TimestampAuthority).Status.Url
+		},
+	},
+	{
+		Kind:      "Console",
+		Condition: ConsoleCondition,
+		New:       func() apis.ConditionsAwareObject { return &rhtasv1.Console{} },
+		Defined: func(instance *rhtasv1.Securesign) bool {
+			return instance.Spec.Console != nil
+		},
+		URL: func(o apis.ConditionsAwareObject) string { return o.(*rhtasv1.Console).Status.UI.Url },
+		SetURL: func(o apis.ConditionsAwareObject, status *rhtasv1.SecuresignStatus) {
+			status.ConsoleStatus.Url = o.(*rhtasv1.Console).Status.UI.Url
+		},
+	},
+}
+
+// componentView is the observed state of all children of a Securesign, keyed by kind.
+// Children that are not defined or not created yet are missing.
+type componentView map[string]apis.ConditionsAwareObject
+
+// observeComponents reads all children of the instance from the client cache.
+func observeComponents(ctx context.Context, c client.Client, instance *rhtasv1.Securesign) (componentView, error) {
+	view := componentView{}
+	for _, component := range Components {
+		if !component.Defined(instance) {
+			continue
+		}
+		object := component.New()
+		if err := c.Get(ctx, client.ObjectKey{Name: instance.Name, Namespace: instance.Namespace}, object); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, err
+		}
+		view[component.Kind] = object
+	}
+	return view, nil
+}
+
+// apply reflects the observed children in the Securesign status.
+func (view componentView) apply(instance *rhtasv1.Securesign) {
+	var statuses []rhtasv1.SecuresignComponentStatus
+	for _, component := range Components {
+		if !component.Defined(instance) {
+			// drop endpoints of a removed component
+			component.SetURL(component.New(), &instance.Status)
+			continue
+		}
+		object, ok := view[component.Kind]
+		if !ok {
+			continue
+		}
+		ready := meta.FindStatusCondition(object.GetConditions(), constants.ReadyCondition)
+		if ready == nil {
+			// not initialized yet, keep the Creating condition set on creation
+			continue
+		}
+
+		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
+			Type:    component.Condition,
+			Status:  ready.Status,
+			Reason:  ready.Reason,
+			Message: ready.Message,
+		})
+		component.SetURL(object, &instance.Status)
+
+		statuses = append(statuses, rhtasv1.SecuresignComponentStatus{
+			Kind:               component.Kind,
+			Name:               object.GetName(),
+			Url:                component.URL(object),
+			Ready:              ready.Status,
+			Reason:             ready.Reason,
+			Message:            ready.Message,
+			LastTransitionTime: ready.LastTransitionTime,
+			ObservedGeneration: ready.ObservedGeneration,
+		})
+	}
+	instance.Status.Components = statuses
+}
@@ -0,0 +1,110 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func readyConditions() []metav1.Condition {
+	var conditions []metav1.Condition
+	for _, c := range []string{constants.ReadyCondition, TrillianCondition, FulcioCondition, RekorCondition, CTlogCondition, TufCondition} {
+		conditions = append(conditions, metav1.Condition{Type: c, Status: metav1.ConditionTrue, Reason: state.Ready.String()})
+	}
+	for _, c := range []string{TSACondition, ConsoleCondition} {
+		conditions = append(conditions, metav1.Condition{Type: c, Status: metav1.ConditionTrue, Reason: state.NotDefined.String()})
+	}
+	return conditions
+}
+
+func TestUpdateStatusAction_Components(t *testing.T) {
+	g := NewWithT(t)
+	objectMeta := metav1.ObjectMeta{Name: "securesign", Namespace: "default", Generation: 2}
+	transition := metav1.Now().Rfc3339Copy()
+	instance := &rhtasv1.Securesign{
+		ObjectMeta: objectMeta,
+		Status:     rhtasv1.SecuresignStatus{Conditions: readyConditions()},
+	}
+	fulcio := &rhtasv1.Fulcio{
+		ObjectMeta: objectMeta,
+		Status: rhtasv1.FulcioStatus{
+			Url:     "https://fulcio.example.com",
+			GrpcUrl: "fulcio.example.com:443",
+			Conditions: []metav1.Condition{{
+				Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Creating.String(),
+				Message: "waiting for deployment", LastTransitionTime: transition, ObservedGeneration: 2,
+			}},
+		},
+	}
+	rekor := &rhtasv1.Rekor{
+		ObjectMeta: objectMeta,
+		Status: rhtasv1.RekorStatus{
+			Url:        "https://rekor.example.com",
+			Conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()}},
+		},
+	}
+	// not initialized yet
+	tuf := &rhtasv1.Tuf{ObjectMeta: objectMeta}
+
+	c := testAction.FakeClientBuilder().WithObjects(instance, fulcio, rekor, tuf).WithStatusSubresource(instance).Build()
+	a := testAction.PrepareAction(c, NewUpdateStatusAction())
+
+	g.Expect(a.Handle(t.Context(), instance)).ToNot(BeNil())
+
+	g.Expect(instance.Status.FulcioStatus).To(Equal(rhtasv1.SecuresignFulcioStatus{Url: "https://fulcio.example.com", GrpcUrl: "fulcio.example.com:443"}))
+	g.Expect(instance.Status.RekorStatus.Url).To(Equal("https://rekor.example.com"))
+	g.Expect(instance.Status.Components).To(BeComparableTo([]rhtasv1.SecuresignComponentStatus{
+		{
+			Kind: "Fulcio", Name: "securesign", Url: "https://fulcio.example.com",
+			Ready: metav1.ConditionFalse, Reason: state.Creating.String(), Message: "waiting for deployment",
+			LastTransitionTime: transition, ObservedGeneration: 2,
+		},
+		{Kind: "Rekor", Name: "securesign", Url: "https://rekor.example.com", Ready: metav1.ConditionTrue, Reason: state.Ready.String()},
+	}))
+
+	// the worst component is aggregated in the same pass
+	ready := meta.FindStatusCondition(instance.Status.Conditions, constants.ReadyCondition)
+	g.Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(ready.Reason).To(Equal(state.Creating.String()))
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, FulcioCondition).Message).To(Equal("waiting for deployment"))
+
+	persisted := &rhtasv1.Securesign{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(instance), persisted)).To(Succeed())
+	g.Expect(persisted.Status.Components).To(HaveLen(2))
+
+	// unchanged children do not update the status
+	g.Expect(a.Handle(t.Context(), persisted)).To(Equal(testAction.Continue()))
+}
+
+func TestUpdateStatusAction_UndefinedComponent(t *testing.T) {
+	g := NewWithT(t)
+	instance := &rhtasv1.Securesign{
+		ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"},
+		Status: rhtasv1.SecuresignStatus{
+			Conditions: readyConditions(),
+			TSAStatus:  rhtasv1.SecuresignTSAStatus{Url: "https://tsa.example.com"},
+		},
+	}
+	tsa := &rhtasv1.TimestampAuthority{
+		ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"},
+		Status: rhtasv1.TimestampAuthorityStatus{
+			Url:        "https://tsa.example.com",
+			Conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()}},
+		},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance, tsa).WithStatusSubresource(instance).Build()
+	a := testAction.PrepareAction(c, NewUpdateStatusAction())
+
+	a.Handle(t.Context(), instance)
+	g.Expect(instance.Status.TSAStatus.Url).To(BeEmpty())
+	g.Expect(instance.Status.Components).To(BeEmpty())
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, TSACondition).Reason).To(Equal(state.NotDefined.String()))
+	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, constants.ReadyCondition)).To(BeTrue())
+}
@@ -7,6 +7,7 @@ const (
 	RekorCondition           = "RekorAvailable"
 	TrillianCondition        = "TrillianAvailable"
 	CTlogCondition           = "CTlogAvailable"
+	ConsoleCondition         = "ConsoleAvailable"
 	SegmentBackupCronJobName = "segment-backup-nightly-metrics"
 	SegmentRBACName          = "rhtas-segment-backup-job"
 	MetricsCondition         = "MetricsAvailable"
@@ -0,0 +1,121 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ErrConsoleRekorV2 is returned when the console would be wired to Rekor v2, which has no search API.
+var ErrConsoleRekorV2 = errors.New("the console requires the search API of Rekor v1, set console.ui.rekor with the v2 rekorVersion")
+
+func NewConsoleAction() action.Action[*rhtasv1.Securesign] {
+	return &consoleAction{}
+}
+
+type consoleAction struct {
+	action.BaseAction
+}
+
+func (i consoleAction) Name() string {
+	return "create console"
+}
+
+func (i consoleAction) CanHandle(context.Context, *rhtasv1.Securesign) bool {
+	return true
+}
+
+func (i consoleAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *action.Result {
+	var (
+		err     error
+		result  controllerutil.OperationResult
+		l       = labels.For("console", instance.Name, instance.Name)
+		console = &rhtasv1.Console{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      instance.Name,
+				Namespace: instance.Namespace,
+			},
+		}
+	)
+
+	if instance.Spec.Console == nil {
+		if meta.IsStatusConditionTrue(instance.Status.Conditions, ConsoleCondition) {
+			return i.Continue()
+		}
+		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
+			Type:    ConsoleCondition,
+			Status:  v1.ConditionTrue,
+			Reason:  state.NotDefined.String(),
+			Message: "Console resource is undefined",
+		})
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+
+	if rekor := instance.Spec.Console.UI.Rekor; instance.Spec.RekorVersion == rhtasv1.RekorVersionV2 && rekor.Ref == nil && rekor.URL == "" {
+		return i.Error(ctx, reconcile.TerminalError(ErrConsoleRekorV2), instance,
+			v1.Condition{
+				Type:    ConsoleCondition,
+				Status:  v1.ConditionFalse,
+				Reason:  state.Failure.String(),
+				Message: ErrConsoleRekorV2.Error(),
+			})
+	}
+
+	if result, err = kubernetes.CreateOrUpdate(ctx, i.Client,
+		console,
+		ensure.ControllerReference[*rhtasv1.Console](instance, i.Client),
+		ensure.Labels[*rhtasv1.Console](slices.Collect(maps.Keys(l)), l),
+		ensure.Annotations[*rhtasv1.Console](annotations.InheritableAnnotations, instance.Annotations),
+		func(object *rhtasv1.Console) error {
+			defaulted := instance.Spec.Console.DeepCopy()
+			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
+			wireServiceReference(&defaulted.UI.Rekor, instance)
+			wireServiceReference(&defaulted.Api.Tuf, instance)
+			object.Spec = *defaulted
+			return nil
+		},
+	); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not create Console: %w", err), instance,
+			v1.Condition{
+				Type:    ConsoleCondition,
+				Status:  v1.ConditionFalse,
+				Reason:  state.Failure.String(),
+				Message: err.Error(),
+			})
+	}
+
+	if result != controllerutil.OperationResultNone {
+		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
+			Type:    ConsoleCondition,
+			Status:  v1.ConditionFalse,
+			Reason:  state.Creating.String(),
+			Message: "Console resource created " + console.Name,
+		})
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+
+	return i.Continue()
+}
+
+// wireServiceReference points an unset reference to the component of the same name created by instance.
+func wireServiceReference(ref *rhtasv1.ServiceReference, instance *rhtasv1.Securesign) {
+	if ref.Ref != nil || ref.URL != "" {
+		return
+	}
+	ref.Ref = &rhtasv1.ServiceReferenceRef{Name: instance.Name, Namespace: instance.Namespace}
+}
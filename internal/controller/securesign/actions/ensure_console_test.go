@@ -0,0 +1,98 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestConsoleAction_Undefined(t *testing.T) {
+	g := NewWithT(t)
+	instance := &rhtasv1.Securesign{ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"}}
+	c := testAction.FakeClientBuilder().WithObjects(instance).WithStatusSubresource(instance).Build()
+	a := testAction.PrepareAction(c, NewConsoleAction())
+
+	g.Expect(a.Handle(t.Context(), instance)).ToNot(BeNil())
+	condition := meta.FindStatusCondition(instance.Status.Conditions, ConsoleCondition)
+	g.Expect(condition).ToNot(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(state.NotDefined.String()))
+
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(instance), &rhtasv1.Console{})).ToNot(Succeed())
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+}
+
+func TestConsoleAction_Create(t *testing.T) {
+	g := NewWithT(t)
+	external := rhtasv1.ServiceReference{URL: "https://tuf.example.com"}
+	instance := &rhtasv1.Securesign{
+		ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"},
+		Spec: rhtasv1.SecuresignSpec{
+			Console: &rhtasv1.ConsoleSpec{Api: rhtasv1.ConsoleAPI{Tuf: external}},
+		},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance).WithStatusSubresource(instance).Build()
+	testAction.PrepareAction(c, NewInitializeStatusAction()).Handle(t.Context(), instance)
+	a := testAction.PrepareAction(c, NewConsoleAction())
+
+	g.Expect(a.Handle(t.Context(), instance)).ToNot(BeNil())
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, ConsoleCondition).Reason).To(Equal(state.Creating.String()))
+
+	console := &rhtasv1.Console{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(instance), console)).To(Succeed())
+	g.Expect(console.OwnerReferences).To(HaveLen(1))
+	g.Expect(console.Spec.UI.Rekor.Ref).To(Equal(&rhtasv1.ServiceReferenceRef{Name: "securesign", Namespace: "default"}))
+	g.Expect(console.Spec.Api.Tuf).To(Equal(external))
+
+	meta.SetStatusCondition(&console.Status.Conditions, metav1.Condition{
+		Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String(),
+	})
+	console.Status.UI.Url = "https://console.example.com"
+	g.Expect(c.Update(t.Context(), console)).To(Succeed())
+
+	// the status is copied by the update status action
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.Continue()))
+	g.Expect(testAction.PrepareAction(c, NewUpdateStatusAction()).Handle(t.Context(), instance)).ToNot(BeNil())
+	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, ConsoleCondition)).To(BeTrue())
+	g.Expect(instance.Status.ConsoleStatus.Url).To(Equal("https://console.example.com"))
+}
+
+func TestConsoleAction_RekorV2(t *testing.T) {
+	g := NewWithT(t)
+	instance := &rhtasv1.Securesign{
+		ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"},
+		Spec: rhtasv1.SecuresignSpec{
+			RekorVersion: rhtasv1.RekorVersionV2,
+			Console:      &rhtasv1.ConsoleSpec{},
+		},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance).WithStatusSubresource(instance).Build()
+	testAction.PrepareAction(c, NewInitializeStatusAction()).Handle(t.Context(), instance)
+	a := testAction.PrepareAction(c, NewConsoleAction())
+
+	// Rekor v2 has no search API, the console is not wired to it
+	result := a.Handle(t.Context(), instance)
+	g.Expect(result.Err).To(MatchError(ErrConsoleRekorV2))
+	g.Expect(errors.Is(result.Err, reconcile.TerminalError(nil))).To(BeTrue())
+	condition := meta.FindStatusCondition(instance.Status.Conditions, ConsoleCondition)
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(state.Failure.String()))
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(instance), &rhtasv1.Console{})).ToNot(Succeed())
+
+	// an external Rekor v1 is used as it is
+	external := rhtasv1.ServiceReference{URL: "https://rekor.example.com"}
+	instance.Spec.Console.UI.Rekor = external
+	g.Expect(a.Handle(t.Context(), instance)).ToNot(BeNil())
+	console := &rhtasv1.Console{}
+	g.Expect(c.Get(t.Context(), client.ObjectKeyFromObject(instance), console)).To(Succeed())
+	g.Expect(console.Spec.UI.Rekor).To(Equal(external))
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/annotations"
-	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
 	"github.com/securesign/operator/internal/utils/kubernetes"
@@ -58,6 +57,7 @@ func (i ctlogAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *
 		func(object *rhtasv1.CTlog) error {
 			defaulted := instance.Spec.Ctlog.DeepCopy()
 			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
 			object.Spec = *defaulted
 			return nil
 		},
@@ -81,22 +81,5 @@ func (i ctlogAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *
 		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 	}
 
-	return i.CopyStatus(ctx, ctl, instance)
-}
-
-func (i ctlogAction) CopyStatus(ctx context.Context, ctl *rhtasv1.CTlog, instance *rhtasv1.Securesign) *action.Result {
-	objectStatus := meta.FindStatusCondition(ctl.Status.Conditions, constants.ReadyCondition)
-	if objectStatus == nil {
-		// not initialized yet, wait for update
-		return i.Continue()
-	}
-	if !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, CTlogCondition, objectStatus.Status) {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   CTlogCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
-	}
 	return i.Continue()
 }
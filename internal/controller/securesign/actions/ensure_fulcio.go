@@ -9,7 +9,6 @@ import (
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/annotations"
-	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/controller/fulcio/actions"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
@@ -57,6 +56,7 @@ func (i fulcioAction) Handle(ctx context.Context, instance *rhtasv1.Securesign)
 		func(object *rhtasv1.Fulcio) error {
 			defaulted := instance.Spec.Fulcio.DeepCopy()
 			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
 			object.Spec = *defaulted
 			return nil
 		},
@@ -80,27 +80,5 @@ func (i fulcioAction) Handle(ctx context.Context, instance *rhtasv1.Securesign)
 		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 	}
 
-	return i.CopyStatus(ctx, fulcio, instance)
-}
-
-func (i fulcioAction) CopyStatus(ctx context.Context, object *rhtasv1.Fulcio, instance *rhtasv1.Securesign) *action.Result {
-	objectStatus := meta.FindStatusCondition(object.Status.Conditions, constants.ReadyCondition)
-	if objectStatus == nil {
-		// not initialized yet, wait for update
-		return i.Continue()
-	}
-	switch {
-	case !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, FulcioCondition, objectStatus.Status):
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   FulcioCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-	case instance.Status.FulcioStatus.Url != object.Status.Url:
-		instance.Status.FulcioStatus.Url = object.Status.Url
-	default:
-		return i.Continue()
-	}
-
-	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	return i.Continue()
 }
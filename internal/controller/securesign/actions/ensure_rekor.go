@@ -10,7 +10,6 @@ import (
 	v1alpha1 "github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/annotations"
-	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/migration"
 	"github.com/securesign/operator/internal/state"
@@ -35,8 +34,8 @@ func (i rekorAction) Name() string {
 	return "create rekor"
 }
 
-func (i rekorAction) CanHandle(context.Context, *rhtasv1.Securesign) bool {
-	return true
+func (i rekorAction) CanHandle(_ context.Context, instance *rhtasv1.Securesign) bool {
+	return instance.Spec.RekorVersion != rhtasv1.RekorVersionV2
 }
 
 func (i rekorAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *action.Result {
@@ -61,6 +60,7 @@ func (i rekorAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *
 		func(object *rhtasv1.Rekor) error {
 			defaulted := instance.Spec.Rekor.DeepCopy()
 			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
 			object.Spec = *defaulted
 			return nil
 		},
@@ -92,27 +92,5 @@ func (i rekorAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *
 		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 	}
 
-	return i.CopyStatus(ctx, rekor, instance)
-}
-
-func (i rekorAction) CopyStatus(ctx context.Context, object *rhtasv1.Rekor, instance *rhtasv1.Securesign) *action.Result {
-	objectStatus := meta.FindStatusCondition(object.Status.Conditions, constants.ReadyCondition)
-	if objectStatus == nil {
-		// not initialized yet, wait for update
-		return i.Continue()
-	}
-	switch {
-	case !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, RekorCondition, objectStatus.Status):
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   RekorCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-	case instance.Status.RekorStatus.Url != object.Status.Url:
-		instance.Status.RekorStatus.Url = object.Status.Url
-	default:
-		return i.Continue()
-	}
-
-	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	return i.Continue()
 }
@@ -0,0 +1,84 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewRekorV2Action() action.Action[*rhtasv1.Securesign] {
+	return &rekorV2Action{}
+}
+
+type rekorV2Action struct {
+	action.BaseAction
+}
+
+func (i rekorV2Action) Name() string {
+	return "create rekor v2"
+}
+
+func (i rekorV2Action) CanHandle(_ context.Context, instance *rhtasv1.Securesign) bool {
+	return instance.Spec.RekorVersion == rhtasv1.RekorVersionV2
+}
+
+func (i rekorV2Action) Handle(ctx context.Context, instance *rhtasv1.Securesign) *action.Result {
+	var (
+		err    error
+		result controllerutil.OperationResult
+		l      = labels.For("rekor-v2", instance.Name, instance.Name)
+		rekor  = &rhtasv1.RekorV2{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      instance.Name,
+				Namespace: instance.Namespace,
+			},
+		}
+	)
+
+	if result, err = kubernetes.CreateOrUpdate(ctx, i.Client,
+		rekor,
+		ensure.ControllerReference[*rhtasv1.RekorV2](instance, i.Client),
+		ensure.Labels[*rhtasv1.RekorV2](slices.Collect(maps.Keys(l)), l),
+		ensure.Annotations[*rhtasv1.RekorV2](annotations.InheritableAnnotations, instance.Annotations),
+		func(object *rhtasv1.RekorV2) error {
+			defaulted := instance.Spec.RekorV2.DeepCopy()
+			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
+			object.Spec = *defaulted
+			return nil
+		},
+	); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not create Rekor v2: %w", err), instance,
+			v1.Condition{
+				Type:    RekorCondition,
+				Status:  v1.ConditionFalse,
+				Reason:  state.Failure.String(),
+				Message: err.Error(),
+			})
+	}
+
+	if result != controllerutil.OperationResultNone {
+		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
+			Type:    RekorCondition,
+			Status:  v1.ConditionFalse,
+			Reason:  state.Creating.String(),
+			Message: "Rekor v2 resource created " + rekor.Name,
+		})
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+
+	return i.Continue()
+}
@@ -8,7 +8,6 @@ import (
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/annotations"
-	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
 	"github.com/securesign/operator/internal/utils/kubernetes"
@@ -57,6 +56,7 @@ func (i trillianAction) Handle(ctx context.Context, instance *rhtasv1.Securesign
 		func(object *rhtasv1.Trillian) error {
 			defaulted := instance.Spec.Trillian.DeepCopy()
 			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
 			object.Spec = *defaulted
 			return nil
 		},
@@ -80,22 +80,5 @@ func (i trillianAction) Handle(ctx context.Context, instance *rhtasv1.Securesign
 		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 	}
 
-	return i.CopyStatus(ctx, trillian, instance)
-}
-
-func (i trillianAction) CopyStatus(ctx context.Context, object *rhtasv1.Trillian, instance *rhtasv1.Securesign) *action.Result {
-	objectStatus := meta.FindStatusCondition(object.Status.Conditions, constants.ReadyCondition)
-	if objectStatus == nil {
-		// not initialized yet, wait for update
-		return i.Continue()
-	}
-	if meta.FindStatusCondition(instance.Status.Conditions, TrillianCondition).Reason != objectStatus.Reason {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   TrillianCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
-	}
 	return i.Continue()
 }
@@ -10,7 +10,6 @@ import (
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/annotations"
-	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/controller/tsa/actions"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
@@ -71,6 +70,7 @@ func (i tsaAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *ac
 		func(object *rhtasv1.TimestampAuthority) error {
 			defaulted := instance.Spec.TimestampAuthority.DeepCopy()
 			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
 			object.Spec = *defaulted
 			return nil
 		},
@@ -94,27 +94,5 @@ func (i tsaAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *ac
 		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 	}
 
-	return i.CopyStatus(ctx, tsa, instance)
-}
-
-func (i tsaAction) CopyStatus(ctx context.Context, object *rhtasv1.TimestampAuthority, instance *rhtasv1.Securesign) *action.Result {
-	objectStatus := meta.FindStatusCondition(object.Status.Conditions, constants.ReadyCondition)
-	if objectStatus == nil {
-		// not initialized yet, wait for update
-		return i.Continue()
-	}
-	switch {
-	case !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, TSACondition, objectStatus.Status):
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   TSACondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-	case instance.Status.TSAStatus.Url != object.Status.Url:
-		instance.Status.TSAStatus.Url = object.Status.Url
-	default:
-		return i.Continue()
-	}
-
-	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	return i.Continue()
 }
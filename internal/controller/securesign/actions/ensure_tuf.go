@@ -8,7 +8,6 @@ import (
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/annotations"
-	"github.com/securesign/operator/internal/constants"
 	tufConstants "github.com/securesign/operator/internal/controller/tuf/constants"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
@@ -58,6 +57,7 @@ func (i tufAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *ac
 		func(object *rhtasv1.Tuf) error {
 			defaulted := instance.Spec.Tuf.DeepCopy()
 			defaulted.SetDefaults()
+			inheritNetworkPolicy(&defaulted.NetworkPolicy, instance)
 			object.Spec = *defaulted
 			return nil
 		},
@@ -81,28 +81,5 @@ func (i tufAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *ac
 		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 	}
 
-	return i.CopyStatus(ctx, tuf, instance)
-}
-
-func (i tufAction) CopyStatus(ctx context.Context, object *rhtasv1.Tuf, instance *rhtasv1.Securesign) *action.Result {
-	objectStatus := meta.FindStatusCondition(object.Status.Conditions, constants.ReadyCondition)
-	if objectStatus == nil {
-		// not initialized yet, wait for update
-		return i.Continue()
-	}
-
-	switch {
-	case !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, TufCondition, objectStatus.Status):
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   TufCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-	case instance.Status.TufStatus.Url != object.Status.Url:
-		instance.Status.TufStatus.Url = object.Status.Url
-	default:
-		return i.Continue()
-	}
-
-	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	return i.Continue()
 }
@@ -0,0 +1,11 @@
+package actions
+
+import rhtasv1 "github.com/securesign/operator/api/v1"
+
+// inheritNetworkPolicy applies the Securesign NetworkPolicy setting to a component
+// that does not configure it explicitly.
+func inheritNetworkPolicy(component *rhtasv1.NetworkPolicyConfig, instance *rhtasv1.Securesign) {
+	if component.Enabled == nil {
+		component.Enabled = instance.Spec.NetworkPolicy.Enabled
+	}
+}
@@ -7,8 +7,10 @@ import (
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
 	v1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -98,6 +100,11 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *a
 
 func (i rbacAction) cleanupResource(ctx context.Context, instance *rhtasv1.Securesign, object client.Object) *action.Result {
 	if err := client.IgnoreNotFound(i.Client.Delete(ctx, object)); err != nil {
+		// A namespace-scoped operator has no access to cluster-wide and openshift-monitoring objects,
+		// so it could not have created them either.
+		if kubernetes.IsNamespaceScoped() && apierrors.IsForbidden(err) {
+			return i.Continue()
+		}
 		return i.Error(ctx, err, instance,
 			metav1.Condition{
 				Type:    MetricsCondition,
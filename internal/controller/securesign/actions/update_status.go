@@ -2,6 +2,7 @@ package actions
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
@@ -28,9 +29,16 @@ func (i updateStatusAction) CanHandle(ctx context.Context, instance *rhtasv1.Sec
 	return meta.FindStatusCondition(instance.Status.Conditions, constants.ReadyCondition) != nil
 }
 
+// Handle refreshes the component conditions from the observed children and aggregates them to the Ready condition.
+// All changes are persisted at once.
 func (i updateStatusAction) Handle(ctx context.Context, instance *rhtasv1.Securesign) *action.Result {
-	sorted := sortByStatus(instance.Status.Conditions)
+	view, err := observeComponents(ctx, i.Client, instance)
+	if err != nil {
+		return i.Error(ctx, fmt.Errorf("could not read components: %w", err), instance)
+	}
+	view.apply(instance)
 
+	sorted := sortByStatus(instance.Status.Conditions)
 	if !meta.IsStatusConditionTrue(instance.Status.Conditions, sorted[0]) {
 		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
 			Type:               constants.ReadyCondition,
@@ -38,22 +46,19 @@ func (i updateStatusAction) Handle(ctx context.Context, instance *rhtasv1.Secure
 			Reason:             meta.FindStatusCondition(instance.Status.Conditions, sorted[0]).Reason,
 			ObservedGeneration: instance.Generation,
 		})
-		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
-	}
-	if !meta.IsStatusConditionTrue(instance.Status.Conditions, constants.ReadyCondition) {
+	} else {
 		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
 			Type:               constants.ReadyCondition,
 			Status:             v1.ConditionTrue,
 			Reason:             state.Ready.String(),
 			ObservedGeneration: instance.Generation,
 		})
-		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 	}
-	return i.Continue()
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
 }
 
 func sortByStatus(conditions []v1.Condition) []string {
-	sorted := []string{TrillianCondition, FulcioCondition, RekorCondition, CTlogCondition, TufCondition, TSACondition}
+	sorted := []string{TrillianCondition, FulcioCondition, RekorCondition, CTlogCondition, TufCondition, TSACondition, ConsoleCondition}
 	sort.SliceStable(sorted, func(i, j int) bool {
 		iCondition := meta.FindStatusCondition(conditions, sorted[i])
 		jCondition := meta.FindStatusCondition(conditions, sorted[j])
@@ -21,6 +21,7 @@ func TestSortByStatus(t *testing.T) {
 		{Type: CTlogCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
 		{Type: TufCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
 		{Type: TSACondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
+		{Type: ConsoleCondition, Status: metav1.ConditionTrue, Reason: state.NotDefined.String()},
 	}
 
 	t.Run("all healthy — first in the base list wins the tie", func(t *testing.T) {
@@ -78,6 +79,7 @@ func TestUpdateStatusAction_Handle_PropagatesDrift(t *testing.T) {
 				{Type: CTlogCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
 				{Type: TufCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
 				{Type: TSACondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
+				{Type: ConsoleCondition, Status: metav1.ConditionTrue, Reason: state.NotDefined.String()},
 			},
 		},
 	}
@@ -18,12 +18,18 @@ package securesign
 
 import (
 	"context"
+	"time"
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
 	"github.com/securesign/operator/internal/controller"
-	v12 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
+	tasPredicate "github.com/securesign/operator/internal/controller/predicate"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/events"
 
 	"github.com/operator-framework/operator-lib/predicate"
@@ -31,9 +37,12 @@ import (
 	"github.com/securesign/operator/internal/controller/securesign/actions"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	crpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -46,6 +55,7 @@ type securesignReconciler struct {
 	client.Client
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
 }
 
 func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
@@ -53,6 +63,7 @@ func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.Even
 		Client:   c,
 		scheme:   scheme,
 		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
 	}
 }
 
@@ -60,22 +71,21 @@ func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.Even
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=securesigns/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=securesigns/finalizers,verbs=update
 
-// TODO: rework Securesign controller to watch resources
 func (r *securesignReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	var instance rhtasv1.Securesign
 	log := ctrllog.FromContext(ctx)
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Fetch the namespace
-	var namespace v12.Namespace
-	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, &namespace); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
 	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
 
 	//Add finalizer for this CR
 	if !controllerutil.ContainsFinalizer(target, finalizer) {
@@ -89,7 +99,11 @@ func (r *securesignReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Check if the namespace is marked for deletion
-	if !namespace.DeletionTimestamp.IsZero() {
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating {
 		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
 		return ctrl.Result{}, nil
 	}
@@ -101,18 +115,26 @@ func (r *securesignReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		actions.NewTrillianAction(),
 		actions.NewFulcioAction(),
 		actions.NewRekorAction(),
+		actions.NewRekorV2Action(),
 		actions.NewCtlogAction(),
 		actions.NewTufAction(),
 		actions.NewTsaAction(),
+		actions.NewConsoleAction(),
 		actions.NewUpdateStatusAction(),
 	}
 
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), acs)
+	}
+
 	for _, a := range acs {
 		a.InjectClient(r.Client)
 		a.InjectLogger(log.WithName(a.Name()))
 
 		if a.CanHandle(ctx, target) {
+			start := time.Now()
 			result := a.Handle(ctx, target)
+			metrics.ObserveAction("securesign", a.Name(), start, result)
 			if result != nil {
 				return result.Result, result.Err
 			}
@@ -129,14 +151,33 @@ func (r *securesignReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		WithEventFilter(pause).
-		For(&rhtasv1.Securesign{}).
-		Owns(&rhtasv1.Fulcio{}).
-		Owns(&rhtasv1.Rekor{}).
-		Owns(&rhtasv1.Tuf{}).
-		Owns(&rhtasv1.Trillian{}).
-		Owns(&rhtasv1.CTlog{}).
-		Owns(&rhtasv1.TimestampAuthority{}).
-		Complete(r)
+		For(&rhtasv1.Securesign{})
+	for _, component := range actions.Components {
+		b = b.Owns(component.New(), builder.WithPredicates(componentPredicate(component)))
+	}
+	return b.Complete(r)
+}
+
+// componentPredicate filters child events to the changes reflected in the Securesign status:
+// spec updates, the Ready condition and the service endpoints.
+func componentPredicate(component actions.Component) crpredicate.Predicate {
+	return crpredicate.Or(
+		crpredicate.GenerationChangedPredicate{},
+		tasPredicate.ConditionChangedPredicate[apis.ConditionsAwareObject](constants.ReadyCondition),
+		crpredicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldC, ok1 := e.ObjectOld.(apis.ConditionsAwareObject)
+				newC, ok2 := e.ObjectNew.(apis.ConditionsAwareObject)
+				if !ok1 || !ok2 {
+					return true
+				}
+				var oldStatus, newStatus rhtasv1.SecuresignStatus
+				component.SetURL(oldC, &oldStatus)
+				component.SetURL(newC, &newStatus)
+				return component.URL(oldC) != component.URL(newC) || !equality.Semantic.DeepEqual(oldStatus, newStatus)
+			},
+		},
+	)
 }
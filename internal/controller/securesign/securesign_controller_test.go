@@ -0,0 +1,59 @@
+package securesign
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/controller/securesign/actions"
+	"github.com/securesign/operator/internal/state"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestComponentPredicate(t *testing.T) {
+	fulcio := func(mutate func(*rhtasv1.Fulcio)) *rhtasv1.Fulcio {
+		f := &rhtasv1.Fulcio{
+			ObjectMeta: metav1.ObjectMeta{Name: "securesign", Generation: 1, ResourceVersion: "1"},
+			Status: rhtasv1.FulcioStatus{
+				Url:        "https://fulcio.example.com",
+				Conditions: []metav1.Condition{{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()}},
+			},
+		}
+		mutate(f)
+		return f
+	}
+	tests := []struct {
+		name   string
+		mutate func(*rhtasv1.Fulcio)
+		want   bool
+	}{
+		{name: "spec change", mutate: func(f *rhtasv1.Fulcio) { f.Generation = 2 }, want: true},
+		{name: "ready condition change", mutate: func(f *rhtasv1.Fulcio) {
+			f.Status.Conditions[0].Status = metav1.ConditionFalse
+		}, want: true},
+		{name: "url change", mutate: func(f *rhtasv1.Fulcio) { f.Status.Url = "https://other.example.com" }, want: true},
+		{name: "grpc url change", mutate: func(f *rhtasv1.Fulcio) { f.Status.GrpcUrl = "fulcio.example.com:443" }, want: true},
+		{name: "unrelated status change", mutate: func(f *rhtasv1.Fulcio) {
+			f.ResourceVersion = "2"
+			f.Status.Conditions = append(f.Status.Conditions, metav1.Condition{Type: "ServerAvailable", Status: metav1.ConditionTrue})
+		}, want: false},
+	}
+
+	component := actions.Components[1]
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := componentPredicate(component)
+			g.Expect(p.Update(event.UpdateEvent{
+				ObjectOld: fulcio(func(*rhtasv1.Fulcio) {}),
+				ObjectNew: fulcio(tt.mutate),
+			})).To(Equal(tt.want))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(component.Kind).To(Equal("Fulcio"))
+	g.Expect(componentPredicate(component).Delete(event.DeleteEvent{Object: fulcio(func(*rhtasv1.Fulcio) {})})).To(BeTrue())
+}
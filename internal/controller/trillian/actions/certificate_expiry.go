@@ -0,0 +1,25 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certexpiry"
+)
+
+type trillianCertificates struct{}
+
+func (trillianCertificates) Certificates(i *rhtasv1.Trillian) []certexpiry.Certificate {
+	return []certexpiry.Certificate{
+		{Name: "database-tls", Ref: i.Status.Db.TLS.CertRef},
+		{Name: "server-tls", Ref: i.Status.LogServer.TLS.CertRef},
+		{Name: "signer-tls", Ref: i.Status.LogSigner.TLS.CertRef},
+	}
+}
+
+func (trillianCertificates) Status(i *rhtasv1.Trillian) *[]rhtasv1.CertificateStatus {
+	return &i.Status.Certificates
+}
+
+func NewCertificateExpiryAction() action.Action[*rhtasv1.Trillian] {
+	return certexpiry.NewAction[*rhtasv1.Trillian](trillianCertificates{})
+}
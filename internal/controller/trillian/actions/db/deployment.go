@@ -15,7 +15,6 @@ import (
 	"github.com/securesign/operator/internal/images"
 	"github.com/securesign/operator/internal/labels"
 	"github.com/securesign/operator/internal/state"
-	"github.com/securesign/operator/internal/utils"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure/deployment"
@@ -68,6 +67,7 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.Trillian) *a
 			},
 		},
 		i.ensureDbDeployment(instance, actions.RBACDbName, labels),
+		deployment.PodRequirements(instance.Spec.Db.PodRequirements, actions.DbDeploymentName),
 		deployment.PodSecurityContext(),
 		deployment.GODEBUG(instance.GetAnnotations()),
 		ensure.ControllerReference[*v2.Deployment](instance, i.Client),
@@ -111,7 +111,6 @@ func (i deployAction) ensureDbDeployment(instance *rhtasv1.Trillian, sa string,
 		var volumeName = "storage"
 
 		spec := &dp.Spec
-		spec.Replicas = utils.Pointer[int32](1)
 		spec.Selector = &metav1.LabelSelector{
 			MatchLabels: labels,
 		}
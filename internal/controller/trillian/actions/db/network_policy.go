@@ -0,0 +1,32 @@
+package db
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/controller/trillian/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type dbNetworkPolicyConfig struct{}
+
+func (dbNetworkPolicyConfig) IsEnabled(i *rhtasv1.Trillian) bool {
+	return enabled(i) && utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (dbNetworkPolicyConfig) Ingress(_ *rhtasv1.Trillian) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{port},
+			networkpolicy.FromComponents(actions.LogServerComponentName, actions.LogSignerComponentName),
+		),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Trillian] {
+	return networkpolicy.NewAction(
+		actions.DbComponentName,
+		actions.DbDeploymentName,
+		dbNetworkPolicyConfig{},
+	)
+}
@@ -0,0 +1,37 @@
+package logserver
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/action/tree"
+	ctlogActions "github.com/securesign/operator/internal/controller/ctlog/actions"
+	rekorActions "github.com/securesign/operator/internal/controller/rekor/actions"
+	"github.com/securesign/operator/internal/controller/trillian/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type logserverNetworkPolicyConfig struct{}
+
+func (logserverNetworkPolicyConfig) IsEnabled(i *rhtasv1.Trillian) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (logserverNetworkPolicyConfig) Ingress(_ *rhtasv1.Trillian) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{actions.ServerPort},
+			networkpolicy.FromComponents(rekorActions.ServerComponentName, ctlogActions.ComponentName, tree.ComponentName),
+			networkpolicy.FromOperator(),
+		),
+		networkpolicy.Rule([]int32{actions.MetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Trillian] {
+	return networkpolicy.NewAction(
+		actions.LogServerComponentName,
+		actions.LogserverDeploymentName,
+		logserverNetworkPolicyConfig{},
+	)
+}
@@ -0,0 +1,31 @@
+package logsigner
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/controller/trillian/actions"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type logsignerNetworkPolicyConfig struct{}
+
+func (logsignerNetworkPolicyConfig) IsEnabled(i *rhtasv1.Trillian) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+// Ingress only admits metrics scraping, the log signer does not serve any other clients.
+func (logsignerNetworkPolicyConfig) Ingress(_ *rhtasv1.Trillian) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{actions.MetricsPort}, networkpolicy.FromMonitoring()),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Trillian] {
+	return networkpolicy.NewAction(
+		actions.LogSignerComponentName,
+		actions.LogsignerDeploymentName,
+		logsignerNetworkPolicyConfig{},
+	)
+}
@@ -0,0 +1,41 @@
+package logsigner
+
+import (
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/alerting"
+	"github.com/securesign/operator/internal/controller/trillian/actions"
+)
+
+type logsignerAlertingConfig struct{}
+
+func (logsignerAlertingConfig) PrometheusRule(i *rhtasv1.Trillian) rhtasv1.PrometheusRuleConfig {
+	return i.Spec.Monitoring.PrometheusRule
+}
+
+func (logsignerAlertingConfig) Rules(_ *rhtasv1.Trillian) []alerting.Rule {
+	return []alerting.Rule{
+		{
+			Alert:       "TrillianSignerNotLeading",
+			Threshold:   "1",
+			For:         "10m",
+			Severity:    alerting.SeverityCritical,
+			Summary:     "No Trillian log signer is leading a log",
+			Description: "Log {{ $labels.logid }} has no leading signer, new entries are not integrated into the tree.",
+			Expr: func(namespace, threshold string) string {
+				return fmt.Sprintf(`sum by (logid) (is_master{namespace=%q,service=%q}) < %s`,
+					namespace, actions.LogsignerDeploymentName, threshold)
+			},
+		},
+	}
+}
+
+func NewPrometheusRuleAction() action.Action[*rhtasv1.Trillian] {
+	return alerting.NewAction(
+		actions.LogSignerComponentName, actions.LogSignerMonitoringName, actions.LogsignerDeploymentName,
+		actions.SignerCondition,
+		logsignerAlertingConfig{},
+	)
+}
@@ -18,23 +18,27 @@ package trillian
 
 import (
 	"context"
+	"time"
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/action/transitions"
 	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/controller"
-	"k8s.io/apimachinery/pkg/types"
 
 	olpredicate "github.com/operator-framework/operator-lib/predicate"
 	"github.com/securesign/operator/internal/controller/trillian/actions"
 	"github.com/securesign/operator/internal/controller/trillian/actions/db"
 	"github.com/securesign/operator/internal/controller/trillian/actions/logserver"
 	"github.com/securesign/operator/internal/controller/trillian/actions/logsigner"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
 	fipsutil "github.com/securesign/operator/internal/utils/fips"
+	"github.com/securesign/operator/internal/utils/kubernetes"
 	v12 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/events"
 
 	v1 "k8s.io/api/apps/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -54,6 +58,7 @@ type trillianReconciler struct {
 	client.Client
 	scheme   *runtime.Scheme
 	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
 }
 
 func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
@@ -61,6 +66,7 @@ func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.Even
 		Client:   c,
 		scheme:   scheme,
 		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
 	}
 }
 
@@ -84,22 +90,26 @@ func (r *trillianReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	log := ctrllog.FromContext(ctx)
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Fetch the namespace
-	var namespace v12.Namespace
-	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, &namespace); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
 	// Check if the namespace is marked for deletion
-	if !namespace.DeletionTimestamp.IsZero() {
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating {
 		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
 		return ctrl.Result{}, nil
 	}
 
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
 	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
 	conditionSupplier := func(_ *rhtasv1.Trillian) []string {
 		conditions := []string{actions.ServerCondition, actions.SignerCondition, actions.DbCondition}
 		return fipsutil.AppendFIPSCondition(conditions)
@@ -123,14 +133,18 @@ func (r *trillianReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		db.NewCreatePvcAction(),
 		db.NewDeployAction(),
 		db.NewCreateServiceAction(),
+		db.NewNetworkPolicyAction(),
 
 		logserver.NewDeployAction(),
 		logserver.NewCreateServiceAction(),
 		logserver.NewCreateMonitorAction(),
+		logserver.NewNetworkPolicyAction(),
 
 		logsigner.NewDeployAction(),
 		logsigner.NewCreateServiceAction(),
 		logsigner.NewCreateMonitorAction(),
+		logsigner.NewPrometheusRuleAction(),
+		logsigner.NewNetworkPolicyAction(),
 
 		transitions.NewToInitializePhaseAction[*rhtasv1.Trillian](),
 
@@ -139,6 +153,11 @@ func (r *trillianReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		logsigner.NewRolloutCheckAction(),
 
 		transitions.NewToReadyPhaseAction[*rhtasv1.Trillian](),
+		actions.NewCertificateExpiryAction(),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), actions)
 	}
 
 	for _, a := range actions {
@@ -148,7 +167,9 @@ func (r *trillianReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 		if a.CanHandle(ctx, target) {
 			log.V(2).Info("Executing " + a.Name())
+			start := time.Now()
 			result := a.Handle(ctx, target)
+			metrics.ObserveAction("trillian", a.Name(), start, result)
 			if result != nil {
 				return result.Result, result.Err
 			}
@@ -170,5 +191,6 @@ func (r *trillianReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&rhtasv1.Trillian{}, builder.WithPredicates(tasPredicate.ConfigurationChangedOnFailurePredicate[*rhtasv1.Trillian]())).
 		Owns(&v1.Deployment{}).
 		Owns(&v12.Service{}).
+		Owns(&networkingv1.NetworkPolicy{}).
 		Complete(r)
 }
@@ -42,7 +42,8 @@ func EnsureSignerDeployment(instance *rhtasv1.Trillian, labels map[string]string
 			actions.LogsignerDeploymentName,
 			actions.RBACSignerName,
 			labels,
-			"--election_system=k8s", "--lock_namespace=$(NAMESPACE)", "--lock_holder_identity=$(POD_NAME)", "--master_hold_interval=5s", "--master_hold_jitter=15s"),
+			append([]string{"--election_system=k8s", "--lock_namespace=$(NAMESPACE)", "--lock_holder_identity=$(POD_NAME)", "--master_hold_interval=5s", "--master_hold_jitter=15s"},
+				sequencerArgs(instance.Spec.LogSigner.Sequencer)...)...),
 		ensureProbes(actions.LogsignerDeploymentName),
 		deployment.PodRequirements(instance.Spec.LogSigner.PodRequirements, actions.LogsignerDeploymentName),
 		deployment.Proxy(),
@@ -53,6 +54,48 @@ func EnsureSignerDeployment(instance *rhtasv1.Trillian, labels map[string]string
 		deployment.PodSecurityContext())
 }
 
+// sequencerArgs renders the sequencing configuration of the log signer, unset values keep the Trillian defaults.
+func sequencerArgs(sequencer *rhtasv1.TrillianSequencer) []string {
+	if sequencer == nil {
+		return nil
+	}
+	var args []string
+	if sequencer.BatchSize != nil {
+		args = append(args, fmt.Sprintf("--batch_size=%d", *sequencer.BatchSize))
+	}
+	if sequencer.Interval != nil {
+		args = append(args, "--sequencer_interval="+sequencer.Interval.Duration.String())
+	}
+	if sequencer.NumSequencers != nil {
+		args = append(args, fmt.Sprintf("--num_sequencers=%d", *sequencer.NumSequencers))
+	}
+	if sequencer.GuardWindow != nil {
+		args = append(args, "--sequencer_guard_window="+sequencer.GuardWindow.Duration.String())
+	}
+	return args
+}
+
+// quotaArgs renders the quota configuration shared by the log server and the log signer.
+// The quota system uses the same database as the storage.
+func quotaArgs(provider string, quota *rhtasv1.TrillianQuota) []string {
+	if quota == nil {
+		return []string{"--quota_system=" + provider}
+	}
+	if quota.Enabled != nil && !*quota.Enabled {
+		return []string{"--quota_system=noop"}
+	}
+
+	args := []string{"--quota_system=" + provider}
+	if quota.MaxUnsequencedRows != nil {
+		flag := "--max_unsequenced_rows"
+		if provider == "postgresql" {
+			flag = "--pg_max_unsequenced_rows"
+		}
+		args = append(args, fmt.Sprintf("%s=%d", flag, *quota.MaxUnsequencedRows))
+	}
+	return args
+}
+
 func ensureProbes(containerName string) func(*apps.Deployment) error {
 	return func(deployment *apps.Deployment) error {
 		container := kubernetes.FindContainerByNameOrCreate(&deployment.Spec.Template.Spec, containerName)
@@ -117,13 +160,13 @@ func ensureDeployment(instance *rhtasv1.Trillian, image string, name string, sa
 		container := kubernetes.FindContainerByNameOrCreate(&template.Spec, name)
 		container.Image = image
 
-		container.Args = append([]string{
-			"--storage_system=" + instance.Spec.Db.Provider,
-			"--quota_system=" + instance.Spec.Db.Provider,
-			"--rpc_endpoint=0.0.0.0:" + strconv.Itoa(int(actions.ServerPort)),
-			"--http_endpoint=0.0.0.0:" + strconv.Itoa(int(actions.MetricsPort)),
+		container.Args = append([]string{"--storage_system=" + instance.Spec.Db.Provider}, quotaArgs(instance.Spec.Db.Provider, instance.Spec.Quota)...)
+		container.Args = append(container.Args,
+			"--rpc_endpoint=0.0.0.0:"+strconv.Itoa(int(actions.ServerPort)),
+			"--http_endpoint=0.0.0.0:"+strconv.Itoa(int(actions.MetricsPort)),
 			"--alsologtostderr",
-		}, args...)
+		)
+		container.Args = append(container.Args, args...)
 
 		if instance.Spec.MaxRecvMessageSize != nil {
 			container.Args = append(container.Args, "--max_msg_size_bytes", fmt.Sprintf("%d", *instance.Spec.MaxRecvMessageSize))
@@ -0,0 +1,85 @@
+package trillianUtils
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/controller/trillian/actions"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestSequencerArgs(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(sequencerArgs(nil)).To(BeEmpty())
+	g.Expect(sequencerArgs(&rhtasv1.TrillianSequencer{BatchSize: ptr.To(int32(5000))})).To(Equal([]string{"--batch_size=5000"}))
+	g.Expect(sequencerArgs(&rhtasv1.TrillianSequencer{
+		BatchSize:     ptr.To(int32(5000)),
+		Interval:      &metav1.Duration{Duration: 50 * time.Millisecond},
+		NumSequencers: ptr.To(int32(20)),
+		GuardWindow:   &metav1.Duration{Duration: time.Second},
+	})).To(Equal([]string{"--batch_size=5000", "--sequencer_interval=50ms", "--num_sequencers=20", "--sequencer_guard_window=1s"}))
+}
+
+func TestQuotaArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		provider string
+		quota    *rhtasv1.TrillianQuota
+		want     []string
+	}{
+		{
+			name:     "default",
+			provider: "mysql",
+			want:     []string{"--quota_system=mysql"},
+		},
+		{
+			name:     "disabled",
+			provider: "mysql",
+			quota:    &rhtasv1.TrillianQuota{Enabled: ptr.To(false), MaxUnsequencedRows: ptr.To(int32(1000))},
+			want:     []string{"--quota_system=noop"},
+		},
+		{
+			name:     "mysql max unsequenced rows",
+			provider: "mysql",
+			quota:    &rhtasv1.TrillianQuota{Enabled: ptr.To(true), MaxUnsequencedRows: ptr.To(int32(100000))},
+			want:     []string{"--quota_system=mysql", "--max_unsequenced_rows=100000"},
+		},
+		{
+			name:     "postgresql max unsequenced rows",
+			provider: "postgresql",
+			quota:    &rhtasv1.TrillianQuota{MaxUnsequencedRows: ptr.To(int32(100000))},
+			want:     []string{"--quota_system=postgresql", "--pg_max_unsequenced_rows=100000"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(quotaArgs(tc.provider, tc.quota)).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestEnsureSignerDeployment_Sequencer(t *testing.T) {
+	g := NewWithT(t)
+	instance := &rhtasv1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Spec: rhtasv1.TrillianSpec{
+			Db: rhtasv1.TrillianDB{Create: ptr.To(false), Provider: "mysql"},
+			LogSigner: rhtasv1.TrillianLogSigner{
+				Sequencer: &rhtasv1.TrillianSequencer{BatchSize: ptr.To(int32(5000))},
+			},
+			Quota: &rhtasv1.TrillianQuota{MaxUnsequencedRows: ptr.To(int32(100000))},
+		},
+	}
+
+	dp := &apps.Deployment{}
+	for _, fn := range EnsureSignerDeployment(instance, map[string]string{}, "") {
+		g.Expect(fn(dp)).To(Succeed())
+	}
+	container := kubernetes.FindContainerByNameOrCreate(&dp.Spec.Template.Spec, actions.LogsignerDeploymentName)
+	g.Expect(container.Args).To(ContainElements("--quota_system=mysql", "--max_unsequenced_rows=100000", "--master_hold_interval=5s", "--batch_size=5000"))
+}
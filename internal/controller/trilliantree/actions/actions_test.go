@@ -0,0 +1,278 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/trillian"
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/serviceresolver"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	testTrillian "github.com/securesign/operator/internal/testing/trillian"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func newInstance(readyReason state.State, mutate func(*rhtasv1.TrillianTree)) *rhtasv1.TrillianTree {
+	instance := &rhtasv1.TrillianTree{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-very-long-tree-name-for-rekor", Namespace: "default", Generation: 1},
+		Spec: rhtasv1.TrillianTreeSpec{
+			Trillian:        rhtasv1.ServiceReference{URL: "trillian-logserver.default.svc:8091"},
+			TreeType:        rhtasv1.TrillianTreeTypeLog,
+			State:           rhtasv1.TrillianTreeStateActive,
+			MaxRootDuration: &metav1.Duration{Duration: time.Hour},
+			DeletionPolicy:  rhtasv1.TrillianTreeRetain,
+		},
+	}
+	instance.SetCondition(metav1.Condition{
+		Type:   constants.ReadyCondition,
+		Status: metav1.ConditionFalse,
+		Reason: readyReason.String(),
+	})
+	if mutate != nil {
+		mutate(instance)
+	}
+	return instance
+}
+
+func run(t *testing.T, server *testTrillian.Server, instance *rhtasv1.TrillianTree, a action.Action[*rhtasv1.TrillianTree]) (client.Client, *action.Result) {
+	t.Helper()
+	testTrillian.Serve(t, server)
+	c := testAction.FakeClientBuilder().WithObjects(instance).WithStatusSubresource(instance).Build()
+	a = testAction.PrepareAction(c, a)
+
+	ctx := context.Background()
+	if !a.CanHandle(ctx, instance) {
+		return c, nil
+	}
+	return c, a.Handle(ctx, instance)
+}
+
+func TestCreateAction(t *testing.T) {
+	t.Run("create tree", func(t *testing.T) {
+		g := NewWithT(t)
+		server := testTrillian.NewServer(42)
+		instance := newInstance(state.Creating, func(tree *rhtasv1.TrillianTree) {
+			tree.Spec.State = rhtasv1.TrillianTreeStateFrozen
+		})
+
+		_, result := run(t, server, instance, NewCreateAction())
+		g.Expect(result).To(Equal(testAction.Return()))
+		g.Expect(instance.Status.TreeID).To(Equal(ptr.To(int64(42))))
+		g.Expect(instance.Status.State).To(Equal(rhtasv1.TrillianTreeStateActive))
+
+		tree := server.Tree(42)
+		g.Expect(tree).ToNot(BeNil())
+		g.Expect(tree.GetDisplayName()).To(Equal("my-very-long-tree-na"))
+		g.Expect(tree.GetTreeState()).To(Equal(trillian.TreeState_ACTIVE))
+		g.Expect(server.Sizes).To(HaveKey(int64(42)))
+	})
+
+	t.Run("adopt existing tree", func(t *testing.T) {
+		g := NewWithT(t)
+		server := testTrillian.NewServer(42)
+		server.Trees[7] = &trillian.Tree{TreeId: 7, TreeState: trillian.TreeState_FROZEN, TreeType: trillian.TreeType_LOG}
+		instance := newInstance(state.Creating, func(tree *rhtasv1.TrillianTree) {
+			tree.Spec.TreeID = ptr.To(int64(7))
+		})
+
+		_, result := run(t, server, instance, NewCreateAction())
+		g.Expect(result).To(Equal(testAction.Return()))
+		g.Expect(instance.Status.TreeID).To(Equal(ptr.To(int64(7))))
+		g.Expect(instance.Status.State).To(Equal(rhtasv1.TrillianTreeStateFrozen))
+		g.Expect(server.Trees).To(HaveLen(1))
+	})
+
+	t.Run("adopt missing tree", func(t *testing.T) {
+		g := NewWithT(t)
+		instance := newInstance(state.Creating, func(tree *rhtasv1.TrillianTree) {
+			tree.Spec.TreeID = ptr.To(int64(7))
+		})
+
+		c, result := run(t, testTrillian.NewServer(42), instance, NewCreateAction())
+		g.Expect(result).To(HaveField("Err", MatchError(ContainSubstring("tree 7 does not exist"))))
+
+		g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(instance), instance)).To(Succeed())
+		g.Expect(meta.FindStatusCondition(instance.Status.Conditions, constants.ReadyCondition).Reason).To(Equal(state.Failure.String()))
+	})
+
+	t.Run("tree already resolved", func(t *testing.T) {
+		g := NewWithT(t)
+		instance := newInstance(state.Creating, func(tree *rhtasv1.TrillianTree) {
+			tree.Status.TreeID = ptr.To(int64(42))
+		})
+
+		_, result := run(t, testTrillian.NewServer(42), instance, NewCreateAction())
+		g.Expect(result).To(BeNil())
+	})
+}
+
+func TestUpdateAction(t *testing.T) {
+	t.Run("update changed fields", func(t *testing.T) {
+		g := NewWithT(t)
+		server := testTrillian.NewServer(42)
+		server.Trees[42] = &trillian.Tree{
+			TreeId:          42,
+			TreeState:       trillian.TreeState_ACTIVE,
+			TreeType:        trillian.TreeType_LOG,
+			DisplayName:     "my-very-long-tree-na",
+			MaxRootDuration: durationpb.New(time.Hour),
+		}
+		instance := newInstance(state.Initialize, func(tree *rhtasv1.TrillianTree) {
+			tree.Status.TreeID = ptr.To(int64(42))
+			tree.Spec.State = rhtasv1.TrillianTreeStateFrozen
+			tree.Spec.MaxRootDuration = &metav1.Duration{}
+		})
+
+		_, result := run(t, server, instance, NewUpdateAction())
+		g.Expect(result).To(Equal(testAction.Return()))
+		g.Expect(instance.Status.State).To(Equal(rhtasv1.TrillianTreeStateFrozen))
+		g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, TreeCondition)).To(BeTrue())
+
+		tree := server.Tree(42)
+		g.Expect(tree.GetTreeState()).To(Equal(trillian.TreeState_FROZEN))
+		g.Expect(tree.GetMaxRootDuration().AsDuration()).To(BeZero())
+		g.Expect(tree.GetDisplayName()).To(Equal("my-very-long-tree-na"))
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		instance := newInstance(state.Ready, func(tree *rhtasv1.TrillianTree) {
+			tree.Status.TreeID = ptr.To(int64(42))
+			tree.SetCondition(metav1.Condition{Type: TreeCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String(), ObservedGeneration: 1})
+		})
+
+		_, result := run(t, testTrillian.NewServer(42), instance, NewUpdateAction())
+		g.Expect(result).To(BeNil())
+	})
+}
+
+func TestObserveAction(t *testing.T) {
+	g := NewWithT(t)
+	server := testTrillian.NewServer(42)
+	server.Trees[42] = &trillian.Tree{TreeId: 42}
+	server.Sizes[42] = 10
+	instance := newInstance(state.Ready, func(tree *rhtasv1.TrillianTree) {
+		tree.Status.TreeID = ptr.To(int64(42))
+		tree.SetCondition(metav1.Condition{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()})
+	})
+
+	c, result := run(t, server, instance, NewObserveAction())
+	g.Expect(result).To(Equal(testAction.RequeueAfter(refreshInterval)))
+
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(instance), instance)).To(Succeed())
+	g.Expect(instance.Status.Size).To(Equal(ptr.To(int64(10))))
+	g.Expect(instance.Status.RootHash).To(Equal("0a"))
+}
+
+func TestFinalizerAction(t *testing.T) {
+	g := NewWithT(t)
+	instance := newInstance(state.Pending, func(tree *rhtasv1.TrillianTree) {
+		tree.Spec.DeletionPolicy = rhtasv1.TrillianTreeDelete
+	})
+
+	c, result := run(t, testTrillian.NewServer(42), instance, NewFinalizerAction())
+	g.Expect(result).To(Equal(testAction.Return()))
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(instance), instance)).To(Succeed())
+	g.Expect(controllerutil.ContainsFinalizer(instance, Finalizer)).To(BeTrue())
+
+	instance.Spec.DeletionPolicy = rhtasv1.TrillianTreeRetain
+	a := testAction.PrepareAction(c, NewFinalizerAction())
+	g.Expect(a.CanHandle(context.Background(), instance)).To(BeTrue())
+	g.Expect(a.Handle(context.Background(), instance)).To(Equal(testAction.Return()))
+	g.Expect(c.Get(context.Background(), client.ObjectKeyFromObject(instance), instance)).To(Succeed())
+	g.Expect(controllerutil.ContainsFinalizer(instance, Finalizer)).To(BeFalse())
+}
+
+func TestDeleteAction(t *testing.T) {
+	serviceresolver.Register(func(obj *rhtasv1.Trillian) (string, error) {
+		return fmt.Sprintf("dns:///trillian-logserver.%s.svc:8091", obj.Namespace), nil
+	})
+
+	terminating := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:              "default",
+		DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		Finalizers:        []string{"kubernetes"},
+	}}
+	trillianRef := func(tree *rhtasv1.TrillianTree) {
+		tree.Spec.Trillian = rhtasv1.ServiceReference{Ref: &rhtasv1.ServiceReferenceRef{Name: "trillian", Namespace: "default"}}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*rhtasv1.TrillianTree)
+		objects []client.Object
+		deleted []int64
+		event   string
+	}{
+		{
+			name:    "delete tree",
+			objects: []client.Object{&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}},
+			deleted: []int64{42},
+			event:   "Normal TrillianTreeDeleted",
+		},
+		{
+			name:    "namespace is being deleted",
+			objects: []client.Object{terminating},
+			event:   "Warning TrillianTreeNotDeleted",
+		},
+		{
+			name:    "trillian is missing",
+			mutate:  trillianRef,
+			objects: []client.Object{&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}},
+			event:   "Warning TrillianTreeNotDeleted",
+		},
+		{
+			name:   "trillian is not ready",
+			mutate: trillianRef,
+			objects: []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+				&rhtasv1.Trillian{ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"}},
+			},
+			event: "Warning TrillianTreeNotDeleted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			server := testTrillian.NewServer(42)
+			server.Trees[42] = &trillian.Tree{TreeId: 42}
+			instance := newInstance(state.Ready, func(tree *rhtasv1.TrillianTree) {
+				tree.Spec.DeletionPolicy = rhtasv1.TrillianTreeDelete
+				tree.Status.TreeID = ptr.To(int64(42))
+				tree.Finalizers = []string{Finalizer}
+				if tt.mutate != nil {
+					tt.mutate(tree)
+				}
+			})
+
+			testTrillian.Serve(t, server)
+			c := testAction.FakeClientBuilder().WithObjects(append(tt.objects, instance)...).WithStatusSubresource(instance).Build()
+			ctx := context.Background()
+			g.Expect(c.Delete(ctx, instance)).To(Succeed())
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(instance), instance)).To(Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			a := testAction.PrepareAction(c, NewDeleteAction())
+			a.InjectRecorder(recorder)
+			g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+			g.Expect(a.Handle(ctx, instance)).To(Equal(testAction.Return()))
+			g.Expect(server.DeletedTrees()).To(Equal(tt.deleted))
+			g.Expect(recorder.Events).To(Receive(HavePrefix(tt.event)))
+			// the object is gone once the finalizer is removed
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(instance), instance)).ToNot(Succeed())
+		})
+	}
+}
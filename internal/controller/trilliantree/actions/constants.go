@@ -0,0 +1,16 @@
+package actions
+
+import "time"
+
+const (
+	TreeCondition = "TreeAvailable"
+
+	// Finalizer keeps the TrillianTree until its tree is deleted in Trillian (deletionPolicy: Delete).
+	Finalizer = "rhtas.redhat.com/trillian-tree"
+
+	// displayNameMaxLength is the size of the DisplayName column in the Trillian database.
+	displayNameMaxLength = 20
+
+	// refreshInterval is the period of reading the latest signed log root.
+	refreshInterval = time.Minute
+)
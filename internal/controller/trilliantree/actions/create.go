@@ -0,0 +1,87 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/trillian"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func NewCreateAction() action.Action[*rhtasv1.TrillianTree] {
+	return &createAction{}
+}
+
+type createAction struct {
+	action.BaseAction
+}
+
+func (i createAction) Name() string {
+	return "create tree"
+}
+
+func (i createAction) CanHandle(_ context.Context, instance *rhtasv1.TrillianTree) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Creating && instance.Status.TreeID == nil
+}
+
+func (i createAction) Handle(ctx context.Context, instance *rhtasv1.TrillianTree) *action.Result {
+	if kubernetes.IsDryRun(ctx) {
+		return i.Continue()
+	}
+
+	conn, result := connect(ctx, &i.BaseAction, instance)
+	if result != nil {
+		return result
+	}
+	defer func() { _ = conn.Close() }()
+
+	rpcCtx, cancel := context.WithTimeout(ctx, trillianutils.Timeout)
+	defer cancel()
+
+	var tree *trillian.Tree
+	var err error
+	if instance.Spec.TreeID != nil {
+		// adopt the existing tree
+		tree, err = trillian.NewTrillianAdminClient(conn).GetTree(rpcCtx, &trillian.GetTreeRequest{TreeId: *instance.Spec.TreeID})
+		if status.Code(err) == codes.NotFound {
+			err = reconcile.TerminalError(fmt.Errorf("tree %d does not exist", *instance.Spec.TreeID))
+		} else if err != nil {
+			err = fmt.Errorf("could not get tree %d: %w", *instance.Spec.TreeID, err)
+		}
+	} else {
+		desired := desiredTree(instance)
+		// Trillian creates ACTIVE trees only, the desired state is set by the update action
+		desired.TreeState = trillian.TreeState_ACTIVE
+		tree, err = trillianutils.CreateTree(rpcCtx, conn, desired)
+		if err == nil {
+			i.Recorder.Eventf(instance, nil, corev1.EventTypeNormal, "TrillianTreeCreated", "Created", "New Trillian tree created: %d", tree.TreeId)
+		}
+	}
+	if err != nil {
+		return i.Error(ctx, err, instance, metav1.Condition{
+			Type:    TreeCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  state.Failure.String(),
+			Message: err.Error(),
+		})
+	}
+
+	instance.Status.TreeID = &tree.TreeId
+	instance.Status.State = rhtasv1.TrillianTreeState(tree.GetTreeState().String())
+	instance.SetCondition(metav1.Condition{
+		Type:   TreeCondition,
+		Status: metav1.ConditionFalse,
+		Reason: state.Creating.String(),
+	})
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
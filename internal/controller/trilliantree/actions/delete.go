@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/trillian"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/serviceresolver"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// NewDeleteAction deletes the tree in Trillian before the TrillianTree with the Delete policy is removed.
+func NewDeleteAction() action.Action[*rhtasv1.TrillianTree] {
+	return &deleteAction{}
+}
+
+type deleteAction struct {
+	action.BaseAction
+}
+
+func (i deleteAction) Name() string {
+	return "delete tree"
+}
+
+func (i deleteAction) CanHandle(_ context.Context, instance *rhtasv1.TrillianTree) bool {
+	return !instance.DeletionTimestamp.IsZero()
+}
+
+func (i deleteAction) Handle(ctx context.Context, instance *rhtasv1.TrillianTree) *action.Result {
+	if !controllerutil.ContainsFinalizer(instance, Finalizer) || kubernetes.IsDryRun(ctx) {
+		return i.Return()
+	}
+
+	if instance.Spec.DeletionPolicy == rhtasv1.TrillianTreeDelete && instance.Status.TreeID != nil {
+		treeID := *instance.Status.TreeID
+		reason, err := i.trillianUnavailable(ctx, instance)
+		if err != nil {
+			return i.Error(ctx, err, instance)
+		}
+		if reason != "" {
+			i.Recorder.Eventf(instance, nil, corev1.EventTypeWarning, "TrillianTreeNotDeleted", "Delete",
+				"Trillian tree %d was not deleted: %s", treeID, reason)
+		} else if result := i.deleteTree(ctx, instance, treeID); result != nil {
+			return result
+		}
+	}
+
+	controllerutil.RemoveFinalizer(instance, Finalizer)
+	if err := i.Client.Update(ctx, instance); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not remove finalizer: %w", err), instance)
+	}
+	return i.Return()
+}
+
+func (i deleteAction) deleteTree(ctx context.Context, instance *rhtasv1.TrillianTree, treeID int64) *action.Result {
+	conn, result := connect(ctx, &i.BaseAction, instance)
+	if result != nil {
+		return result
+	}
+	defer func() { _ = conn.Close() }()
+
+	rpcCtx, cancel := context.WithTimeout(ctx, trillianutils.Timeout)
+	defer cancel()
+
+	_, err := trillian.NewTrillianAdminClient(conn).DeleteTree(rpcCtx, &trillian.DeleteTreeRequest{TreeId: treeID})
+	if err != nil && status.Code(err) != codes.NotFound {
+		return i.Error(ctx, fmt.Errorf("could not delete tree %d: %w", treeID, err), instance)
+	}
+	i.Recorder.Eventf(instance, nil, corev1.EventTypeNormal, "TrillianTreeDeleted", "Deleted", "Trillian tree deleted: %d", treeID)
+	return nil
+}
+
+// trillianUnavailable returns why the tree can't be deleted in Trillian, an empty string if it can. Trillian is often
+// removed together with the namespace, waiting for it would keep the namespace terminating forever.
+func (i deleteAction) trillianUnavailable(ctx context.Context, instance *rhtasv1.TrillianTree) (string, error) {
+	namespaces := []string{instance.Namespace}
+	if ref := instance.Spec.Trillian.Ref; ref != nil && ref.Namespace != instance.Namespace {
+		namespaces = append(namespaces, ref.Namespace)
+	}
+	for _, namespace := range namespaces {
+		terminating, err := kubernetes.NamespaceTerminating(ctx, i.Client, namespace)
+		if err != nil {
+			return "", err
+		}
+		if terminating {
+			return fmt.Sprintf("namespace %s is being deleted", namespace), nil
+		}
+	}
+
+	_, err := trillianutils.Resolve(ctx, i.Client, instance.Spec.Trillian, instance.Namespace)
+	switch {
+	case err == nil:
+		return "", nil
+	case errors.Is(err, trillianutils.ErrNotReady), errors.Is(err, serviceresolver.ErrServiceNotFound), apierrors.IsNotFound(err):
+		return err.Error(), nil
+	default:
+		return "", err
+	}
+}
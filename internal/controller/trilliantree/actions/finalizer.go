@@ -0,0 +1,41 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// NewFinalizerAction adds the finalizer when the tree has to be deleted together with the TrillianTree
+// and removes it when the tree is retained.
+func NewFinalizerAction() action.Action[*rhtasv1.TrillianTree] {
+	return &finalizerAction{}
+}
+
+type finalizerAction struct {
+	action.BaseAction
+}
+
+func (i finalizerAction) Name() string {
+	return "finalizer"
+}
+
+func (i finalizerAction) CanHandle(_ context.Context, instance *rhtasv1.TrillianTree) bool {
+	return instance.DeletionTimestamp.IsZero() &&
+		controllerutil.ContainsFinalizer(instance, Finalizer) != (instance.Spec.DeletionPolicy == rhtasv1.TrillianTreeDelete)
+}
+
+func (i finalizerAction) Handle(ctx context.Context, instance *rhtasv1.TrillianTree) *action.Result {
+	if instance.Spec.DeletionPolicy == rhtasv1.TrillianTreeDelete {
+		controllerutil.AddFinalizer(instance, Finalizer)
+	} else {
+		controllerutil.RemoveFinalizer(instance, Finalizer)
+	}
+	if err := i.Client.Update(ctx, instance); err != nil {
+		return i.Error(ctx, fmt.Errorf("could not update finalizers: %w", err), instance)
+	}
+	return i.Return()
+}
@@ -0,0 +1,63 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/trillian"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// connect opens a connection to the Trillian log server of the instance.
+// A non-nil result is returned when the action has to stop.
+func connect(ctx context.Context, base *action.BaseAction, instance *rhtasv1.TrillianTree) (*grpc.ClientConn, *action.Result) {
+	address, err := trillianutils.Resolve(ctx, base.Client, instance.Spec.Trillian, instance.Namespace)
+	if err != nil {
+		if errors.Is(err, trillianutils.ErrNotReady) {
+			base.Logger.V(1).Info("waiting for Trillian to become ready", "error", err.Error())
+			return nil, base.RequeueAfter(5 * time.Second)
+		}
+		return nil, base.Error(ctx, err, instance)
+	}
+
+	conn, err := trillianutils.Dial(ctx, base.Client, instance, address)
+	if err != nil {
+		return nil, base.Error(ctx, err, instance)
+	}
+	return conn, nil
+}
+
+// desiredTree returns the tree described by the instance spec.
+func desiredTree(instance *rhtasv1.TrillianTree) *trillian.Tree {
+	tree := &trillian.Tree{
+		TreeType:        trillian.TreeType(trillian.TreeType_value[string(instance.Spec.TreeType)]),
+		TreeState:       trillian.TreeState(trillian.TreeState_value[string(instance.Spec.State)]),
+		DisplayName:     displayName(instance),
+		MaxRootDuration: durationpb.New(time.Hour),
+	}
+	if tree.TreeType == trillian.TreeType_UNKNOWN_TREE_TYPE {
+		tree.TreeType = trillian.TreeType_LOG
+	}
+	if tree.TreeState == trillian.TreeState_UNKNOWN_TREE_STATE {
+		tree.TreeState = trillian.TreeState_ACTIVE
+	}
+	if instance.Spec.MaxRootDuration != nil {
+		tree.MaxRootDuration = durationpb.New(instance.Spec.MaxRootDuration.Duration)
+	}
+	return tree
+}
+
+func displayName(instance *rhtasv1.TrillianTree) string {
+	if instance.Spec.DisplayName != "" {
+		return instance.Spec.DisplayName
+	}
+	if len(instance.Name) > displayNameMaxLength {
+		return instance.Name[:displayNameMaxLength]
+	}
+	return instance.Name
+}
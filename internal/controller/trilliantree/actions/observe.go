@@ -0,0 +1,60 @@
+package actions
+
+import (
+	"context"
+	"encoding/hex"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+)
+
+// NewObserveAction periodically reports the size and root hash of the latest signed log root.
+func NewObserveAction() action.Action[*rhtasv1.TrillianTree] {
+	return &observeAction{}
+}
+
+type observeAction struct {
+	action.BaseAction
+}
+
+func (i observeAction) Name() string {
+	return "observe tree"
+}
+
+func (i observeAction) CanHandle(_ context.Context, instance *rhtasv1.TrillianTree) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Ready && instance.Status.TreeID != nil
+}
+
+func (i observeAction) Handle(ctx context.Context, instance *rhtasv1.TrillianTree) *action.Result {
+	if kubernetes.IsDryRun(ctx) {
+		return i.Continue()
+	}
+
+	conn, result := connect(ctx, &i.BaseAction, instance)
+	if result != nil {
+		return result
+	}
+	defer func() { _ = conn.Close() }()
+
+	rpcCtx, cancel := context.WithTimeout(ctx, trillianutils.Timeout)
+	defer cancel()
+
+	root, err := trillianutils.LatestRoot(rpcCtx, conn, *instance.Status.TreeID)
+	if err != nil {
+		// the status keeps the last observed root, try again on the next refresh
+		i.Logger.Error(err, "could not observe tree", "treeID", *instance.Status.TreeID)
+		return i.RequeueAfter(refreshInterval)
+	}
+
+	size := int64(root.TreeSize) //nolint:gosec
+	instance.Status.Size = &size
+	instance.Status.RootHash = hex.EncodeToString(root.RootHash)
+	if _, err = i.PersistStatus(ctx, instance); err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	return i.RequeueAfter(refreshInterval)
+}
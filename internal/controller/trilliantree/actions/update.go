@@ -0,0 +1,101 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/trillian"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewUpdateAction() action.Action[*rhtasv1.TrillianTree] {
+	return &updateAction{}
+}
+
+type updateAction struct {
+	action.BaseAction
+}
+
+func (i updateAction) Name() string {
+	return "update tree"
+}
+
+func (i updateAction) CanHandle(_ context.Context, instance *rhtasv1.TrillianTree) bool {
+	if state.FromInstance(instance, constants.ReadyCondition) < state.Creating || instance.Status.TreeID == nil {
+		return false
+	}
+	c := meta.FindStatusCondition(instance.GetConditions(), TreeCondition)
+	return c == nil || c.Status != metav1.ConditionTrue || c.ObservedGeneration != instance.GetGeneration()
+}
+
+func (i updateAction) Handle(ctx context.Context, instance *rhtasv1.TrillianTree) *action.Result {
+	if kubernetes.IsDryRun(ctx) {
+		return i.Continue()
+	}
+
+	conn, result := connect(ctx, &i.BaseAction, instance)
+	if result != nil {
+		return result
+	}
+	defer func() { _ = conn.Close() }()
+
+	rpcCtx, cancel := context.WithTimeout(ctx, trillianutils.Timeout)
+	defer cancel()
+
+	adminClient := trillian.NewTrillianAdminClient(conn)
+	tree, err := adminClient.GetTree(rpcCtx, &trillian.GetTreeRequest{TreeId: *instance.Status.TreeID})
+	if err != nil {
+		return i.Error(ctx, fmt.Errorf("could not get tree %d: %w", *instance.Status.TreeID, err), instance)
+	}
+
+	desired := desiredTree(instance)
+	desired.TreeId = tree.TreeId
+	var paths []string
+	if desired.TreeState != tree.TreeState {
+		paths = append(paths, "tree_state")
+	}
+	if desired.TreeType != tree.TreeType {
+		paths = append(paths, "tree_type")
+	}
+	if desired.DisplayName != tree.DisplayName {
+		paths = append(paths, "display_name")
+	}
+	if !proto.Equal(desired.MaxRootDuration, tree.MaxRootDuration) {
+		paths = append(paths, "max_root_duration")
+	}
+
+	if len(paths) > 0 {
+		tree, err = adminClient.UpdateTree(rpcCtx, &trillian.UpdateTreeRequest{
+			Tree:       desired,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		})
+		if err != nil {
+			return i.Error(ctx, fmt.Errorf("could not update tree %d: %w", desired.TreeId, err), instance, metav1.Condition{
+				Type:               TreeCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             state.Failure.String(),
+				Message:            err.Error(),
+				ObservedGeneration: instance.GetGeneration(),
+			})
+		}
+		i.Logger.Info("Trillian tree updated", "treeID", tree.TreeId, "fields", paths)
+	}
+
+	instance.Status.State = rhtasv1.TrillianTreeState(tree.GetTreeState().String())
+	instance.SetCondition(metav1.Condition{
+		Type:               TreeCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             state.Ready.String(),
+		ObservedGeneration: instance.GetGeneration(),
+	})
+	return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trilliantree
+
+import (
+	"context"
+	"time"
+
+	olpredicate "github.com/operator-framework/operator-lib/predicate"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/transitions"
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/controller"
+	tasPredicate "github.com/securesign/operator/internal/controller/predicate"
+	_ "github.com/securesign/operator/internal/controller/trillian/serviceresolver"
+	"github.com/securesign/operator/internal/controller/trilliantree/actions"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
+	ctrlutil "github.com/securesign/operator/internal/utils/controller"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+type trillianTreeReconciler struct {
+	client.Client
+	scheme   *runtime.Scheme
+	recorder events.EventRecorder
+	drift    *kubernetes.DriftTracker
+}
+
+func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
+	return &trillianTreeReconciler{
+		Client:   c,
+		scheme:   scheme,
+		recorder: recorder,
+		drift:    kubernetes.NewDriftTracker(recorder),
+	}
+}
+
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trilliantrees,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trilliantrees/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trilliantrees/finalizers,verbs=update
+
+func (r *trillianTreeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var instance rhtasv1.TrillianTree
+	log := ctrllog.FromContext(ctx)
+
+	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// the tree is deleted in Trillian even when the whole namespace is removed
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating && instance.DeletionTimestamp.IsZero() {
+		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
+		return ctrl.Result{}, nil
+	}
+
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
+	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
+	conditionSupplier := func(_ *rhtasv1.TrillianTree) []string {
+		return []string{actions.TreeCondition}
+	}
+	actionList := []action.Action[*rhtasv1.TrillianTree]{
+		actions.NewDeleteAction(),
+		actions.NewFinalizerAction(),
+
+		transitions.NewToPendingPhaseAction[*rhtasv1.TrillianTree](),
+		transitions.NewEnsureConditionsAction[*rhtasv1.TrillianTree](conditionSupplier),
+
+		transitions.NewToCreatePhaseAction[*rhtasv1.TrillianTree](),
+		actions.NewCreateAction(),
+
+		transitions.NewToInitializePhaseAction[*rhtasv1.TrillianTree](),
+		actions.NewUpdateAction(),
+
+		transitions.NewToReadyPhaseAction[*rhtasv1.TrillianTree](),
+		actions.NewObserveAction(),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), actionList)
+	}
+
+	for _, a := range actionList {
+		a.InjectClient(r.Client)
+		a.InjectLogger(log.WithName(a.Name()))
+		a.InjectRecorder(r.recorder)
+
+		if a.CanHandle(ctx, target) {
+			log.V(2).Info("Executing " + a.Name())
+			start := time.Now()
+			result := a.Handle(ctx, target)
+			metrics.ObserveAction("trilliantree", a.Name(), start, result)
+			if result != nil {
+				return result.Result, result.Err
+			}
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+func (r *trillianTreeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	pause, err := olpredicate.NewPause[client.Object](annotations.PausedReconciliation)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithEventFilter(pause).
+		For(&rhtasv1.TrillianTree{}, builder.WithPredicates(tasPredicate.ConfigurationChangedOnFailurePredicate[*rhtasv1.TrillianTree]())).
+		Watches(&rhtasv1.Trillian{}, handler.EnqueueRequestsFromMapFunc(
+			ctrlutil.ServiceRefWatch(mgr.GetClient(), &rhtasv1.TrillianTreeList{}, func(o client.Object) rhtasv1.ServiceReference {
+				return o.(*rhtasv1.TrillianTree).Spec.Trillian
+			}),
+		), builder.WithPredicates(tasPredicate.ConditionChangedPredicate[*rhtasv1.Trillian](constants.ReadyCondition))).
+		Complete(r)
+}
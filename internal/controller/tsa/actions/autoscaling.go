@@ -0,0 +1,21 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/autoscaling"
+)
+
+type tsaAutoscalingConfig struct{}
+
+func (tsaAutoscalingConfig) Autoscaling(i *rhtasv1.TimestampAuthority) rhtasv1.Autoscaling {
+	return i.Spec.Autoscaling
+}
+
+func NewAutoscalingAction() action.Action[*rhtasv1.TimestampAuthority] {
+	return autoscaling.NewAction(
+		ComponentName,
+		DeploymentName,
+		tsaAutoscalingConfig{},
+	)
+}
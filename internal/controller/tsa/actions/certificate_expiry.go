@@ -0,0 +1,24 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certexpiry"
+)
+
+type tsaCertificates struct{}
+
+func (tsaCertificates) Certificates(i *rhtasv1.TimestampAuthority) []certexpiry.Certificate {
+	if i.Status.Signer == nil {
+		return nil
+	}
+	return []certexpiry.Certificate{{Name: "certificateChain", Ref: i.Status.Signer.CertificateChainRef}}
+}
+
+func (tsaCertificates) Status(i *rhtasv1.TimestampAuthority) *[]rhtasv1.CertificateStatus {
+	return &i.Status.Certificates
+}
+
+func NewCertificateExpiryAction() action.Action[*rhtasv1.TimestampAuthority] {
+	return certexpiry.NewAction[*rhtasv1.TimestampAuthority](tsaCertificates{})
+}
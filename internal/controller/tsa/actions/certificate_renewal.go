@@ -0,0 +1,64 @@
+package actions
+
+import (
+	"context"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/certrenewal"
+	tsaUtils "github.com/securesign/operator/internal/controller/tsa/utils"
+	"github.com/securesign/operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// tsaRenewalConfig renews the leaf of the operator-generated certificate chain.
+// The root and intermediate certificates are kept.
+type tsaRenewalConfig struct{}
+
+func (tsaRenewalConfig) IsEnabled(i *rhtasv1.TimestampAuthority) bool {
+	chain := i.Spec.Signer.CertificateChain
+	return tsaUtils.IsFileType(i) && chain.CertificateChainRef == nil && chain.RootCA != nil &&
+		utils.IsEnabled(chain.Renewal.Enabled)
+}
+
+func (tsaRenewalConfig) RenewBefore(i *rhtasv1.TimestampAuthority) time.Duration {
+	renewal := i.Spec.Signer.CertificateChain.Renewal.DeepCopy()
+	renewal.SetDefaults()
+	return renewal.RenewBefore.Duration
+}
+
+func (tsaRenewalConfig) Overlap(i *rhtasv1.TimestampAuthority) time.Duration {
+	renewal := i.Spec.Signer.CertificateChain.Renewal.DeepCopy()
+	renewal.SetDefaults()
+	return renewal.Overlap.Duration
+}
+
+func (tsaRenewalConfig) CurrentRef(i *rhtasv1.TimestampAuthority) *rhtasv1.SecretKeySelector {
+	if i.Status.Signer == nil {
+		return nil
+	}
+	return i.Status.Signer.CertificateChainRef
+}
+
+func (tsaRenewalConfig) Renew(_ context.Context, i *rhtasv1.TimestampAuthority, _ client.Client, current map[string][]byte) (map[string][]byte, error) {
+	return tsaUtils.RenewLeafCertificate(current, keyAlgorithm(i))
+}
+
+func (tsaRenewalConfig) AlignStatus(i *rhtasv1.TimestampAuthority, ref rhtasv1.SecretKeySelector) {
+	alignStatus(i, ref)
+}
+
+func (tsaRenewalConfig) MutateSecret(i *rhtasv1.TimestampAuthority, secret *corev1.Secret) {
+	mutateSecret(i, secret)
+}
+
+func NewCertificateRenewalAction() action.Action[*rhtasv1.TimestampAuthority] {
+	return certrenewal.NewAction(
+		signerSecretNameFormat,
+		ComponentName,
+		DeploymentName,
+		tsaRenewalConfig{},
+	)
+}
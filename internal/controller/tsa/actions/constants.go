@@ -11,4 +11,8 @@ const (
 	MetricsPortName    = "metrics"
 	MetricsPort        = 2112
 	NtpCMName          = "ntp-config-"
+
+	TimeSynchronizedCondition = "TimeSynchronized"
+	// TimeSynchronizedPodCondition is the readiness gate of the TSA pods, set from the NTP monitoring of the pod.
+	TimeSynchronizedPodCondition = "rhtas.redhat.com/time-synchronized"
 )
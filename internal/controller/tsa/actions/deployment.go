@@ -69,14 +69,16 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1.TimestampAut
 				Namespace: instance.Namespace,
 			},
 		},
-		i.ensureDeployment(instance, RBACName, labels),
+		deployment.Autoscaling(instance.Spec.Autoscaling,
+			i.ensureDeployment(instance, RBACName, labels),
+			deployment.PodRequirements(instance.Spec.PodRequirements, DeploymentName),
+		),
 		ensure.ControllerReference[*apps.Deployment](instance, i.Client),
 		ensure.Labels[*apps.Deployment](slices.Collect(maps.Keys(labels)), labels),
 		deployment.Auth(DeploymentName, instance.Spec.Auth),
 		deployment.Proxy(),
 		deployment.GODEBUG(instance.GetAnnotations()),
 		deployment.TrustedCA(instance.GetTrustedCA(), DeploymentName),
-		deployment.PodRequirements(instance.Spec.PodRequirements, DeploymentName),
 		deployment.PodSecurityContext(),
 	); err != nil {
 		return i.Error(ctx, fmt.Errorf("could not create TSA Server: %w", err), instance)
@@ -169,6 +171,13 @@ func (i deployAction) ensureDeployment(instance *rhtasv1.TimestampAuthority, sa
 			return err
 		}
 
+		template.Spec.ReadinessGates = slices.DeleteFunc(template.Spec.ReadinessGates, func(gate core.PodReadinessGate) bool {
+			return gate.ConditionType == TimeSynchronizedPodCondition
+		})
+		if utils.IsEnabled(instance.Spec.NTPMonitoring.Enabled) && utils.IsEnabled(instance.Spec.NTPMonitoring.ReadinessGate) {
+			template.Spec.ReadinessGates = append(template.Spec.ReadinessGates, core.PodReadinessGate{ConditionType: TimeSynchronizedPodCondition})
+		}
+
 		signerType := tsaUtils.GetSignerType(&instance.Spec.Signer)
 		switch signerType {
 		case tsaUtils.KmsType:
@@ -27,6 +27,7 @@ func NewFIPSValidationAction() action.Action[*rhtasv1.TimestampAuthority] {
 					"spec.signer.certificateChain.certificateChainRef", fipsutil.ValidateCertificateChainPEM, &refs); err != nil {
 					return nil, err
 				}
+				fipsAction.AppendKeyAlgorithm(i.Spec.Signer.KeyAlgorithm, "spec.signer.keyAlgorithm", &refs)
 				return refs, nil
 			},
 		}),
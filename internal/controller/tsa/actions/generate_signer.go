@@ -2,9 +2,6 @@ package actions
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/elliptic"
-	"crypto/rand"
 	"errors"
 	"fmt"
 
@@ -13,6 +10,7 @@ import (
 	"github.com/securesign/operator/internal/action/generateSigner"
 	tsaUtils "github.com/securesign/operator/internal/controller/tsa/utils"
 	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/utils/keys"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -37,16 +35,18 @@ func NewGenerateSignerAction() action.Action[*rhtasv1.TimestampAuthority] {
 			GenerateData: generateData,
 			AlignStatus:  alignStatus,
 			IsEnabled:    isEnabled,
-			MutateSecret: func(_ *rhtasv1.TimestampAuthority, secret *corev1.Secret) {
-				if secret.Labels == nil {
-					secret.Labels = make(map[string]string)
-				}
-				secret.Labels[labels.LabelNamespace+"/tsa.certchain.pem"] = tsaUtils.KeyCertificateChain
-			},
+			MutateSecret: mutateSecret,
 		}),
 	)
 }
 
+func mutateSecret(_ *rhtasv1.TimestampAuthority, secret *corev1.Secret) {
+	if secret.Labels == nil {
+		secret.Labels = make(map[string]string)
+	}
+	secret.Labels[labels.LabelNamespace+"/tsa.certchain.pem"] = tsaUtils.KeyCertificateChain
+}
+
 func isEnabled(instance *rhtasv1.TimestampAuthority) bool {
 	return tsaUtils.IsFileType(instance)
 }
@@ -138,17 +138,25 @@ func signerStatusFromSpec(signer *rhtasv1.TimestampAuthoritySigner) *rhtasv1.Tim
 	return status
 }
 
-func generatePrivateKey() ([]byte, error) {
-	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+// keyAlgorithm returns the algorithm of the generated certificate chain keys.
+func keyAlgorithm(instance *rhtasv1.TimestampAuthority) rhtasv1.KeyAlgorithm {
+	signer := instance.Spec.Signer.DeepCopy()
+	signer.SetDefaults()
+	return signer.KeyAlgorithm
+}
+
+func generatePrivateKey(algorithm rhtasv1.KeyAlgorithm) ([]byte, error) {
+	key, err := keys.Generate(algorithm)
 	if err != nil {
 		return nil, err
 	}
-	return tsaUtils.CreatePrivateKey(key)
+	return keys.MarshalPrivateKey(key)
 }
 
 func handleSignerKeys(instance *rhtasv1.TimestampAuthority, config *tsaUtils.TsaCertChainConfig) (*tsaUtils.TsaCertChainConfig, error) {
+	algorithm := keyAlgorithm(instance)
 	if instance.Spec.Signer.CertificateChain.RootCA != nil {
-		rootKey, err := generatePrivateKey()
+		rootKey, err := generatePrivateKey(algorithm)
 		if err != nil {
 			return nil, err
 		}
@@ -156,7 +164,7 @@ func handleSignerKeys(instance *rhtasv1.TimestampAuthority, config *tsaUtils.Tsa
 	}
 
 	for range instance.Spec.Signer.CertificateChain.IntermediateCA {
-		interKey, err := generatePrivateKey()
+		interKey, err := generatePrivateKey(algorithm)
 		if err != nil {
 			return nil, err
 		}
@@ -165,7 +173,7 @@ func handleSignerKeys(instance *rhtasv1.TimestampAuthority, config *tsaUtils.Tsa
 	}
 
 	if instance.Spec.Signer.CertificateChain.LeafCA != nil {
-		leafKey, err := generatePrivateKey()
+		leafKey, err := generatePrivateKey(algorithm)
 		if err != nil {
 			return nil, err
 		}
@@ -224,6 +224,75 @@ func TestTSASigner_GeneratesData(t *testing.T) {
 	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, TSASignerCondition)).To(BeTrue())
 }
 
+func TestTSASigner_GeneratesChainWithKeyAlgorithm(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := tsaInstance()
+	instance.Spec.Signer.KeyAlgorithm = rhtasv1.KeyAlgorithmRSA3072
+
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance).
+		WithStatusSubresource(instance).
+		Build()
+	a := testAction.PrepareAction(c, NewGenerateSignerAction())
+	g.Expect(a.Handle(ctx, instance)).To(Equal(testAction.Return()))
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "tsa-signer-config-tsa", Namespace: "default"}, secret)).To(Succeed())
+	g.Expect(secret.Data[tsaUtils.KeyLeafPrivateKey]).To(ContainSubstring("RSA PRIVATE KEY"))
+
+	chain := parseCertificates(g, secret.Data[tsaUtils.KeyCertificateChain])
+	g.Expect(chain).To(HaveLen(3))
+	for _, cert := range chain {
+		g.Expect(cert.PublicKeyAlgorithm).To(Equal(x509.RSA))
+	}
+}
+
+func TestTSASigner_RenewsLeafCertificate(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := tsaInstance()
+
+	c := testAction.FakeClientBuilder().
+		WithObjects(instance).
+		WithStatusSubresource(instance).
+		Build()
+	a := testAction.PrepareAction(c, NewGenerateSignerAction())
+	g.Expect(a.Handle(ctx, instance)).To(Equal(testAction.Return()))
+
+	secret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "tsa-signer-config-tsa", Namespace: "default"}, secret)).To(Succeed())
+
+	renewed, err := tsaRenewalConfig{}.Renew(ctx, instance, c, secret.Data)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(renewed[tsaUtils.KeyLeafPrivateKey]).ToNot(Equal(secret.Data[tsaUtils.KeyLeafPrivateKey]))
+	g.Expect(renewed).ToNot(HaveKey(tsaUtils.KeyLeafPrivateKeyPassword))
+
+	previous := parseCertificates(g, secret.Data[tsaUtils.KeyCertificateChain])
+	chain := parseCertificates(g, renewed[tsaUtils.KeyCertificateChain])
+	g.Expect(chain).To(HaveLen(len(previous)))
+	for i := 1; i < len(chain); i++ {
+		g.Expect(chain[i].Equal(previous[i])).To(BeTrue())
+	}
+	g.Expect(chain[0].CheckSignatureFrom(chain[len(chain)-1])).To(Succeed())
+	g.Expect(chain[0].Subject.String()).To(Equal(previous[0].Subject.String()))
+	g.Expect(chain[0].ExtKeyUsage).To(ContainElement(x509.ExtKeyUsageTimeStamping))
+	g.Expect(chain[0].SerialNumber).ToNot(Equal(previous[0].SerialNumber))
+
+	_, err = tsaUtils.RenewLeafCertificate(map[string][]byte{tsaUtils.KeyCertificateChain: secret.Data[tsaUtils.KeyCertificateChain]}, rhtasv1.KeyAlgorithmECDSAP384)
+	g.Expect(err).To(MatchError(tsaUtils.ErrMissingRootPrivateKey))
+}
+
+func parseCertificates(g Gomega, data []byte) []*x509.Certificate {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		g.Expect(err).ToNot(HaveOccurred())
+		certs = append(certs, cert)
+	}
+	return certs
+}
+
 func TestTSASigner_MigrationFromPreExistingSecret(t *testing.T) {
 	g := NewWithT(t)
 	ctx := t.Context()
@@ -284,7 +353,7 @@ func TestTSASigner_UnencryptedKeyAllowedInFIPS(t *testing.T) {
 	fips.Enabled = func() bool { return true }
 	t.Cleanup(func() { fips.Enabled = original })
 
-	unencryptedKey, err := generatePrivateKey()
+	unencryptedKey, err := generatePrivateKey(rhtasv1.KeyAlgorithmECDSAP384)
 	g.Expect(err).ToNot(HaveOccurred())
 
 	instance := tsaInstance()
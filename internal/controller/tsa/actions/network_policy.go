@@ -0,0 +1,36 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type tsaNetworkPolicyConfig struct{}
+
+func (tsaNetworkPolicyConfig) IsEnabled(i *rhtasv1.TimestampAuthority) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (tsaNetworkPolicyConfig) Ingress(_ *rhtasv1.TimestampAuthority) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{ServerPort},
+			networkpolicy.FromIngressControllers(),
+			networkpolicy.FromOperator(),
+		),
+		networkpolicy.Rule([]int32{MetricsPort},
+			networkpolicy.FromMonitoring(),
+			networkpolicy.FromOperator(),
+		),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.TimestampAuthority] {
+	return networkpolicy.NewAction(
+		ComponentName,
+		DeploymentName,
+		tsaNetworkPolicyConfig{},
+	)
+}
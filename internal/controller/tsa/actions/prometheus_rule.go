@@ -0,0 +1,47 @@
+package actions
+
+import (
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/alerting"
+	"github.com/securesign/operator/internal/utils"
+)
+
+type tsaAlertingConfig struct{}
+
+func (tsaAlertingConfig) PrometheusRule(i *rhtasv1.TimestampAuthority) rhtasv1.PrometheusRuleConfig {
+	return i.Spec.Monitoring.PrometheusRule
+}
+
+func (tsaAlertingConfig) Rules(i *rhtasv1.TimestampAuthority) []alerting.Rule {
+	rules := []alerting.Rule{
+		alerting.CertificateExpiryRule("TSACertificateExpiring", "TimestampAuthority", i.Name),
+	}
+	if utils.IsEnabled(i.Spec.NTPMonitoring.Enabled) {
+		rules = append(rules, alerting.Rule{
+			Alert:       "TSANTPDrift",
+			Threshold:   "0",
+			For:         "5m",
+			Severity:    alerting.SeverityCritical,
+			Summary:     "Timestamp authority clock is out of sync",
+			Description: "The timestamp authority failed {{ $value }} NTP checks in the last 15 minutes; the local clock drifts from the NTP servers or they are unreachable.",
+			Expr: func(namespace, threshold string) string {
+				return fmt.Sprintf(`increase(timestamp_authority_ntp_errors_total{namespace=%q,service=%q}[15m]) > %s`,
+					namespace, DeploymentName, threshold)
+			},
+		})
+	}
+	return rules
+}
+
+func NewPrometheusRuleAction() action.Action[*rhtasv1.TimestampAuthority] {
+	return alerting.NewAction(
+		ComponentName,
+		MonitoringRoleName,
+		DeploymentName,
+		"",
+		tsaAlertingConfig{},
+	)
+}
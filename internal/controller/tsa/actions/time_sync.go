@@ -0,0 +1,327 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	tsaUtils "github.com/securesign/operator/internal/controller/tsa/utils"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	"github.com/securesign/operator/internal/utils"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// timeSyncCheckInterval is the time between checks if the clocks of all pods are synchronized.
+	timeSyncCheckInterval = 2 * time.Minute
+	// timeSyncRetryInterval is the time between checks while the clock of a pod is out of sync.
+	timeSyncRetryInterval = 30 * time.Second
+	// defaultMaxTimeDelta is the allowed drift if spec.ntpMonitoring.config.maxTimeDelta is not set.
+	defaultMaxTimeDelta = 6 * time.Second
+)
+
+// NewTimeSyncAction scrapes the NTP monitoring metrics of the TSA pods and reports their time synchronization.
+func NewTimeSyncAction(samples *tsaUtils.NTPSamples) action.Action[*rhtasv1.TimestampAuthority] {
+	return &timeSyncAction{samples: samples, now: time.Now}
+}
+
+type timeSyncAction struct {
+	action.BaseAction
+	samples *tsaUtils.NTPSamples
+	now     func() time.Time
+}
+
+func (i timeSyncAction) Name() string {
+	return "time sync"
+}
+
+func (i timeSyncAction) CanHandle(_ context.Context, instance *rhtasv1.TimestampAuthority) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) >= state.Initialize &&
+		(timeSyncEnabled(instance) || instance.Status.TimeSynchronization != nil ||
+			meta.FindStatusCondition(instance.Status.Conditions, TimeSynchronizedCondition) != nil)
+}
+
+func (i timeSyncAction) Handle(ctx context.Context, instance *rhtasv1.TimestampAuthority) *action.Result {
+	if !timeSyncEnabled(instance) {
+		instance.Status.TimeSynchronization = nil
+		meta.RemoveStatusCondition(&instance.Status.Conditions, TimeSynchronizedCondition)
+		return i.ReturnOnChange(i.PersistStatus)(ctx, instance)
+	}
+	if kubernetes.IsDryRun(ctx) {
+		return i.Continue()
+	}
+
+	pods, err := i.runningPods(ctx, instance)
+	if err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	if len(pods) == 0 || (!TimeSyncCheckDue(instance, i.now()) && checkedPods(instance).Equal(podNames(pods))) {
+		return i.Continue()
+	}
+
+	last := make(map[string]rhtasv1.TimestampAuthorityPodTimeSync)
+	if instance.Status.TimeSynchronization != nil {
+		for _, p := range instance.Status.TimeSynchronization.Pods {
+			last[p.Name] = p
+		}
+	}
+	status := &rhtasv1.TimestampAuthorityTimeSyncStatus{
+		ServerThreshold: serverThreshold(instance),
+		LastCheckTime:   metav1.NewTime(i.now()),
+	}
+	httpClient := httputils.GetClientBuilder()()
+	healthy := sets.New[string]()
+	var outOfSync []string
+	for _, pod := range pods {
+		podStatus, servers, scrapeErr := i.checkPod(ctx, httpClient, instance, pod)
+		if scrapeErr != nil {
+			podStatus.Error = scrapeErr.Error()
+		}
+		healthy.Insert(servers...)
+		status.Pods = append(status.Pods, podStatus)
+
+		previous, checked := last[pod.Name]
+		switch {
+		case !podStatus.Synchronized:
+			outOfSync = append(outOfSync, pod.Name)
+			if checked && previous.Synchronized {
+				i.Recorder.Eventf(instance, pod, core.EventTypeWarning, "TimeOutOfSync", "TimeSync",
+					"Clock of pod %s is out of sync: %s", pod.Name, podStatus.Error)
+			}
+		case checked && !previous.Synchronized:
+			i.Recorder.Eventf(instance, pod, core.EventTypeNormal, "TimeSynchronized", "TimeSync",
+				"Clock of pod %s is synchronized", pod.Name)
+		}
+
+		// the readiness gate is not changed if the metrics of the pod could not be read
+		if utils.IsEnabled(instance.Spec.NTPMonitoring.ReadinessGate) && scrapeErr == nil {
+			if err = i.setPodCondition(ctx, pod, podStatus); err != nil {
+				return i.Error(ctx, fmt.Errorf("could not update readiness gate of pod %s: %w", pod.Name, err), instance)
+			}
+		}
+	}
+	status.HealthyServers = int32(healthy.Len()) //nolint:gosec
+
+	condition := metav1.Condition{
+		Type:               TimeSynchronizedCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Synchronized",
+		Message:            fmt.Sprintf("%d of %d pods synchronized with %d NTP servers", len(pods), len(pods), status.HealthyServers),
+		ObservedGeneration: instance.Generation,
+	}
+	if len(outOfSync) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "OutOfSync"
+		condition.Message = fmt.Sprintf("%d of %d pods out of sync: %s", len(outOfSync), len(pods), strings.Join(outOfSync, ", "))
+	}
+
+	instance.Status.TimeSynchronization = status
+	instance.SetCondition(condition)
+	if _, err = i.PersistStatus(ctx, instance); err != nil {
+		return i.Error(ctx, err, instance)
+	}
+	return i.Continue()
+}
+
+// checkPod returns the time synchronization of the pod and the NTP servers that answered it. The error is returned
+// if the metrics of the pod could not be read.
+func (i timeSyncAction) checkPod(ctx context.Context, httpClient *http.Client, instance *rhtasv1.TimestampAuthority, pod *core.Pod) (rhtasv1.TimestampAuthorityPodTimeSync, []string, error) {
+	status := rhtasv1.TimestampAuthorityPodTimeSync{Name: pod.Name}
+	metrics, drift, err := i.scrape(ctx, httpClient, pod)
+	if err != nil {
+		return status, nil, err
+	}
+	servers, errors := i.samples.Observe(pod.UID, metrics, i.now())
+	status.HealthyServers = int32(len(servers)) //nolint:gosec
+	if drift != nil {
+		status.Drift = &metav1.Duration{Duration: *drift}
+	}
+
+	maxDrift := defaultMaxTimeDelta
+	if instance.Spec.NTPMonitoring.Config != nil && instance.Spec.NTPMonitoring.Config.MaxTimeDelta > 0 {
+		maxDrift = time.Duration(instance.Spec.NTPMonitoring.Config.MaxTimeDelta) * time.Second
+	}
+	switch {
+	case errors > 0:
+		status.Error = fmt.Sprintf("%.0f failed NTP checks", errors)
+	case len(servers) == 0:
+		status.Error = "no NTP server answered"
+	case drift != nil && drift.Abs() > maxDrift:
+		status.Error = fmt.Sprintf("drift %s exceeds %s", drift, maxDrift)
+	default:
+		status.Synchronized = true
+	}
+	return status, servers, nil
+}
+
+// scrape reads the NTP monitoring metrics of the pod. The drift of the pod clock is estimated from the Date header
+// of the response.
+func (i timeSyncAction) scrape(ctx context.Context, httpClient *http.Client, pod *core.Pod) (tsaUtils.NTPMetrics, *time.Duration, error) {
+	url := "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(MetricsPort)) + "/metrics"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return tsaUtils.NTPMetrics{}, nil, err
+	}
+	sent := i.now()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return tsaUtils.NTPMetrics{}, nil, err
+	}
+	received := i.now()
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return tsaUtils.NTPMetrics{}, nil, fmt.Errorf("GET %s returned status %d", url, resp.StatusCode)
+	}
+	metrics, err := tsaUtils.ParseNTPMetrics(io.LimitReader(resp.Body, 4<<20))
+	if err != nil {
+		return tsaUtils.NTPMetrics{}, nil, err
+	}
+
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return metrics, nil, nil
+	}
+	// the Date header is truncated to seconds, the pod clock is in the middle of the second on average
+	drift := date.Add(500 * time.Millisecond).Sub(sent.Add(received.Sub(sent) / 2)).Truncate(time.Second)
+	return metrics, &drift, nil
+}
+
+func (i timeSyncAction) setPodCondition(ctx context.Context, pod *core.Pod, status rhtasv1.TimestampAuthorityPodTimeSync) error {
+	condition := core.PodCondition{
+		Type:               TimeSynchronizedPodCondition,
+		Status:             core.ConditionTrue,
+		Reason:             "Synchronized",
+		LastTransitionTime: metav1.NewTime(i.now()),
+	}
+	if !status.Synchronized {
+		condition.Status = core.ConditionFalse
+		condition.Reason = "OutOfSync"
+		condition.Message = status.Error
+	}
+
+	patch := client.StrategicMergeFrom(pod.DeepCopy())
+	index := slices.IndexFunc(pod.Status.Conditions, func(c core.PodCondition) bool { return c.Type == TimeSynchronizedPodCondition })
+	switch {
+	case index < 0:
+		pod.Status.Conditions = append(pod.Status.Conditions, condition)
+	case pod.Status.Conditions[index].Status == condition.Status:
+		return nil
+	default:
+		pod.Status.Conditions[index] = condition
+	}
+	return i.Client.Status().Patch(ctx, pod, patch)
+}
+
+func (i timeSyncAction) runningPods(ctx context.Context, instance *rhtasv1.TimestampAuthority) ([]*core.Pod, error) {
+	list := &core.PodList{}
+	if err := i.Client.List(ctx, list, client.InNamespace(instance.Namespace),
+		client.MatchingLabels(labels.For(ComponentName, DeploymentName, instance.Name))); err != nil {
+		return nil, fmt.Errorf("could not list TSA pods: %w", err)
+	}
+	var pods []*core.Pod
+	for _, pod := range list.Items {
+		if podRunning(&pod) {
+			pods = append(pods, &pod)
+		}
+	}
+	slices.SortFunc(pods, func(a, b *core.Pod) int { return strings.Compare(a.Name, b.Name) })
+	return pods, nil
+}
+
+// TimeSyncCheckDue reports whether the time synchronization of the TSA pods hasn't been checked within the interval.
+func TimeSyncCheckDue(instance *rhtasv1.TimestampAuthority, now time.Time) bool {
+	if !timeSyncEnabled(instance) {
+		return false
+	}
+	status := instance.Status.TimeSynchronization
+	if status == nil {
+		return true
+	}
+	interval := timeSyncCheckInterval
+	if slices.ContainsFunc(status.Pods, func(p rhtasv1.TimestampAuthorityPodTimeSync) bool { return !p.Synchronized }) {
+		interval = timeSyncRetryInterval
+	}
+	return now.Sub(status.LastCheckTime.Time) >= interval
+}
+
+// TimeSyncPodStarted accepts TSA server pods that started running, so a new pod is checked without waiting for the
+// check interval.
+func TimeSyncPodStarted() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return tsaServerPod(e.Object) && podRunning(e.Object.(*core.Pod))
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return tsaServerPod(e.ObjectNew) && !podRunning(e.ObjectOld.(*core.Pod)) && podRunning(e.ObjectNew.(*core.Pod))
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// TimeSyncPodInstance maps a TSA server pod to its TimestampAuthority.
+func TimeSyncPodInstance(_ context.Context, pod client.Object) []reconcile.Request {
+	instance, ok := pod.GetLabels()[labels.LabelAppInstance]
+	if !ok || !tsaServerPod(pod) {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: pod.GetNamespace(), Name: instance}}}
+}
+
+func tsaServerPod(obj client.Object) bool {
+	l := obj.GetLabels()
+	return l[labels.LabelAppComponent] == ComponentName && l[labels.LabelAppName] == DeploymentName
+}
+
+func podRunning(pod *core.Pod) bool {
+	return pod.Status.Phase == core.PodRunning && pod.Status.PodIP != "" && pod.DeletionTimestamp.IsZero()
+}
+
+func timeSyncEnabled(instance *rhtasv1.TimestampAuthority) bool {
+	return utils.IsEnabled(instance.Spec.NTPMonitoring.Enabled)
+}
+
+func serverThreshold(instance *rhtasv1.TimestampAuthority) int32 {
+	if instance.Spec.NTPMonitoring.Config == nil {
+		return 0
+	}
+	return int32(instance.Spec.NTPMonitoring.Config.ServerThreshold) //nolint:gosec
+}
+
+func checkedPods(instance *rhtasv1.TimestampAuthority) sets.Set[string] {
+	names := sets.New[string]()
+	if instance.Status.TimeSynchronization != nil {
+		for _, p := range instance.Status.TimeSynchronization.Pods {
+			names.Insert(p.Name)
+		}
+	}
+	return names
+}
+
+func podNames(pods []*core.Pod) sets.Set[string] {
+	names := sets.New[string]()
+	for _, pod := range pods {
+		names.Insert(pod.Name)
+	}
+	return names
+}
@@ -0,0 +1,324 @@
+package actions
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	tsaUtils "github.com/securesign/operator/internal/controller/tsa/utils"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	httpmock "github.com/securesign/operator/internal/testing/http"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func ntpMetrics(succeeded, failed, errors int) string {
+	return fmt.Sprintf(`# TYPE timestamp_authority_ntp_sync_total counter
+timestamp_authority_ntp_sync_total{failed="false",host="time.example.com"} %d
+timestamp_authority_ntp_sync_total{failed="false",host="time2.example.com"} %d
+timestamp_authority_ntp_sync_total{failed="true",host="time3.example.com"} %d
+# TYPE timestamp_authority_ntp_errors_total counter
+timestamp_authority_ntp_errors_total{reason="err_too_few"} %d
+`, succeeded, succeeded, failed, errors)
+}
+
+func TestTimeSync_Handle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	respond := func(status int, body string, date time.Time) httpmock.RoundTripFunc {
+		return func(_ *http.Request) *http.Response {
+			header := make(http.Header)
+			header.Set("Date", date.Format(http.TimeFormat))
+			return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}
+		}
+	}
+
+	tests := []struct {
+		name          string
+		ntpMonitoring rhtasv1.NTPMonitoring
+		status        *rhtasv1.TimestampAuthorityTimeSyncStatus
+		responses     map[string]httpmock.RoundTripFunc
+		want          *action.Result
+		verify        func(Gomega, *rhtasv1.TimestampAuthority, []core.Pod, []string)
+	}{
+		{
+			name: "synchronized pods",
+			responses: map[string]httpmock.RoundTripFunc{
+				"http://10.0.0.1:2112/metrics": respond(http.StatusOK, ntpMetrics(5, 1, 0), now),
+				"http://10.0.0.2:2112/metrics": respond(http.StatusOK, ntpMetrics(5, 0, 0), now.Add(-2*time.Second)),
+			},
+			verify: func(g Gomega, tsa *rhtasv1.TimestampAuthority, pods []core.Pod, events []string) {
+				status := tsa.Status.TimeSynchronization
+				g.Expect(status.HealthyServers).To(Equal(int32(2)))
+				g.Expect(status.LastCheckTime.Time).To(BeTemporally("==", now))
+				g.Expect(status.Pods).To(HaveLen(2))
+				g.Expect(status.Pods[0].Name).To(Equal("tsa-0"))
+				g.Expect(status.Pods[0].Synchronized).To(BeTrue())
+				g.Expect(status.Pods[0].HealthyServers).To(Equal(int32(2)))
+				g.Expect(status.Pods[0].Drift.Duration).To(Equal(time.Duration(0)))
+				g.Expect(status.Pods[1].Drift.Duration).To(Equal(-time.Second))
+
+				condition := meta.FindStatusCondition(tsa.Status.Conditions, TimeSynchronizedCondition)
+				g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				g.Expect(condition.Message).To(Equal("2 of 2 pods synchronized with 2 NTP servers"))
+				g.Expect(pods[0].Status.Conditions).To(BeEmpty())
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name: "pods out of sync",
+			status: &rhtasv1.TimestampAuthorityTimeSyncStatus{
+				Pods: []rhtasv1.TimestampAuthorityPodTimeSync{
+					{Name: "tsa-0", Synchronized: true, HealthyServers: 2},
+					{Name: "tsa-1", Synchronized: true, HealthyServers: 2},
+				},
+				LastCheckTime: metav1.NewTime(now.Add(-5 * time.Minute)),
+			},
+			responses: map[string]httpmock.RoundTripFunc{
+				"http://10.0.0.1:2112/metrics": respond(http.StatusOK, ntpMetrics(5, 3, 2), now),
+				"http://10.0.0.2:2112/metrics": respond(http.StatusOK, ntpMetrics(5, 0, 0), now.Add(10*time.Second)),
+			},
+			verify: func(g Gomega, tsa *rhtasv1.TimestampAuthority, _ []core.Pod, events []string) {
+				status := tsa.Status.TimeSynchronization
+				g.Expect(status.Pods[0].Synchronized).To(BeFalse())
+				g.Expect(status.Pods[0].Error).To(Equal("2 failed NTP checks"))
+				g.Expect(status.Pods[1].Synchronized).To(BeFalse())
+				g.Expect(status.Pods[1].Error).To(Equal("drift 10s exceeds 6s"))
+
+				condition := meta.FindStatusCondition(tsa.Status.Conditions, TimeSynchronizedCondition)
+				g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+				g.Expect(condition.Reason).To(Equal("OutOfSync"))
+				g.Expect(condition.Message).To(Equal("2 of 2 pods out of sync: tsa-0, tsa-1"))
+				g.Expect(events).To(HaveLen(2))
+				g.Expect(events[0]).To(Equal("Warning TimeOutOfSync Clock of pod tsa-0 is out of sync: 2 failed NTP checks"))
+			},
+		},
+		{
+			name: "readiness gate",
+			ntpMonitoring: rhtasv1.NTPMonitoring{
+				Enabled:       ptr.To(true),
+				ReadinessGate: ptr.To(true),
+				Config:        &rhtasv1.NtpMonitoringConfig{MaxTimeDelta: 15, ServerThreshold: 2},
+			},
+			responses: map[string]httpmock.RoundTripFunc{
+				"http://10.0.0.1:2112/metrics": respond(http.StatusOK, ntpMetrics(5, 0, 0), now.Add(10*time.Second)),
+				"http://10.0.0.2:2112/metrics": respond(http.StatusOK, ntpMetrics(0, 3, 0), now),
+			},
+			verify: func(g Gomega, tsa *rhtasv1.TimestampAuthority, pods []core.Pod, _ []string) {
+				status := tsa.Status.TimeSynchronization
+				g.Expect(status.ServerThreshold).To(Equal(int32(2)))
+				g.Expect(status.Pods[0].Synchronized).To(BeTrue())
+				g.Expect(status.Pods[1].Error).To(Equal("no NTP server answered"))
+
+				g.Expect(pods[0].Status.Conditions).To(ContainElement(And(
+					HaveField("Type", core.PodConditionType(TimeSynchronizedPodCondition)),
+					HaveField("Status", core.ConditionTrue),
+				)))
+				g.Expect(pods[1].Status.Conditions).To(ContainElement(And(
+					HaveField("Type", core.PodConditionType(TimeSynchronizedPodCondition)),
+					HaveField("Status", core.ConditionFalse),
+					HaveField("Message", "no NTP server answered"),
+				)))
+			},
+		},
+		{
+			name: "metrics unavailable",
+			ntpMonitoring: rhtasv1.NTPMonitoring{
+				Enabled:       ptr.To(true),
+				ReadinessGate: ptr.To(true),
+			},
+			responses: map[string]httpmock.RoundTripFunc{
+				"http://10.0.0.1:2112/metrics": respond(http.StatusServiceUnavailable, "", now),
+				"http://10.0.0.2:2112/metrics": respond(http.StatusOK, ntpMetrics(5, 0, 0), now),
+			},
+			verify: func(g Gomega, tsa *rhtasv1.TimestampAuthority, pods []core.Pod, _ []string) {
+				status := tsa.Status.TimeSynchronization
+				g.Expect(status.Pods[0].Synchronized).To(BeFalse())
+				g.Expect(status.Pods[0].Error).To(Equal("GET http://10.0.0.1:2112/metrics returned status 503"))
+				g.Expect(pods[0].Status.Conditions).To(BeEmpty())
+				g.Expect(pods[1].Status.Conditions).To(HaveLen(1))
+			},
+		},
+		{
+			name: "checked within the interval",
+			status: &rhtasv1.TimestampAuthorityTimeSyncStatus{
+				HealthyServers: 4,
+				Pods: []rhtasv1.TimestampAuthorityPodTimeSync{
+					{Name: "tsa-0", Synchronized: true, HealthyServers: 4},
+					{Name: "tsa-1", Synchronized: true, HealthyServers: 4},
+				},
+				LastCheckTime: metav1.NewTime(now.Add(-time.Minute)),
+			},
+			verify: func(g Gomega, tsa *rhtasv1.TimestampAuthority, _ []core.Pod, _ []string) {
+				g.Expect(tsa.Status.TimeSynchronization.HealthyServers).To(Equal(int32(4)))
+				g.Expect(tsa.Status.TimeSynchronization.LastCheckTime.Time).To(BeTemporally("==", now.Add(-time.Minute)))
+			},
+		},
+		{
+			name:          "monitoring disabled",
+			ntpMonitoring: rhtasv1.NTPMonitoring{Enabled: ptr.To(false)},
+			want:          testAction.Return(),
+			status: &rhtasv1.TimestampAuthorityTimeSyncStatus{
+				Pods:          []rhtasv1.TimestampAuthorityPodTimeSync{{Name: "tsa-0", Synchronized: true}},
+				LastCheckTime: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			verify: func(g Gomega, tsa *rhtasv1.TimestampAuthority, _ []core.Pod, _ []string) {
+				g.Expect(tsa.Status.TimeSynchronization).To(BeNil())
+				g.Expect(meta.FindStatusCondition(tsa.Status.Conditions, TimeSynchronizedCondition)).To(BeNil())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := t.Context()
+
+			mockClient := &http.Client{}
+			httpmock.SetMockTransport(mockClient, tt.responses)
+			orig := httputils.GetClientBuilder()
+			httputils.SetClientBuilder(func(_ ...[]byte) *http.Client { return mockClient })
+			t.Cleanup(func() { httputils.SetClientBuilder(orig) })
+
+			ntpMonitoring := tt.ntpMonitoring
+			if ntpMonitoring.Enabled == nil {
+				ntpMonitoring.Enabled = ptr.To(true)
+			}
+			instance := &rhtasv1.TimestampAuthority{
+				ObjectMeta: metav1.ObjectMeta{Name: "tsa", Namespace: "default"},
+				Spec:       rhtasv1.TimestampAuthoritySpec{NTPMonitoring: ntpMonitoring},
+				Status:     rhtasv1.TimestampAuthorityStatus{TimeSynchronization: tt.status},
+			}
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:   constants.ReadyCondition,
+				Status: metav1.ConditionTrue,
+				Reason: state.Ready.String(),
+			})
+			if tt.status != nil {
+				meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+					Type: TimeSynchronizedCondition, Status: metav1.ConditionTrue, Reason: "Synchronized",
+				})
+			}
+			pods := make([]client.Object, 0, 2)
+			for n := range 2 {
+				pods = append(pods, &core.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("tsa-%d", n),
+						Namespace: "default",
+						UID:       types.UID(fmt.Sprintf("%s-%d", tt.name, n)),
+						Labels:    labels.For(ComponentName, DeploymentName, instance.Name),
+					},
+					Status: core.PodStatus{Phase: core.PodRunning, PodIP: fmt.Sprintf("10.0.0.%d", n+1)},
+				})
+			}
+
+			c := testAction.FakeClientBuilder().
+				WithObjects(instance).
+				WithObjects(pods...).
+				WithStatusSubresource(append(pods, instance)...).
+				Build()
+			recorder := events.NewFakeRecorder(10)
+			a := testAction.PrepareAction(c, &timeSyncAction{samples: tsaUtils.NewNTPSamples(), now: func() time.Time { return now }})
+			a.InjectRecorder(recorder)
+
+			g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+			want := tt.want
+			if want == nil {
+				want = testAction.Continue()
+			}
+			g.Expect(a.Handle(ctx, instance)).To(Equal(want))
+
+			updated := &rhtasv1.TimestampAuthority{}
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(instance), updated)).To(Succeed())
+			list := &core.PodList{}
+			g.Expect(c.List(ctx, list)).To(Succeed())
+			close(recorder.Events)
+			var recorded []string
+			for e := range recorder.Events {
+				recorded = append(recorded, e)
+			}
+			tt.verify(g, updated, list.Items, recorded)
+		})
+	}
+}
+
+func TestTimeSyncPodStarted(t *testing.T) {
+	g := NewWithT(t)
+	pod := func(component string, phase core.PodPhase, ip string) *core.Pod {
+		return &core.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tsa-0",
+				Namespace: "default",
+				Labels:    labels.For(component, DeploymentName, "tsa"),
+			},
+			Status: core.PodStatus{Phase: phase, PodIP: ip},
+		}
+	}
+	pending := pod(ComponentName, core.PodPending, "")
+	running := pod(ComponentName, core.PodRunning, "10.0.0.1")
+	p := TimeSyncPodStarted()
+
+	g.Expect(p.Create(event.CreateEvent{Object: running})).To(BeTrue())
+	g.Expect(p.Create(event.CreateEvent{Object: pending})).To(BeFalse())
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: pending, ObjectNew: running})).To(BeTrue())
+	g.Expect(p.Update(event.UpdateEvent{ObjectOld: running, ObjectNew: running.DeepCopy()})).To(BeFalse(), "condition updates of a checked pod")
+	g.Expect(p.Create(event.CreateEvent{Object: pod("other", core.PodRunning, "10.0.0.2")})).To(BeFalse())
+	g.Expect(p.Delete(event.DeleteEvent{Object: running})).To(BeFalse())
+
+	g.Expect(TimeSyncPodInstance(t.Context(), running)).To(ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "tsa"}},
+	))
+	g.Expect(TimeSyncPodInstance(t.Context(), pod("other", core.PodRunning, "10.0.0.2"))).To(BeEmpty())
+}
+
+func TestNTPSamples_Observe(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	samples := tsaUtils.NewNTPSamples()
+	parse := func(body string) tsaUtils.NTPMetrics {
+		metrics, err := tsaUtils.ParseNTPMetrics(strings.NewReader(body))
+		g.Expect(err).ToNot(HaveOccurred())
+		return metrics
+	}
+
+	healthy, errors := samples.Observe("pod", parse(ntpMetrics(5, 1, 1)), now)
+	g.Expect(healthy).To(Equal([]string{"time.example.com", "time2.example.com"}))
+	g.Expect(errors).To(Equal(1.0))
+
+	// no query since the previous sample
+	healthy, errors = samples.Observe("pod", parse(ntpMetrics(5, 1, 1)), now.Add(time.Minute))
+	g.Expect(healthy).To(HaveLen(2))
+	g.Expect(errors).To(Equal(1.0))
+
+	healthy, errors = samples.Observe("pod", parse(ntpMetrics(5, 3, 1)), now.Add(2*time.Minute))
+	g.Expect(healthy).To(BeEmpty())
+	g.Expect(errors).To(Equal(0.0))
+
+	healthy, errors = samples.Observe("pod", parse(ntpMetrics(6, 3, 1)), now.Add(3*time.Minute))
+	g.Expect(healthy).To(HaveLen(2))
+	g.Expect(errors).To(Equal(0.0))
+
+	// counters reset by a container restart
+	healthy, errors = samples.Observe("pod", parse(ntpMetrics(1, 0, 0)), now.Add(4*time.Minute))
+	g.Expect(healthy).To(HaveLen(2))
+	g.Expect(errors).To(Equal(0.0))
+
+	// samples of deleted pods expire
+	healthy, _ = samples.Observe("pod", parse(ntpMetrics(1, 0, 0)), now.Add(2*time.Hour))
+	g.Expect(healthy).To(HaveLen(2))
+}
@@ -18,6 +18,7 @@ package tsa
 
 import (
 	"context"
+	"time"
 
 	"github.com/securesign/operator/internal/action"
 	"github.com/securesign/operator/internal/action/transitions"
@@ -25,44 +26,60 @@ import (
 	"github.com/securesign/operator/internal/annotations"
 	"github.com/securesign/operator/internal/controller"
 	"github.com/securesign/operator/internal/controller/predicate"
+	"github.com/securesign/operator/internal/dryrun"
+	"github.com/securesign/operator/internal/metrics"
 	fipsutil "github.com/securesign/operator/internal/utils/fips"
-	"k8s.io/apimachinery/pkg/types"
+	"github.com/securesign/operator/internal/utils/kubernetes"
 	"k8s.io/client-go/tools/events"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	olpredicate "github.com/operator-framework/operator-lib/predicate"
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/controller/tsa/actions"
 	_ "github.com/securesign/operator/internal/controller/tsa/serviceresolver"
+	tsaUtils "github.com/securesign/operator/internal/controller/tsa/utils"
+	ctrlutil "github.com/securesign/operator/internal/utils/controller"
 	v1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v12 "k8s.io/api/core/v1"
 	v13 "k8s.io/api/networking/v1"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	crpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// timeSyncCheckTick is how often the instances with a due time synchronization check are enqueued.
+const timeSyncCheckTick = 30 * time.Second
+
 // timestampAuthorityReconciler reconciles a TimestampAuthority object
 type timestampAuthorityReconciler struct {
 	client.Client
-	scheme   *runtime.Scheme
-	recorder events.EventRecorder
+	scheme     *runtime.Scheme
+	recorder   events.EventRecorder
+	drift      *kubernetes.DriftTracker
+	ntpSamples *tsaUtils.NTPSamples
 }
 
 func NewReconciler(c client.Client, scheme *runtime.Scheme, recorder events.EventRecorder) controller.Controller {
 	return &timestampAuthorityReconciler{
-		Client:   c,
-		scheme:   scheme,
-		recorder: recorder,
+		Client:     c,
+		scheme:     scheme,
+		recorder:   recorder,
+		drift:      kubernetes.NewDriftTracker(recorder),
+		ntpSamples: tsaUtils.NewNTPSamples(),
 	}
 }
 
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=timestampauthorities,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=timestampauthorities/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=timestampauthorities/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;update;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -79,22 +96,26 @@ func (r *timestampAuthorityReconciler) Reconcile(ctx context.Context, req ctrl.R
 	log.V(1).Info("Reconciling Timestamp Authority", "request", req)
 
 	if err := r.Get(ctx, req.NamespacedName, &instance); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			metrics.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+			r.drift.Forget(metrics.KindOf(&instance), req.Namespace, req.Name)
+		}
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Fetch the namespace
-	var namespace v12.Namespace
-	if err := r.Get(ctx, types.NamespacedName{Name: req.Namespace}, &namespace); err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
-	}
-
 	// Check if the namespace is marked for deletion
-	if !namespace.DeletionTimestamp.IsZero() {
+	terminating, err := kubernetes.NamespaceTerminating(ctx, r.Client, req.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if terminating {
 		log.Info("namespace is marked for deletion, stopping reconciliation", "namespace", req.Namespace)
 		return ctrl.Result{}, nil
 	}
 
+	ctx = kubernetes.WithDriftTracker(ctx, r.drift, &instance)
 	target := instance.DeepCopy()
+	defer metrics.RecordState(target)
 	conditionSupplier := func(_ *rhtasv1.TimestampAuthority) []string {
 		conditions := []string{actions.TSASignerCondition, trustmaterial.TrustMaterialCondition}
 		return fipsutil.AppendFIPSCondition(conditions)
@@ -105,21 +126,31 @@ func (r *timestampAuthorityReconciler) Reconcile(ctx context.Context, req ctrl.R
 		actions.NewFIPSValidationAction(),
 		actions.NewGenerateSignerAction(),
 		actions.NewResolveKMSTinkSignerAction(),
+		actions.NewCertificateRenewalAction(),
 		transitions.NewToCreatePhaseAction[*rhtasv1.TimestampAuthority](),
 		actions.NewRBACAction(),
 		actions.NewNtpMonitoringAction(),
 		actions.NewDeployAction(),
+		actions.NewAutoscalingAction(),
 		actions.NewServiceAction(),
 		actions.NewIngressAction(),
+		actions.NewNetworkPolicyAction(),
 		actions.NewStatusUrlAction(),
 		actions.NewMonitoringAction(),
+		actions.NewPrometheusRuleAction(),
 
 		transitions.NewToInitializePhaseAction[*rhtasv1.TimestampAuthority](),
 
+		actions.NewTimeSyncAction(r.ntpSamples),
 		actions.NewRolloutCheckAction(),
 		actions.NewResolvePubKeyAction(),
 
 		transitions.NewToReadyPhaseAction[*rhtasv1.TimestampAuthority](),
+		actions.NewCertificateExpiryAction(),
+	}
+
+	if dryrun.Enabled(target) {
+		return dryrun.Run(ctx, r.Client, log, target.DeepCopy(), actions)
 	}
 
 	for _, a := range actions {
@@ -129,7 +160,9 @@ func (r *timestampAuthorityReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 		if a.CanHandle(ctx, target) {
 			log.V(2).Info("Executing " + a.Name())
+			start := time.Now()
 			result := a.Handle(ctx, target)
+			metrics.ObserveAction("timestampauthority", a.Name(), start, result)
 			if result != nil {
 				return result.Result, result.Err
 			}
@@ -152,5 +185,15 @@ func (r *timestampAuthorityReconciler) SetupWithManager(mgr ctrl.Manager) error
 		Owns(&v1.Deployment{}).
 		Owns(&v12.Service{}).
 		Owns(&v13.Ingress{}).
+		Owns(&v13.NetworkPolicy{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		// new pods are checked right away, the periodic source covers the check interval
+		Watches(&v12.Pod{}, handler.EnqueueRequestsFromMapFunc(actions.TimeSyncPodInstance),
+			builder.WithPredicates(actions.TimeSyncPodStarted())).
+		WatchesRawSource(ctrlutil.PeriodicSource(mgr.GetClient(), &rhtasv1.TimestampAuthorityList{}, timeSyncCheckTick, pause,
+			crpredicate.NewPredicateFuncs(func(o client.Object) bool {
+				return actions.TimeSyncCheckDue(o.(*rhtasv1.TimestampAuthority), time.Now())
+			}),
+		)).
 		Complete(r)
 }
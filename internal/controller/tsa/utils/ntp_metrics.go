@@ -0,0 +1,126 @@
+package tsaUtils
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// NTPSyncMetric counts the queries of the NTP monitor, labeled with the host of the NTP server and failed.
+	NTPSyncMetric = "timestamp_authority_ntp_sync_total"
+	// NTPErrorsMetric counts the checks in which the NTP servers did not confirm the local time.
+	NTPErrorsMetric = "timestamp_authority_ntp_errors_total"
+
+	// ntpSampleTTL is how long the metrics of a pod are kept without a new sample.
+	ntpSampleTTL = time.Hour
+)
+
+// NTPMetrics holds the counters of the NTP monitor of a TSA pod.
+type NTPMetrics struct {
+	// Succeeded and Failed queries by NTP server host
+	Succeeded map[string]float64
+	Failed    map[string]float64
+	// Errors is the number of failed checks
+	Errors float64
+}
+
+// ParseNTPMetrics reads the NTP monitor counters from the Prometheus text format.
+func ParseNTPMetrics(r io.Reader) (NTPMetrics, error) {
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return NTPMetrics{}, fmt.Errorf("invalid metrics: %w", err)
+	}
+
+	metrics := NTPMetrics{Succeeded: map[string]float64{}, Failed: map[string]float64{}}
+	for _, m := range families[NTPSyncMetric].GetMetric() {
+		host := label(m, "host")
+		if label(m, "failed") == "true" {
+			metrics.Failed[host] += m.GetCounter().GetValue()
+		} else {
+			metrics.Succeeded[host] += m.GetCounter().GetValue()
+		}
+	}
+	for _, m := range families[NTPErrorsMetric].GetMetric() {
+		metrics.Errors += m.GetCounter().GetValue()
+	}
+	return metrics, nil
+}
+
+// NTPSamples keeps the last NTP metrics of each TSA pod between reconciliations.
+type NTPSamples struct {
+	mu      sync.Mutex
+	samples map[types.UID]ntpSample
+}
+
+type ntpSample struct {
+	metrics NTPMetrics
+	time    time.Time
+	healthy []string
+	errors  float64
+}
+
+func NewNTPSamples() *NTPSamples {
+	return &NTPSamples{samples: map[types.UID]ntpSample{}}
+}
+
+// Observe stores the metrics of the pod. It returns the hosts of the NTP servers that answered and the number of
+// failed checks since the previous sample, since the start of the pod for the first sample. If the NTP monitor did
+// not query a server since the previous sample, the result of the previous sample is returned.
+func (s *NTPSamples) Observe(pod types.UID, metrics NTPMetrics, now time.Time) ([]string, float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for uid, sample := range s.samples {
+		if now.Sub(sample.time) > ntpSampleTTL {
+			delete(s.samples, uid)
+		}
+	}
+
+	previous, ok := s.samples[pod]
+	queries := sum(metrics.Succeeded) + sum(metrics.Failed)
+	previousQueries := sum(previous.metrics.Succeeded) + sum(previous.metrics.Failed)
+	switch {
+	case ok && queries == previousQueries && metrics.Errors == previous.metrics.Errors:
+		previous.time = now
+		s.samples[pod] = previous
+		return previous.healthy, previous.errors
+	case queries < previousQueries || metrics.Errors < previous.metrics.Errors:
+		// the counters were reset by a restart of the container
+		previous = ntpSample{}
+	}
+
+	sample := ntpSample{metrics: metrics, time: now, errors: metrics.Errors - previous.metrics.Errors}
+	for host, succeeded := range metrics.Succeeded {
+		if succeeded > previous.metrics.Succeeded[host] {
+			sample.healthy = append(sample.healthy, host)
+		}
+	}
+	slices.Sort(sample.healthy)
+	s.samples[pod] = sample
+	return sample.healthy, sample.errors
+}
+
+func label(m *dto.Metric, name string) string {
+	for _, l := range m.GetLabel() {
+		if l.GetName() == name {
+			return l.GetValue()
+		}
+	}
+	return ""
+}
+
+func sum(values map[string]float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
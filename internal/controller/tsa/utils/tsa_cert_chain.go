@@ -1,7 +1,6 @@
 package tsaUtils
 
 import (
-	"bytes"
 	"context"
 	"crypto"
 	"crypto/ecdsa"
@@ -13,11 +12,13 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"maps"
 	"math/big"
 	"time"
 
 	rhtasv1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/utils"
+	"github.com/securesign/operator/internal/utils/keys"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -28,6 +29,8 @@ const (
 	KeyLeafPrivateKeyPassword = "leafPrivateKeyPassword"
 )
 
+var ErrMissingRootPrivateKey = errors.New("missing root private key for certificate renewal")
+
 type TsaCertChainConfig struct {
 	RootPrivateKey                  []byte
 	RootPrivateKeyPassword          []byte
@@ -75,23 +78,6 @@ func (c TsaCertChainConfig) ToMap() map[string][]byte {
 	return result
 }
 
-func CreatePrivateKey(key *ecdsa.PrivateKey) ([]byte, error) {
-	mKey, err := x509.MarshalECPrivateKey(key)
-	if err != nil {
-		return nil, err
-	}
-
-	var pemData bytes.Buffer
-	if err := pem.Encode(&pemData, &pem.Block{
-		Type:  "EC PRIVATE KEY",
-		Bytes: mKey,
-	}); err != nil {
-		return nil, err
-	}
-
-	return pemData.Bytes(), nil
-}
-
 func CreateTSACertChain(ctx context.Context, instance *rhtasv1.TimestampAuthority, deploymentName string, client client.Client, config *TsaCertChainConfig) ([]byte, error) {
 	var err error
 
@@ -193,6 +179,91 @@ func CreateTSACertChain(ctx context.Context, instance *rhtasv1.TimestampAuthorit
 	return certificateChain, nil
 }
 
+// RenewLeafCertificate issues a new leaf certificate with a fresh key of the given algorithm, signed by the root of
+// the current chain. The subject, usages and validity period are copied from the current leaf, the rest of the chain is kept.
+func RenewLeafCertificate(current map[string][]byte, algorithm rhtasv1.KeyAlgorithm) (map[string][]byte, error) {
+	if len(current["rootPrivateKey"]) == 0 {
+		return nil, ErrMissingRootPrivateKey
+	}
+	rootKey, err := parsePrivateKey(current["rootPrivateKey"], current["rootPrivateKeyPassword"])
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*pem.Block
+	for block, rest := pem.Decode(current[KeyCertificateChain]); block != nil; block, rest = pem.Decode(rest) {
+		blocks = append(blocks, block)
+	}
+	if len(blocks) < 2 {
+		return nil, fmt.Errorf("certificate chain must contain the leaf and the root certificate")
+	}
+	leaf, err := x509.ParseCertificate(blocks[0].Bytes)
+	if err != nil {
+		return nil, err
+	}
+	root, err := x509.ParseCertificate(blocks[len(blocks)-1].Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	leafKey, err := keys.Generate(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	serialNumber, err := GenerateSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	oidExtendedKeyUsage := asn1.ObjectIdentifier{2, 5, 29, 37}
+	var extraExtensions []pkix.Extension
+	for _, ext := range leaf.Extensions {
+		if ext.Id.Equal(oidExtendedKeyUsage) {
+			extraExtensions = append(extraExtensions, ext)
+		}
+	}
+
+	notBefore := time.Now()
+	notAfter := notBefore.Add(leaf.NotAfter.Sub(leaf.NotBefore))
+	if notAfter.After(root.NotAfter) {
+		notAfter = root.NotAfter
+	}
+	template := x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               leaf.Subject,
+		EmailAddresses:        leaf.EmailAddresses,
+		BasicConstraintsValid: true,
+		IsCA:                  leaf.IsCA,
+		KeyUsage:              leaf.KeyUsage,
+		ExtKeyUsage:           leaf.ExtKeyUsage,
+		ExtraExtensions:       extraExtensions,
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+	}
+	leafCert, err := x509.CreateCertificate(rand.Reader, &template, root, leafKey.Public(), rootKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to renew leaf certificate: %w", err)
+	}
+	leafPrivateKey, err := keys.MarshalPrivateKey(leafKey)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateChain := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: leafCert,
+	})
+	for _, block := range blocks[1:] {
+		certificateChain = append(certificateChain, pem.EncodeToMemory(block)...)
+	}
+
+	result := maps.Clone(current)
+	delete(result, KeyLeafPrivateKeyPassword)
+	result[KeyLeafPrivateKey] = leafPrivateKey
+	result[KeyCertificateChain] = certificateChain
+	return result, nil
+}
+
 func GenerateSerialNumber() (*big.Int, error) {
 	serial, err := rand.Int(rand.Reader, (&big.Int{}).Exp(big.NewInt(2), big.NewInt(159), nil))
 	if err != nil {
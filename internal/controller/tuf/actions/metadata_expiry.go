@@ -0,0 +1,116 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/trustmaterial"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/state"
+	httputils "github.com/securesign/operator/internal/utils/http"
+)
+
+// metadataExpiryInterval is how often the served repository metadata is re-read.
+const metadataExpiryInterval = time.Hour
+
+// signedMetadata is the subset of a TUF metadata file the operator reports.
+type signedMetadata struct {
+	Signed struct {
+		Expires            time.Time `json:"expires"`
+		ConsistentSnapshot bool      `json:"consistent_snapshot"`
+		Meta               map[string]struct {
+			Version int64 `json:"version"`
+		} `json:"meta"`
+	} `json:"signed"`
+}
+
+func NewMetadataExpiryAction() action.Action[*rhtasv1.Tuf] {
+	return &metadataExpiryAction{}
+}
+
+type metadataExpiryAction struct {
+	action.BaseAction
+}
+
+func (i metadataExpiryAction) Name() string {
+	return "record metadata expiry"
+}
+
+func (i metadataExpiryAction) CanHandle(_ context.Context, instance *rhtasv1.Tuf) bool {
+	return state.FromInstance(instance, constants.ReadyCondition) == state.Ready
+}
+
+func (i metadataExpiryAction) Handle(ctx context.Context, instance *rhtasv1.Tuf) *action.Result {
+	if err := i.recordExpiry(ctx, instance); err != nil {
+		// metrics are best effort, never block the reconciliation
+		i.Logger.V(1).Info("could not read repository metadata", "error", err.Error())
+	}
+	return i.RequeueAfter(metadataExpiryInterval)
+}
+
+// recordExpiry walks root -> timestamp -> snapshot -> targets the same way a TUF
+// client does, so that versioned file names are used for consistent snapshots.
+func (i metadataExpiryAction) recordExpiry(ctx context.Context, instance *rhtasv1.Tuf) error {
+	baseURL, err := trustmaterial.ResolveBaseURL(instance)
+	if err != nil {
+		return err
+	}
+	cas, err := httputils.LoadTrustedCAs(ctx, i.Client, instance)
+	if err != nil {
+		return err
+	}
+	httpClient := httputils.GetClientBuilder()(cas...)
+	fetch := func(file string) (*signedMetadata, error) {
+		u, err := url.JoinPath(baseURL, file)
+		if err != nil {
+			return nil, err
+		}
+		body, err := httputils.FetchFromAPI(ctx, httpClient, u)
+		if err != nil {
+			return nil, err
+		}
+		md := &signedMetadata{}
+		if err = json.Unmarshal(body, md); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", file, err)
+		}
+		return md, nil
+	}
+
+	root, err := fetch("root.json")
+	if err != nil {
+		return err
+	}
+	metrics.SetTufMetadataExpiry(instance, "root", root.Signed.Expires)
+
+	fileName := func(role string, parent *signedMetadata) string {
+		if v, ok := parent.Signed.Meta[role+".json"]; ok && root.Signed.ConsistentSnapshot && v.Version > 0 {
+			return fmt.Sprintf("%d.%s.json", v.Version, role)
+		}
+		return role + ".json"
+	}
+
+	timestamp, err := fetch("timestamp.json")
+	if err != nil {
+		return err
+	}
+	metrics.SetTufMetadataExpiry(instance, "timestamp", timestamp.Signed.Expires)
+
+	snapshot, err := fetch(fileName("snapshot", timestamp))
+	if err != nil {
+		return err
+	}
+	metrics.SetTufMetadataExpiry(instance, "snapshot", snapshot.Signed.Expires)
+
+	targets, err := fetch(fileName("targets", snapshot))
+	if err != nil {
+		return err
+	}
+	metrics.SetTufMetadataExpiry(instance, "targets", targets.Signed.Expires)
+	return nil
+}
@@ -0,0 +1,65 @@
+package actions
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	httpmock "github.com/securesign/operator/internal/testing/http"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+func TestMetadataExpiry(t *testing.T) {
+	g := NewWithT(t)
+	const baseURL = "http://tuf.default.svc"
+
+	respond := func(body string) httpmock.RoundTripFunc {
+		return func(_ *http.Request) *http.Response {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(body))), Header: make(http.Header)}
+		}
+	}
+	mockClient := &http.Client{}
+	httpmock.SetMockTransport(mockClient, map[string]httpmock.RoundTripFunc{
+		baseURL + "/root.json":       respond(`{"signed":{"_type":"root","expires":"2030-01-01T00:00:00Z","consistent_snapshot":true}}`),
+		baseURL + "/timestamp.json":  respond(`{"signed":{"_type":"timestamp","expires":"2027-01-01T00:00:00Z","meta":{"snapshot.json":{"version":3}}}}`),
+		baseURL + "/3.snapshot.json": respond(`{"signed":{"_type":"snapshot","expires":"2028-01-01T00:00:00Z","meta":{"targets.json":{"version":2}}}}`),
+		baseURL + "/2.targets.json":  respond(`{"signed":{"_type":"targets","expires":"2029-01-01T00:00:00Z"}}`),
+	})
+	orig := httputils.GetClientBuilder()
+	httputils.SetClientBuilder(func(_ ...[]byte) *http.Client { return mockClient })
+	t.Cleanup(func() { httputils.SetClientBuilder(orig) })
+
+	instance := &rhtasv1.Tuf{
+		ObjectMeta: metav1.ObjectMeta{Name: "tuf", Namespace: "default"},
+		Status: rhtasv1.TufStatus{
+			Url: baseURL,
+			Conditions: []metav1.Condition{
+				{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()},
+			},
+		},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewMetadataExpiryAction())
+
+	g.Expect(a.CanHandle(t.Context(), instance)).To(BeTrue())
+	g.Expect(a.Handle(t.Context(), instance)).To(Equal(testAction.RequeueAfter(time.Hour)))
+	g.Expect(testutil.GatherAndCompare(crmetrics.Registry, strings.NewReader(`
+# HELP rhtas_operator_tuf_metadata_expiry_timestamp_seconds Expiry of a TUF repository metadata role as a Unix timestamp.
+# TYPE rhtas_operator_tuf_metadata_expiry_timestamp_seconds gauge
+rhtas_operator_tuf_metadata_expiry_timestamp_seconds{kind="Tuf",name="tuf",namespace="default",role="root"} 1.893456e+09
+rhtas_operator_tuf_metadata_expiry_timestamp_seconds{kind="Tuf",name="tuf",namespace="default",role="snapshot"} 1.8302976e+09
+rhtas_operator_tuf_metadata_expiry_timestamp_seconds{kind="Tuf",name="tuf",namespace="default",role="targets"} 1.86192e+09
+rhtas_operator_tuf_metadata_expiry_timestamp_seconds{kind="Tuf",name="tuf",namespace="default",role="timestamp"} 1.7987616e+09
+`), "rhtas_operator_tuf_metadata_expiry_timestamp_seconds")).To(Succeed())
+}
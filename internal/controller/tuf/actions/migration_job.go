@@ -133,6 +133,10 @@ func (i migrationJobAction) ensureMigrationJob(ctx context.Context, labels map[s
 				Namespace:    instance.Namespace,
 			},
 		},
+		// the migration job keeps its own pod affinity to the TUF deployment, apply scheduling first
+		func(object *batchv1.Job) error {
+			return ensure.PodScheduling(instance.Spec.PodRequirements)(&object.Spec.Template.Spec)
+		},
 		// use init job RBAC and do not introduce new RBAC for the migration job
 		utils.EnsureTufMigrationJob(ctx, i.Client, instance, tufConstants.RBACInitJobName, labels),
 		ensure.ControllerReference[*batchv1.Job](instance, i.Client),
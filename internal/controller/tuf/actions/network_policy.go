@@ -0,0 +1,36 @@
+package actions
+
+import (
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/action/networkpolicy"
+	consoleActions "github.com/securesign/operator/internal/controller/console/actions"
+	ctlogActions "github.com/securesign/operator/internal/controller/ctlog/actions"
+	rekorActions "github.com/securesign/operator/internal/controller/rekor/actions"
+	tufConstants "github.com/securesign/operator/internal/controller/tuf/constants"
+	"github.com/securesign/operator/internal/utils"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+type tufNetworkPolicyConfig struct{}
+
+func (tufNetworkPolicyConfig) IsEnabled(i *rhtasv1.Tuf) bool {
+	return utils.IsEnabled(i.Spec.NetworkPolicy.Enabled)
+}
+
+func (tufNetworkPolicyConfig) Ingress(_ *rhtasv1.Tuf) []networkingv1.NetworkPolicyIngressRule {
+	return []networkingv1.NetworkPolicyIngressRule{
+		networkpolicy.Rule([]int32{tufConstants.Port},
+			networkpolicy.FromComponents(rekorActions.MonitorComponentName, ctlogActions.MonitorComponentName, consoleActions.ApiComponentName),
+			networkpolicy.FromIngressControllers(),
+		),
+	}
+}
+
+func NewNetworkPolicyAction() action.Action[*rhtasv1.Tuf] {
+	return networkpolicy.NewAction(
+		tufConstants.ComponentName,
+		tufConstants.DeploymentName,
+		tufNetworkPolicyConfig{},
+	)
+}
@@ -14,6 +14,7 @@ import (
 	tufConstants "github.com/securesign/operator/internal/controller/tuf/constants"
 	"github.com/securesign/operator/internal/controller/tuf/utils"
 	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/metrics"
 	"github.com/securesign/operator/internal/state"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
@@ -65,6 +66,7 @@ func (i initJobAction) Handle(ctx context.Context, instance *rhtasv1.Tuf) *actio
 
 func (i initJobAction) jobPresent(ctx context.Context, job *v2.Job, instance *rhtasv1.Tuf) *action.Result {
 	i.Logger.Info("Tuf tuf-repository-init is present.", "Succeeded", job.Status.Succeeded, "Failures", job.Status.Failed)
+	metrics.SetFailedJobs(instance, "tuf-repository-init", job.Status.Failed)
 	if jobUtils.IsCompleted(*job) {
 		if !jobUtils.IsFailed(*job) {
 			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
@@ -113,6 +115,9 @@ func (i initJobAction) ensureInitJob(ctx context.Context, labels map[string]stri
 			},
 		},
 		utils.EnsureTufInitJob(ctx, i.Client, instance, tufConstants.RBACInitJobName, labels),
+		func(object *v2.Job) error {
+			return ensure.PodScheduling(instance.Spec.PodRequirements)(&object.Spec.Template.Spec)
+		},
 		ensure.Annotations[*v2.Job]([]string{tufConstants.RepositoryVersionAnnotation}, map[string]string{tufConstants.RepositoryVersionAnnotation: tufConstants.TufVersionV1}),
 		ensure.ControllerReference[*v2.Job](instance, i.Client),
 		ensure.Labels[*v2.Job](slices.Collect(maps.Keys(labels)), labels),
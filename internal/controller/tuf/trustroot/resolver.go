@@ -125,13 +125,32 @@ var descriptors = map[ComponentKey]descriptor{
 	},
 }
 
-// Resolve resolves key's address and trust material from binding.
+// rekorV2Descriptor serves the Rekor key when the log is deployed as RekorV2.
+var rekorV2Descriptor = descriptor{
+	newInstance:        func() apis.AddressableConditionAware { return &rhtasv1.RekorV2{} },
+	materialFromStatus: func(obj apis.AddressableConditionAware) string { return obj.(*rhtasv1.RekorV2).Status.PublicKey },
+}
+
+// Resolve resolves key's address and trust material from binding. A Rekor binding
+// that matches no Rekor instance falls back to a RekorV2 instance; the original
+// error is returned when no RekorV2 instance is found either.
 func Resolve(ctx context.Context, cli client.Client, namespace string, key ComponentKey, binding rhtasv1.TrustRootBinding) (Resolved, error) {
 	desc, ok := descriptors[key]
 	if !ok {
 		return Resolved{}, reconcile.TerminalError(fmt.Errorf("%w: %s", ErrUnknownComponent, key))
 	}
-	return resolveComponent(ctx, cli, namespace, desc, binding, desc.newInstance())
+	resolved, err := resolveComponent(ctx, cli, namespace, desc, binding, desc.newInstance())
+	if key == Rekor && missing(err) {
+		if v2, v2Err := resolveComponent(ctx, cli, namespace, rekorV2Descriptor, binding, rekorV2Descriptor.newInstance()); !missing(v2Err) {
+			return v2, v2Err
+		}
+	}
+	return resolved, err
+}
+
+// missing reports whether err means the component instance could not be found.
+func missing(err error) bool {
+	return errors.Is(err, ErrResolveAddress) && !errors.Is(err, serviceresolver.ErrServiceNotReady)
 }
 
 // ResolveFulcio resolves Fulcio's address, trust material, and accepted OIDC
@@ -188,3 +188,27 @@ func TestResolve_UnknownComponent(t *testing.T) {
 	_, err := Resolve(t.Context(), c, t.Name(), ComponentKey("bogus"), rhtasv1.TrustRootBinding{})
 	g.Expect(err).To(MatchError(ErrUnknownComponent))
 }
+
+func TestResolve_RekorFallsBackToRekorV2(t *testing.T) {
+	g := NewWithT(t)
+	rekor := &rhtasv1.RekorV2{ObjectMeta: metav1.ObjectMeta{Name: "rekor", Namespace: t.Name()}}
+	rekor.Status.PublicKey = testPEM
+	rekor.Status.Url = "https://rekor-v2.internal.svc"
+	rekor.Status.Conditions = []metav1.Condition{readyCondition()}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rekor).WithStatusSubresource(rekor).Build()
+	g.Expect(c.Status().Update(t.Context(), rekor)).To(Succeed())
+
+	resolved, err := Resolve(t.Context(), c, t.Name(), Rekor, rhtasv1.TrustRootBinding{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resolved.Address).To(Equal("https://rekor-v2.internal.svc"))
+	g.Expect(resolved.Material).To(Equal([]byte(testPEM)))
+}
+
+func TestResolve_RekorMissingKeepsOriginalError(t *testing.T) {
+	g := NewWithT(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	_, err := Resolve(t.Context(), c, t.Name(), Rekor, rhtasv1.TrustRootBinding{})
+	g.Expect(err).To(MatchError(ErrResolveAddress))
+	g.Expect(err.Error()).To(ContainSubstring("RekorList"))
+}
@@ -0,0 +1,89 @@
+package dryrun
+
+import (
+	"context"
+	"sync"
+
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// recordingClient performs all writes in server-side dry-run mode and records them as changes.
+// Status writes are not recorded, they are part of the planned resource itself.
+type recordingClient struct {
+	client.Client
+	live client.Client
+
+	mu      sync.Mutex
+	changes []Change
+}
+
+func newRecordingClient(c client.Client) *recordingClient {
+	return &recordingClient{Client: client.NewDryRunClient(c), live: c}
+}
+
+func (c *recordingClient) record(obj client.Object, op Operation, fields []string) {
+	kind := ""
+	if gvk, err := c.GroupVersionKindFor(obj); err == nil {
+		kind = gvk.Kind
+	}
+	name := obj.GetName()
+	if name == "" {
+		name = obj.GetGenerateName() + "*"
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.changes = append(c.changes, Change{Operation: op, Kind: kind, Namespace: obj.GetNamespace(), Name: name, Fields: fields})
+}
+
+func (c *recordingClient) Changes() []Change {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Change(nil), c.changes...)
+}
+
+func (c *recordingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(obj, Create, nil)
+	return nil
+}
+
+func (c *recordingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	var fields []string
+	live := obj.DeepCopyObject().(client.Object)
+	if err := c.live.Get(ctx, client.ObjectKeyFromObject(obj), live); err == nil {
+		fields, _ = kubernetes.DiffFields(live, obj)
+	}
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(obj, Update, fields)
+	return nil
+}
+
+func (c *recordingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	c.record(obj, Patch, nil)
+	return nil
+}
+
+func (c *recordingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(obj, Delete, nil)
+	return nil
+}
+
+func (c *recordingClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	if err := c.Client.DeleteAllOf(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.record(obj, Delete, nil)
+	return nil
+}
@@ -0,0 +1,79 @@
+package dryrun
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operation is a write of a planned change.
+type Operation string
+
+const (
+	Create Operation = "create"
+	Update Operation = "update"
+	Patch  Operation = "patch"
+	Delete Operation = "delete"
+)
+
+// Change is a write the reconciliation would make.
+type Change struct {
+	Operation Operation
+	Kind      string
+	Namespace string
+	Name      string
+	// Fields lists the changed fields of an update.
+	Fields []string
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s %s/%s", c.Operation, c.Kind, c.Namespace, c.Name)
+	if len(c.Fields) > 0 {
+		s += ": " + strings.Join(c.Fields, ", ")
+	}
+	return s
+}
+
+// Plan is the summary of a dry run.
+type Plan struct {
+	// Resource identifies the planned custom resource.
+	Resource string
+	// Generation of the planned custom resource.
+	Generation int64
+	Changes    []Change
+	// Error stops the plan, later actions were not run.
+	Error string
+}
+
+// String renders the plan in a human-readable form.
+func (p *Plan) String() string {
+	var resources, jobs []string
+	for _, c := range p.Changes {
+		if c.Kind == "Job" && c.Operation == Create {
+			jobs = append(jobs, c.String())
+			continue
+		}
+		resources = append(resources, c.String())
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Dry run of %s at generation %d.\n", p.Resource, p.Generation)
+	if len(p.Changes) == 0 {
+		b.WriteString("\nNo changes.\n")
+	}
+	if len(resources) > 0 {
+		b.WriteString("\nResources:\n")
+		for _, r := range resources {
+			fmt.Fprintf(&b, "  %s\n", r)
+		}
+	}
+	if len(jobs) > 0 {
+		b.WriteString("\nJobs:\n")
+		for _, j := range jobs {
+			fmt.Fprintf(&b, "  %s\n", j)
+		}
+	}
+	if p.Error != "" {
+		fmt.Fprintf(&b, "\nStopped: %s\n", p.Error)
+	}
+	return b.String()
+}
@@ -0,0 +1,99 @@
+// Package dryrun plans the changes of a custom resource annotated with [annotations.DryRun].
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/labels"
+	"github.com/securesign/operator/internal/metrics"
+	"github.com/securesign/operator/internal/utils/kubernetes"
+	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// ComponentName labels the plan ConfigMaps.
+	ComponentName = "dry-run"
+	// PlanKey is the ConfigMap key of the rendered plan.
+	PlanKey = "plan"
+)
+
+// Enabled reports whether obj is annotated with [annotations.DryRun].
+func Enabled(obj client.Object) bool {
+	value, err := strconv.ParseBool(obj.GetAnnotations()[annotations.DryRun])
+	return err == nil && value
+}
+
+// ConfigMapName returns the name of the ConfigMap holding the plan of obj.
+func ConfigMapName(obj client.Object) string {
+	return fmt.Sprintf("%s-%s-dry-run", obj.GetName(), strings.ToLower(metrics.KindOf(obj)))
+}
+
+// Run executes the actions with all writes in server-side dry-run mode and stores the plan
+// in the ConfigMap named by [ConfigMapName].
+//
+// The instance status is changed in memory only, so every action sees the state left by the previous ones
+// and the whole chain runs in a single pass. Results other than errors do not stop the run.
+func Run[T apis.ConditionsAwareObject](ctx context.Context, c client.Client, log logr.Logger, instance T, actions []action.Action[T]) (ctrl.Result, error) {
+	recording := newRecordingClient(c)
+	plan := &Plan{
+		Resource:   fmt.Sprintf("%s %s/%s", metrics.KindOf(instance), instance.GetNamespace(), instance.GetName()),
+		Generation: instance.GetGeneration(),
+	}
+
+	dryRunCtx := kubernetes.WithDryRun(ctx)
+	for _, a := range actions {
+		a.InjectClient(recording)
+		a.InjectLogger(log.WithName(a.Name()))
+		// events of a dry run are dropped
+		a.InjectRecorder(&events.FakeRecorder{})
+
+		if !a.CanHandle(dryRunCtx, instance) {
+			continue
+		}
+		if result := a.Handle(dryRunCtx, instance); result != nil && result.Err != nil {
+			plan.Error = fmt.Sprintf("action %q failed: %v", a.Name(), result.Err)
+			break
+		}
+	}
+	plan.Changes = recording.Changes()
+
+	log.Info("dry run planned", "changes", len(plan.Changes))
+	return ctrl.Result{}, writePlan(ctx, c, instance, plan)
+}
+
+func writePlan(ctx context.Context, c client.Client, owner client.Object, plan *Plan) error {
+	l := labels.For(ComponentName, ConfigMapName(owner), owner.GetName())
+	cm := &core.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName(owner), Namespace: owner.GetNamespace()},
+	}
+	_, err := kubernetes.CreateOrUpdate(ctx, c, cm,
+		func(object *core.ConfigMap) error {
+			// not a controller reference, the plan does not trigger another reconciliation of the owner
+			return controllerutil.SetOwnerReference(owner, object, c.Scheme())
+		},
+		ensure.Labels[*core.ConfigMap](slices.Collect(maps.Keys(l)), l),
+		func(object *core.ConfigMap) error {
+			object.Data = map[string]string{PlanKey: plan.String()}
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not write dry run plan: %w", err)
+	}
+	return nil
+}
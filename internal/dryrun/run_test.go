@@ -0,0 +1,118 @@
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/annotations"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	apps "k8s.io/api/apps/v1"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// stepAction runs fn as its Handle.
+type stepAction struct {
+	action.BaseAction
+	fn func(context.Context, client.Client) *action.Result
+}
+
+func (s *stepAction) Name() string {
+	return "step"
+}
+
+func (s *stepAction) CanHandle(context.Context, *rhtasv1.Rekor) bool {
+	return true
+}
+
+func (s *stepAction) Handle(ctx context.Context, _ *rhtasv1.Rekor) *action.Result {
+	return s.fn(ctx, s.Client)
+}
+
+func step(fn func(context.Context, client.Client) *action.Result) action.Action[*rhtasv1.Rekor] {
+	return &stepAction{fn: fn}
+}
+
+func TestEnabled(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(Enabled(&rhtasv1.Rekor{})).To(BeFalse())
+	g.Expect(Enabled(&rhtasv1.Rekor{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotations.DryRun: "false"}}})).To(BeFalse())
+	g.Expect(Enabled(&rhtasv1.Rekor{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{annotations.DryRun: "true"}}})).To(BeTrue())
+}
+
+func TestRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := t.Context()
+	instance := &rhtasv1.Rekor{ObjectMeta: metav1.ObjectMeta{
+		Name: "rekor", Namespace: "default", Generation: 3,
+		Annotations: map[string]string{annotations.DryRun: "true"},
+	}}
+	deployment := &apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "rekor-server", Namespace: "default"},
+		Spec:       apps.DeploymentSpec{Replicas: ptr.To(int32(1))},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(instance, deployment).Build()
+
+	actions := []action.Action[*rhtasv1.Rekor]{
+		step(func(ctx context.Context, c client.Client) *action.Result {
+			g.Expect(c.Create(ctx, &core.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "sharding", Namespace: "default"}})).To(Succeed())
+			// a Return result does not stop the plan
+			return &action.Result{}
+		}),
+		step(func(ctx context.Context, c client.Client) *action.Result {
+			dp := &apps.Deployment{}
+			g.Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), dp)).To(Succeed())
+			dp.Spec.Replicas = ptr.To(int32(3))
+			g.Expect(c.Update(ctx, dp)).To(Succeed())
+			g.Expect(c.Create(ctx, &batch.Job{ObjectMeta: metav1.ObjectMeta{Name: "createtree", Namespace: "default"}})).To(Succeed())
+			return nil
+		}),
+		step(func(context.Context, client.Client) *action.Result {
+			return &action.Result{Err: errors.New("boom")}
+		}),
+		step(func(ctx context.Context, c client.Client) *action.Result {
+			t.Fatal("actions after an error must not run")
+			return nil
+		}),
+	}
+
+	_, err := Run(ctx, c, logr.Discard(), instance, actions)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// nothing was written
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "sharding", Namespace: "default"}, &core.ConfigMap{})).ToNot(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "createtree", Namespace: "default"}, &batch.Job{})).ToNot(Succeed())
+	dp := &apps.Deployment{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), dp)).To(Succeed())
+	g.Expect(*dp.Spec.Replicas).To(Equal(int32(1)))
+
+	cm := &core.ConfigMap{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "rekor-rekor-dry-run", Namespace: "default"}, cm)).To(Succeed())
+	g.Expect(cm.OwnerReferences).To(HaveLen(1))
+	g.Expect(cm.OwnerReferences[0].Controller).To(BeNil())
+	g.Expect(cm.Data[PlanKey]).To(Equal(`Dry run of Rekor default/rekor at generation 3.
+
+Resources:
+  create ConfigMap default/sharding
+  update Deployment default/rekor-server: spec.replicas
+
+Jobs:
+  create Job default/createtree
+
+Stopped: action "step" failed: boom
+`))
+}
+
+func TestPlan_NoChanges(t *testing.T) {
+	g := NewWithT(t)
+	plan := &Plan{Resource: "Tuf default/tuf", Generation: 1}
+	g.Expect(plan.String()).To(Equal("Dry run of Tuf default/tuf at generation 1.\n\nNo changes.\n"))
+}
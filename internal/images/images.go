@@ -22,6 +22,7 @@ const (
 
 	RekorRedis    Image = "RELATED_IMAGE_REKOR_REDIS"
 	RekorServer   Image = "RELATED_IMAGE_REKOR_SERVER"
+	RekorV2Server Image = "RELATED_IMAGE_REKOR_V2_SERVER"
 	RekorMonitor  Image = "RELATED_IMAGE_REKOR_MONITOR"
 	BackfillRedis Image = "RELATED_IMAGE_BACKFILL_REDIS"
 
@@ -34,8 +35,10 @@ const (
 	HttpServer   Image = "RELATED_IMAGE_HTTP_SERVER"
 	CTLogMonitor Image = "RELATED_IMAGE_CTLOG_MONITOR"
 
-	ConsoleApi Image = "RELATED_IMAGE_CONSOLE_API"
-	ConsoleUI  Image = "RELATED_IMAGE_CONSOLE_UI"
+	ConsoleApi        Image = "RELATED_IMAGE_CONSOLE_API"
+	ConsoleUI         Image = "RELATED_IMAGE_CONSOLE_UI"
+	ConsoleAuthProxy  Image = "RELATED_IMAGE_CONSOLE_AUTH_PROXY"
+	ConsoleOAuthProxy Image = "RELATED_IMAGE_CONSOLE_OAUTH_PROXY"
 )
 
 var Images = []Image{
@@ -47,6 +50,7 @@ var Images = []Image{
 	FulcioServer,
 	RekorRedis,
 	RekorServer,
+	RekorV2Server,
 	RekorMonitor,
 	BackfillRedis,
 	Tuf,
@@ -56,6 +60,8 @@ var Images = []Image{
 	CTLogMonitor,
 	ConsoleApi,
 	ConsoleUI,
+	ConsoleAuthProxy,
+	ConsoleOAuthProxy,
 }
 
 //go:generate cp ../../config/default/images.env embed/images.env
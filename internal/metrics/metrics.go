@@ -0,0 +1,188 @@
+package metrics
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "rhtas_operator"
+
+var (
+	actionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "action_duration_seconds",
+		Help:      "Duration of a single reconciliation action.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"controller", "action"})
+
+	actionErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "action_errors_total",
+		Help:      "Number of reconciliation actions that returned an error.",
+	}, []string{"controller", "action"})
+
+	resourceState = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "resource_state",
+		Help:      "Current state of a custom resource (1 for the active state, 0 otherwise).",
+	}, []string{"kind", "namespace", "name", "state"})
+
+	trustMaterialDrifted = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "trust_material_drifted",
+		Help:      "Whether the trust material served by a component changed and awaits acknowledgement.",
+	}, []string{"kind", "namespace", "name", "component"})
+
+	certificateExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "certificate_expiry_timestamp_seconds",
+		Help:      "Expiry (notAfter) of a component certificate as a Unix timestamp.",
+	}, []string{"kind", "namespace", "name", "certificate"})
+
+	tufMetadataExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tuf_metadata_expiry_timestamp_seconds",
+		Help:      "Expiry of a TUF repository metadata role as a Unix timestamp.",
+	}, []string{"kind", "namespace", "name", "role"})
+
+	treeSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tree_size",
+		Help:      "Number of entries in the active transparency log tree.",
+	}, []string{"kind", "namespace", "name", "tree_id"})
+
+	failedJobs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "failed_jobs",
+		Help:      "Number of failed Job pods (or failed Jobs for scheduled jobs) observed for an operator job.",
+	}, []string{"kind", "namespace", "name", "job"})
+
+	configurationDrift = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "configuration_drift_total",
+		Help:      "Number of managed resources of a custom resource found modified outside of the operator.",
+	}, []string{"kind", "namespace", "name", "resource_kind", "mode"})
+
+	// instanceVecs hold series labeled by kind/namespace/name and are cleaned up by [Forget].
+	instanceVecs = []*prometheus.MetricVec{
+		resourceState.MetricVec,
+		trustMaterialDrifted.MetricVec,
+		certificateExpiry.MetricVec,
+		tufMetadataExpiry.MetricVec,
+		treeSize.MetricVec,
+		failedJobs.MetricVec,
+		configurationDrift.MetricVec,
+	}
+
+	reportedStates = []state.State{state.Pending, state.Creating, state.Initialize, state.Ready, state.Failure}
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(
+		actionDuration,
+		actionErrors,
+		resourceState,
+		trustMaterialDrifted,
+		certificateExpiry,
+		tufMetadataExpiry,
+		treeSize,
+		failedJobs,
+		configurationDrift,
+	)
+}
+
+// ObserveAction records the duration of an action run started at start and
+// counts it as failed when result carries an error.
+func ObserveAction(controller, name string, start time.Time, result *action.Result) {
+	actionDuration.WithLabelValues(controller, name).Observe(time.Since(start).Seconds())
+	if result != nil && result.Err != nil {
+		actionErrors.WithLabelValues(controller, name).Inc()
+	}
+}
+
+// RecordState publishes the state derived from the Ready condition of instance.
+func RecordState(instance apis.ConditionsAwareObject) {
+	current := state.FromInstance(instance, constants.ReadyCondition)
+	kind, ns, name := identity(instance)
+	for _, s := range reportedStates {
+		value := 0.0
+		if s == current {
+			value = 1
+		}
+		resourceState.WithLabelValues(kind, ns, name, s.String()).Set(value)
+	}
+}
+
+// SetTrustMaterialDrifted flags whether the trust material of component requires acknowledgement.
+func SetTrustMaterialDrifted(instance client.Object, component string, drifted bool) {
+	kind, ns, name := identity(instance)
+	value := 0.0
+	if drifted {
+		value = 1
+	}
+	trustMaterialDrifted.WithLabelValues(kind, ns, name, component).Set(value)
+}
+
+// SetCertificateExpiry replaces the published certificate expiries of instance.
+func SetCertificateExpiry(instance client.Object, certificates []rhtasv1.CertificateStatus) {
+	kind, ns, name := identity(instance)
+	certificateExpiry.DeletePartialMatch(prometheus.Labels{"kind": kind, "namespace": ns, "name": name})
+	for _, c := range certificates {
+		certificateExpiry.WithLabelValues(kind, ns, name, c.Name).Set(float64(c.NotAfter.Unix()))
+	}
+}
+
+// SetTufMetadataExpiry publishes the expiry of a TUF metadata role.
+func SetTufMetadataExpiry(instance client.Object, role string, expires time.Time) {
+	kind, ns, name := identity(instance)
+	tufMetadataExpiry.WithLabelValues(kind, ns, name, role).Set(float64(expires.Unix()))
+}
+
+// SetTreeSize publishes the size of the active tree of a transparency log.
+func SetTreeSize(instance client.Object, treeID string, size int64) {
+	kind, ns, name := identity(instance)
+	treeSize.DeletePartialMatch(prometheus.Labels{"kind": kind, "namespace": ns, "name": name})
+	treeSize.WithLabelValues(kind, ns, name, treeID).Set(float64(size))
+}
+
+// SetFailedJobs publishes the number of failures observed for an operator job.
+func SetFailedJobs(instance client.Object, job string, failed int32) {
+	kind, ns, name := identity(instance)
+	failedJobs.WithLabelValues(kind, ns, name, job).Set(float64(failed))
+}
+
+// IncConfigurationDrift counts a drift of a resource of kind resourceKind managed for the custom resource
+// identified by kind, namespace and name, mode is either "reverted" or "reported".
+func IncConfigurationDrift(kind, namespace, name, resourceKind, mode string) {
+	configurationDrift.WithLabelValues(kind, namespace, name, resourceKind, mode).Inc()
+}
+
+// Forget drops all series of a deleted resource.
+func Forget(kind, namespace, name string) {
+	labels := prometheus.Labels{"kind": kind, "namespace": namespace, "name": name}
+	for _, vec := range instanceVecs {
+		vec.DeletePartialMatch(labels)
+	}
+}
+
+func identity(obj client.Object) (string, string, string) {
+	return KindOf(obj), obj.GetNamespace(), obj.GetName()
+}
+
+// KindOf returns the kind of obj. Typed objects returned by the client usually
+// carry an empty TypeMeta, so the Go type name is used as a fallback.
+func KindOf(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/action"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/state"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestObserveAction(t *testing.T) {
+	g := NewWithT(t)
+
+	ObserveAction("test", "ok", time.Now(), nil)
+	ObserveAction("test", "failing", time.Now(), &action.Result{Err: errors.New("boom")})
+	ObserveAction("test", "failing", time.Now(), &action.Result{Err: errors.New("boom")})
+
+	g.Expect(testutil.ToFloat64(actionErrors.WithLabelValues("test", "ok"))).To(BeZero())
+	g.Expect(testutil.ToFloat64(actionErrors.WithLabelValues("test", "failing"))).To(BeEquivalentTo(2))
+	g.Expect(testutil.CollectAndCount(actionDuration, "rhtas_operator_action_duration_seconds")).To(BeNumerically(">=", 2))
+}
+
+func TestRecordState(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &rhtasv1.Fulcio{ObjectMeta: metav1.ObjectMeta{Name: "state", Namespace: "default"}}
+	instance.SetCondition(metav1.Condition{Type: constants.ReadyCondition, Status: metav1.ConditionFalse, Reason: state.Creating.String()})
+	RecordState(instance)
+
+	g.Expect(testutil.ToFloat64(resourceState.WithLabelValues("Fulcio", "default", "state", "Creating"))).To(BeEquivalentTo(1))
+	g.Expect(testutil.ToFloat64(resourceState.WithLabelValues("Fulcio", "default", "state", "Ready"))).To(BeZero())
+
+	instance.SetCondition(metav1.Condition{Type: constants.ReadyCondition, Status: metav1.ConditionTrue, Reason: state.Ready.String()})
+	RecordState(instance)
+
+	g.Expect(testutil.ToFloat64(resourceState.WithLabelValues("Fulcio", "default", "state", "Creating"))).To(BeZero())
+	g.Expect(testutil.ToFloat64(resourceState.WithLabelValues("Fulcio", "default", "state", "Ready"))).To(BeEquivalentTo(1))
+}
+
+func TestSetCertificateExpiry(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &rhtasv1.CTlog{ObjectMeta: metav1.ObjectMeta{Name: "expiry", Namespace: "default"}}
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	SetCertificateExpiry(instance, []rhtasv1.CertificateStatus{
+		{Name: "old", NotAfter: metav1.NewTime(notAfter)},
+	})
+	SetCertificateExpiry(instance, []rhtasv1.CertificateStatus{
+		{Name: "ca[0]", NotAfter: metav1.NewTime(notAfter)},
+	})
+
+	g.Expect(testutil.ToFloat64(certificateExpiry.WithLabelValues("CTlog", "default", "expiry", "ca[0]"))).To(BeEquivalentTo(notAfter.Unix()))
+	// series of certificates that are no longer reported are removed
+	g.Expect(certificateExpiry.DeleteLabelValues("CTlog", "default", "expiry", "old")).To(BeFalse())
+}
+
+func TestForget(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &rhtasv1.Rekor{ObjectMeta: metav1.ObjectMeta{Name: "forget", Namespace: "default"}}
+	other := &rhtasv1.Rekor{ObjectMeta: metav1.ObjectMeta{Name: "keep", Namespace: "default"}}
+	RecordState(instance)
+	SetTreeSize(instance, "1", 10)
+	SetFailedJobs(instance, "createtree", 1)
+	IncConfigurationDrift(KindOf(instance), instance.Namespace, instance.Name, "Deployment", "reverted")
+	SetTreeSize(other, "2", 20)
+
+	Forget(KindOf(instance), instance.Namespace, instance.Name)
+
+	g.Expect(treeSize.DeleteLabelValues("Rekor", "default", "forget", "1")).To(BeFalse())
+	g.Expect(failedJobs.DeleteLabelValues("Rekor", "default", "forget", "createtree")).To(BeFalse())
+	g.Expect(resourceState.DeleteLabelValues("Rekor", "default", "forget", "Pending")).To(BeFalse())
+	g.Expect(configurationDrift.DeleteLabelValues("Rekor", "default", "forget", "Deployment", "reverted")).To(BeFalse())
+	g.Expect(testutil.ToFloat64(treeSize.WithLabelValues("Rekor", "default", "keep", "2"))).To(BeEquivalentTo(20))
+}
+
+func TestKindOf(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(KindOf(&rhtasv1.TimestampAuthority{})).To(Equal("TimestampAuthority"))
+
+	typed := &rhtasv1.Tuf{}
+	typed.SetGroupVersionKind(rhtasv1.GroupVersion.WithKind("Tuf"))
+	g.Expect(KindOf(typed)).To(Equal("Tuf"))
+}
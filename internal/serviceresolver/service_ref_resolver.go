@@ -20,6 +20,7 @@ var (
 	ErrGetServiceFailed    = fmt.Errorf("failed to get service")
 	ErrAutodiscoveryFailed = fmt.Errorf("failed to autodiscovery service")
 	ErrServiceNotReady     = fmt.Errorf("service is not ready")
+	ErrServiceNotFound     = fmt.Errorf("service not found")
 )
 
 // portRe matches a trailing :port, anchored to end-of-string so it can't match a
@@ -178,7 +179,7 @@ func autoloadService(ctx context.Context, cl client.Client, namespace string, li
 	}
 	switch len(items) {
 	case 0:
-		return nil, fmt.Errorf("%w: no %T found in namespace %s", ErrAutodiscoveryFailed, list, namespace)
+		return nil, fmt.Errorf("%w: %w: no %T found in namespace %s", ErrAutodiscoveryFailed, ErrServiceNotFound, list, namespace)
 	case 1:
 		obj, ok := items[0].(client.Object)
 		if !ok {
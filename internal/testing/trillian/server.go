@@ -0,0 +1,219 @@
+package trillian
+
+import (
+	"context"
+	"maps"
+	"net"
+	"slices"
+	"sync"
+	"syscall"
+	"testing"
+
+	"github.com/google/trillian"
+	"github.com/google/trillian/types"
+	trillianutils "github.com/securesign/operator/internal/utils/trillian"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+// Server is an in-memory Trillian admin and log server.
+type Server struct {
+	trillian.UnimplementedTrillianAdminServer
+	trillian.UnimplementedTrillianLogServer
+
+	mu sync.Mutex
+	// Errors returned by the RPC methods, keyed by the method name (e.g. "CreateTree").
+	Errors map[string]error
+	// Trees stored in the server, keyed by tree ID.
+	Trees map[int64]*trillian.Tree
+	// Sizes of the initialised trees, keyed by tree ID.
+	Sizes map[int64]uint64
+	// Deleted lists the IDs of the deleted trees.
+	Deleted []int64
+	// Targets lists the addresses the clients connected to.
+	Targets []string
+	// NextTreeID is assigned to the next created tree.
+	NextTreeID int64
+}
+
+// NewServer returns an empty server creating trees from the given ID.
+func NewServer(nextTreeID int64) *Server {
+	return &Server{
+		Errors:     map[string]error{},
+		Trees:      map[int64]*trillian.Tree{},
+		Sizes:      map[int64]uint64{},
+		NextTreeID: nextTreeID,
+	}
+}
+
+// Serve starts the server in memory and routes the connections opened by [trillianutils.Dial] to it.
+// The server is stopped and the default connection builder restored via t.Cleanup.
+func Serve(t testing.TB, server *Server) {
+	listener := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	trillian.RegisterTrillianAdminServer(s, server)
+	trillian.RegisterTrillianLogServer(s, server)
+	go func() { _ = s.Serve(listener) }()
+
+	trillianutils.SetConnBuilder(func(target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+		server.mu.Lock()
+		server.Targets = append(server.Targets, target)
+		server.mu.Unlock()
+		return grpc.NewClient("passthrough:///bufnet", append(opts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}))...)
+	})
+	t.Cleanup(func() {
+		trillianutils.ResetConnBuilder()
+		s.Stop()
+	})
+}
+
+// ServeUnreachable routes the connections opened by [trillianutils.Dial] to an address refusing them.
+// The default connection builder is restored via t.Cleanup.
+func ServeUnreachable(t testing.TB) {
+	trillianutils.SetConnBuilder(func(_ string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+		return grpc.NewClient("passthrough:///unreachable", append(opts, grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return nil, syscall.ECONNREFUSED
+		}))...)
+	})
+	t.Cleanup(trillianutils.ResetConnBuilder)
+}
+
+// Tree returns a copy of the stored tree, nil when it does not exist.
+func (s *Server) Tree(id int64) *trillian.Tree {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tree, ok := s.Trees[id]; ok {
+		return proto.Clone(tree).(*trillian.Tree)
+	}
+	return nil
+}
+
+// Connections returns the addresses the clients connected to.
+func (s *Server) Connections() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.Targets...)
+}
+
+// DeletedTrees returns the IDs of the deleted trees.
+func (s *Server) DeletedTrees() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int64(nil), s.Deleted...)
+}
+
+func (s *Server) CreateTree(_ context.Context, req *trillian.CreateTreeRequest) (*trillian.Tree, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors["CreateTree"]; err != nil {
+		return nil, err
+	}
+	tree := proto.Clone(req.GetTree()).(*trillian.Tree)
+	tree.TreeId = s.NextTreeID
+	s.NextTreeID++
+	s.Trees[tree.TreeId] = tree
+	return proto.Clone(tree).(*trillian.Tree), nil
+}
+
+func (s *Server) ListTrees(_ context.Context, req *trillian.ListTreesRequest) (*trillian.ListTreesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors["ListTrees"]; err != nil {
+		return nil, err
+	}
+	resp := &trillian.ListTreesResponse{}
+	for _, id := range slices.Sorted(maps.Keys(s.Trees)) {
+		resp.Tree = append(resp.Tree, proto.Clone(s.Trees[id]).(*trillian.Tree))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetTree(_ context.Context, req *trillian.GetTreeRequest) (*trillian.Tree, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors["GetTree"]; err != nil {
+		return nil, err
+	}
+	tree, ok := s.Trees[req.GetTreeId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tree %d not found", req.GetTreeId())
+	}
+	return proto.Clone(tree).(*trillian.Tree), nil
+}
+
+func (s *Server) UpdateTree(_ context.Context, req *trillian.UpdateTreeRequest) (*trillian.Tree, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors["UpdateTree"]; err != nil {
+		return nil, err
+	}
+	tree, ok := s.Trees[req.GetTree().GetTreeId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tree %d not found", req.GetTree().GetTreeId())
+	}
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "tree_state":
+			tree.TreeState = req.GetTree().GetTreeState()
+		case "tree_type":
+			tree.TreeType = req.GetTree().GetTreeType()
+		case "display_name":
+			tree.DisplayName = req.GetTree().GetDisplayName()
+		case "max_root_duration":
+			tree.MaxRootDuration = req.GetTree().GetMaxRootDuration()
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path: %q", path)
+		}
+	}
+	return proto.Clone(tree).(*trillian.Tree), nil
+}
+
+func (s *Server) DeleteTree(_ context.Context, req *trillian.DeleteTreeRequest) (*trillian.Tree, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors["DeleteTree"]; err != nil {
+		return nil, err
+	}
+	tree, ok := s.Trees[req.GetTreeId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tree %d not found", req.GetTreeId())
+	}
+	delete(s.Trees, req.GetTreeId())
+	s.Deleted = append(s.Deleted, req.GetTreeId())
+	return tree, nil
+}
+
+func (s *Server) InitLog(_ context.Context, req *trillian.InitLogRequest) (*trillian.InitLogResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors["InitLog"]; err != nil {
+		return nil, err
+	}
+	if _, ok := s.Sizes[req.GetLogId()]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "tree %d already initialised", req.GetLogId())
+	}
+	s.Sizes[req.GetLogId()] = 0
+	return &trillian.InitLogResponse{}, nil
+}
+
+func (s *Server) GetLatestSignedLogRoot(_ context.Context, req *trillian.GetLatestSignedLogRootRequest) (*trillian.GetLatestSignedLogRootResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.Errors["GetLatestSignedLogRoot"]; err != nil {
+		return nil, err
+	}
+	size, ok := s.Sizes[req.GetLogId()]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "tree %d not initialised", req.GetLogId())
+	}
+	root, err := (&types.LogRootV1{TreeSize: size, RootHash: []byte{byte(size)}}).MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &trillian.GetLatestSignedLogRootResponse{SignedLogRoot: &trillian.SignedLogRoot{LogRoot: root}}, nil
+}
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"context"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// LocalRefWatch enqueues the items of listObj in the namespace of the watched object whose reference points to it.
+func LocalRefWatch(cl client.Client, listObj client.ObjectList, getRef func(client.Object) *rhtasv1.LocalObjectReference) handler.MapFunc {
+	return func(ctx context.Context, object client.Object) []reconcile.Request {
+		list := listObj.DeepCopyObject().(client.ObjectList)
+		if err := cl.List(ctx, list, client.InNamespace(object.GetNamespace())); err != nil {
+			return nil
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, raw := range items {
+			item, ok := raw.(client.Object)
+			if !ok {
+				continue
+			}
+			if ref := getRef(item); ref != nil && ref.Name == object.GetName() {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+			}
+		}
+		return requests
+	}
+}
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestLocalRefWatch(t *testing.T) {
+	g := NewWithT(t)
+	tree := &rhtasv1.TrillianTree{
+		ObjectMeta: metav1.ObjectMeta{Name: "tree", Namespace: "ns1"},
+	}
+	rekor := func(name, namespace string, ref *rhtasv1.LocalObjectReference) client.Object {
+		return &rhtasv1.Rekor{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       rhtasv1.RekorSpec{TreeRef: ref},
+		}
+	}
+
+	c := testAction.FakeClientBuilder().WithObjects(
+		rekor("match", "ns1", &rhtasv1.LocalObjectReference{Name: "tree"}),
+		rekor("other-tree", "ns1", &rhtasv1.LocalObjectReference{Name: "other"}),
+		rekor("no-ref", "ns1", nil),
+		rekor("other-namespace", "ns2", &rhtasv1.LocalObjectReference{Name: "tree"}),
+	).Build()
+
+	mapFn := LocalRefWatch(c, &rhtasv1.RekorList{}, func(o client.Object) *rhtasv1.LocalObjectReference {
+		return o.(*rhtasv1.Rekor).Spec.TreeRef
+	})
+	g.Expect(mapFn(context.Background(), tree)).To(ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "match"}},
+	))
+}
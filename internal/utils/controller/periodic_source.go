@@ -0,0 +1,63 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// PeriodicSource enqueues the items of listObj accepted by the predicates every interval. It triggers the checks
+// of external state that can't be watched. The predicates receive a generic event of each item.
+func PeriodicSource(cl client.Client, listObj client.ObjectList, interval time.Duration, predicates ...predicate.Predicate) source.Source {
+	return source.Func(func(ctx context.Context, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) error {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					for _, request := range periodicRequests(ctx, cl, listObj, predicates) {
+						queue.Add(request)
+					}
+				}
+			}
+		}()
+		return nil
+	})
+}
+
+func periodicRequests(ctx context.Context, cl client.Client, listObj client.ObjectList, predicates []predicate.Predicate) []reconcile.Request {
+	list := listObj.DeepCopyObject().(client.ObjectList)
+	if err := cl.List(ctx, list); err != nil {
+		return nil
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+items:
+	for _, raw := range items {
+		item, ok := raw.(client.Object)
+		if !ok {
+			continue
+		}
+		for _, p := range predicates {
+			if !p.Generic(event.GenericEvent{Object: item}) {
+				continue items
+			}
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: item.GetNamespace(), Name: item.GetName()}})
+	}
+	return requests
+}
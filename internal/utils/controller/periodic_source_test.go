@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestPeriodicSource(t *testing.T) {
+	g := NewWithT(t)
+	fulcio := func(name, namespace string, labels map[string]string) client.Object {
+		return &rhtasv1.Fulcio{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+		}
+	}
+
+	c := testAction.FakeClientBuilder().WithObjects(
+		fulcio("due", "ns1", map[string]string{"due": "true"}),
+		fulcio("due", "ns2", map[string]string{"due": "true"}),
+		fulcio("not-due", "ns1", nil),
+	).Build()
+
+	due := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetLabels()["due"] == "true"
+	})
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	t.Cleanup(queue.ShutDown)
+
+	src := PeriodicSource(c, &rhtasv1.FulcioList{}, 10*time.Millisecond, due)
+	g.Expect(src.Start(t.Context(), queue)).To(Succeed())
+
+	g.Eventually(queue.Len).Should(Equal(2))
+	var requests []reconcile.Request
+	for range 2 {
+		request, _ := queue.Get()
+		requests = append(requests, request)
+		queue.Done(request)
+	}
+	g.Expect(requests).To(ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "due"}},
+		reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns2", Name: "due"}},
+	))
+}
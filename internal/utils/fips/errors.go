@@ -3,11 +3,12 @@ package fips
 import "errors"
 
 var (
-	ErrNonFIPSPrivateKey  = errors.New("private key does not use a FIPS-approved algorithm")
-	ErrNonFIPSPublicKey   = errors.New("public key does not use a FIPS-approved algorithm")
-	ErrNonFIPSCertificate = errors.New("certificate does not use a FIPS-approved algorithm")
-	ErrInvalidPEM         = errors.New("failed to decode PEM data")
-	ErrInvalidDER         = errors.New("failed to parse DER data")
+	ErrNonFIPSPrivateKey   = errors.New("private key does not use a FIPS-approved algorithm")
+	ErrNonFIPSPublicKey    = errors.New("public key does not use a FIPS-approved algorithm")
+	ErrNonFIPSCertificate  = errors.New("certificate does not use a FIPS-approved algorithm")
+	ErrNonFIPSKeyAlgorithm = errors.New("key algorithm is not FIPS-approved")
+	ErrInvalidPEM          = errors.New("failed to decode PEM data")
+	ErrInvalidDER          = errors.New("failed to parse DER data")
 )
 
 type ValidationError struct{ err error }
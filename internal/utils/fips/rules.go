@@ -8,6 +8,8 @@ import (
 	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 const minRSAKeySize = 2048
@@ -59,6 +61,38 @@ func validatePublicKey(key crypto.PublicKey) error {
 	}
 }
 
+// keyAlgorithmCurves maps the curve part of a key algorithm name (e.g. "ecdsa-p384") to the curve.
+var keyAlgorithmCurves = map[string]elliptic.Curve{
+	"p224": elliptic.P224(),
+	"p256": elliptic.P256(),
+	"p384": elliptic.P384(),
+	"p521": elliptic.P521(),
+}
+
+func validateKeyAlgorithm(algorithm string) error {
+	family, param, _ := strings.Cut(algorithm, "-")
+	switch family {
+	case "ecdsa":
+		curve, ok := keyAlgorithmCurves[param]
+		if !ok {
+			return fmt.Errorf("%w: elliptic curve %s is not FIPS-approved; use P-256, P-384, or P-521",
+				ErrNonFIPSKeyAlgorithm, param)
+		}
+		return validateECDSACurve(curve, ErrNonFIPSKeyAlgorithm)
+	case "rsa":
+		bits, err := strconv.Atoi(param)
+		if err != nil {
+			return fmt.Errorf("%w: invalid RSA key size %q", ErrNonFIPSKeyAlgorithm, param)
+		}
+		return validateRSAKeySize(bits, ErrNonFIPSKeyAlgorithm)
+	case "ed25519":
+		return nil
+	default:
+		return fmt.Errorf("%w: %s is not FIPS-approved; use ECDSA (P-256, P-384, P-521), RSA (>= %d bits), or Ed25519",
+			ErrNonFIPSKeyAlgorithm, algorithm, minRSAKeySize)
+	}
+}
+
 func validateSignatureAlgorithm(alg x509.SignatureAlgorithm) error {
 	if fipsApprovedSignatureAlgorithms[alg] {
 		return nil
@@ -77,6 +77,15 @@ func ValidatePublicKeyPEMOrDER(data []byte) error {
 	return ValidatePublicKeyDER(data)
 }
 
+// ValidateKeyAlgorithm returns an error if keys generated with the algorithm (e.g. "ecdsa-p384")
+// would not be FIPS-approved. An empty algorithm selects the component default and is accepted.
+func ValidateKeyAlgorithm(algorithm string) error {
+	if algorithm == "" {
+		return nil
+	}
+	return NewValidationError(validateKeyAlgorithm(algorithm))
+}
+
 // ValidateCertificateChainPEM parses a PEM bundle containing one or more certificates
 // and validates that every certificate in the chain uses FIPS-approved algorithms.
 func ValidateCertificateChainPEM(pemData []byte) (retErr error) {
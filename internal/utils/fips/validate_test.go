@@ -487,3 +487,39 @@ func TestValidateCryptoMaterialIfPEM(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateKeyAlgorithm(t *testing.T) {
+	tests := []struct {
+		algorithm string
+		wantErr   bool
+	}{
+		{algorithm: ""},
+		{algorithm: "ecdsa-p256"},
+		{algorithm: "ecdsa-p384"},
+		{algorithm: "ecdsa-p521"},
+		{algorithm: "rsa-2048"},
+		{algorithm: "rsa-4096"},
+		{algorithm: "ed25519"},
+		{algorithm: "ecdsa-p224", wantErr: true},
+		{algorithm: "ecdsa-secp256k1", wantErr: true},
+		{algorithm: "rsa-1024", wantErr: true},
+		{algorithm: "dsa-2048", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			err := ValidateKeyAlgorithm(tt.algorithm)
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrNonFIPSKeyAlgorithm) {
+				t.Fatalf("expected ErrNonFIPSKeyAlgorithm, got %v", err)
+			}
+			if !IsValidationError(err) {
+				t.Errorf("expected ValidationError, got %T", err)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/securesign/operator/internal/images"
@@ -21,6 +22,60 @@ func DurationFlagOrEnv(p *time.Duration, name string, envName string, defaultVal
 	flag.DurationVar(p, name, defaultValue, usage)
 }
 
+// DurationListFlagOrEnv defines a comma separated duration list flag which can be set by an environment variable.
+// Precedence: flag > env var > default value.
+func DurationListFlagOrEnv(p *[]time.Duration, name string, envName string, usage string) {
+	if value, err := parseDurationList(os.Getenv(envName)); err == nil && len(value) > 0 {
+		*p = value
+	}
+	flag.Func(name, usage, func(s string) error {
+		value, err := parseDurationList(s)
+		if err != nil {
+			return err
+		}
+		*p = value
+		return nil
+	})
+}
+
+func parseDurationList(s string) ([]time.Duration, error) {
+	var result []time.Duration
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		d, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+	return result, nil
+}
+
+// StringListFlagOrEnv defines a comma separated string list flag which can be set by an environment variable.
+// Precedence: flag > env var > default value.
+func StringListFlagOrEnv(p *[]string, name string, envName string, usage string) {
+	if value := parseStringList(os.Getenv(envName)); len(value) > 0 {
+		*p = value
+	}
+	flag.Func(name, usage, func(s string) error {
+		*p = parseStringList(s)
+		return nil
+	})
+}
+
+func parseStringList(s string) []string {
+	var result []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
 // StringFlagOrEnv defines a string flag which can be set by an environment variable.
 // Precedence: flag > env var > default value.
 func StringFlagOrEnv(p *string, name string, envName string, defaultValue string, usage string) {
@@ -50,7 +50,23 @@ func ResetClientBuilder() {
 // DefaultClientBuilder builds a TLS-aware HTTP client that trusts the system CA pool,
 // the OpenShift/Kubernetes service CA (if present on disk), and any additional PEM-encoded CA bundles.
 // CA files are read on each call to pick up rotations (kubelet updates mounted files in-place).
+// The cluster-wide proxy is used as configured by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables of the operator.
 func DefaultClientBuilder(additionalCAs ...[]byte) *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				RootCAs:    CertPool(additionalCAs...),
+				MinVersion: tls.VersionTLS12,
+			},
+		},
+	}
+}
+
+// CertPool returns the system CA pool extended with the OpenShift/Kubernetes service CA (if present on disk)
+// and any additional PEM-encoded CA bundles.
+func CertPool(additionalCAs ...[]byte) *x509.CertPool {
 	pool, err := x509.SystemCertPool()
 	if err != nil {
 		pool = x509.NewCertPool()
@@ -63,16 +79,7 @@ func DefaultClientBuilder(additionalCAs ...[]byte) *http.Client {
 	for _, ca := range additionalCAs {
 		pool.AppendCertsFromPEM(ca)
 	}
-
-	return &http.Client{
-		Timeout: 10 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:    pool,
-				MinVersion: tls.VersionTLS12,
-			},
-		},
-	}
+	return pool
 }
 
 // FetchFromAPI performs an HTTP GET request to the given URL and returns the response body.
@@ -0,0 +1,73 @@
+package keys
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	rhtasv1 "github.com/securesign/operator/api/v1"
+)
+
+var ErrUnsupportedAlgorithm = errors.New("unsupported key algorithm")
+
+// Generate creates a new private key with the given algorithm.
+func Generate(algorithm rhtasv1.KeyAlgorithm) (crypto.Signer, error) {
+	switch algorithm {
+	case rhtasv1.KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case rhtasv1.KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case rhtasv1.KeyAlgorithmECDSAP521:
+		return ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case rhtasv1.KeyAlgorithmRSA2048:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case rhtasv1.KeyAlgorithmRSA3072:
+		return rsa.GenerateKey(rand.Reader, 3072)
+	case rhtasv1.KeyAlgorithmRSA4096:
+		return rsa.GenerateKey(rand.Reader, 4096)
+	case rhtasv1.KeyAlgorithmEd25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
+
+// MarshalPrivateKey PEM encodes the private key.
+// ECDSA keys are encoded as SEC 1, RSA keys as PKCS #1 and Ed25519 keys as PKCS #8.
+func MarshalPrivateKey(key crypto.Signer) ([]byte, error) {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+	case *rsa.PrivateKey:
+		return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}), nil
+	case ed25519.PrivateKey:
+		der, err := x509.MarshalPKCS8PrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedAlgorithm, key)
+	}
+}
+
+// MarshalPublicKey PEM encodes the public key in the PKIX format.
+func MarshalPublicKey(key crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
@@ -0,0 +1,87 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		algorithm rhtasv1.KeyAlgorithm
+		pemType   string
+		verify    func(Gomega, any)
+	}{
+		{
+			algorithm: rhtasv1.KeyAlgorithmECDSAP256,
+			pemType:   "EC PRIVATE KEY",
+			verify: func(g Gomega, key any) {
+				g.Expect(key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+				g.Expect(key.(*ecdsa.PrivateKey).Curve.Params().Name).To(Equal("P-256"))
+			},
+		},
+		{
+			algorithm: rhtasv1.KeyAlgorithmECDSAP521,
+			pemType:   "EC PRIVATE KEY",
+			verify: func(g Gomega, key any) {
+				g.Expect(key.(*ecdsa.PrivateKey).Curve.Params().Name).To(Equal("P-521"))
+			},
+		},
+		{
+			algorithm: rhtasv1.KeyAlgorithmRSA3072,
+			pemType:   "RSA PRIVATE KEY",
+			verify: func(g Gomega, key any) {
+				g.Expect(key.(*rsa.PrivateKey).N.BitLen()).To(Equal(3072))
+			},
+		},
+		{
+			algorithm: rhtasv1.KeyAlgorithmEd25519,
+			pemType:   "PRIVATE KEY",
+			verify: func(g Gomega, key any) {
+				g.Expect(key).To(BeAssignableToTypeOf(ed25519.PrivateKey{}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.algorithm), func(t *testing.T) {
+			g := NewWithT(t)
+			key, err := Generate(tt.algorithm)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			data, err := MarshalPrivateKey(key)
+			g.Expect(err).ToNot(HaveOccurred())
+			block, _ := pem.Decode(data)
+			g.Expect(block).ToNot(BeNil())
+			g.Expect(block.Type).To(Equal(tt.pemType))
+
+			var parsed any
+			switch block.Type {
+			case "EC PRIVATE KEY":
+				parsed, err = x509.ParseECPrivateKey(block.Bytes)
+			case "RSA PRIVATE KEY":
+				parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+			default:
+				parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			tt.verify(g, parsed)
+
+			pub, err := MarshalPublicKey(key.Public())
+			g.Expect(err).ToNot(HaveOccurred())
+			block, _ = pem.Decode(pub)
+			g.Expect(block.Type).To(Equal("PUBLIC KEY"))
+		})
+	}
+}
+
+func TestGenerate_Unsupported(t *testing.T) {
+	g := NewWithT(t)
+	_, err := Generate("dsa-1024")
+	g.Expect(err).To(MatchError(ErrUnsupportedAlgorithm))
+}
@@ -78,13 +78,23 @@ func IsOpenShift() bool {
 	return config.Openshift
 }
 
+// IsNamespaceScoped reports whether the operator watches only the namespaces listed in config.WatchNamespaces.
+func IsNamespaceScoped() bool {
+	return len(config.WatchNamespaces) > 0
+}
+
+// CalculateHostname returns the default hostname of a service. On OpenShift the cluster ingress domain is used.
+// A namespace-scoped operator usually cannot read the cluster ingress config and falls back to config.IngressHostTemplate.
 func CalculateHostname(ctx context.Context, client client.Client, svcName, ns string) (string, error) {
 	if IsOpenShift() {
 		ingress := &configv1.Ingress{}
-		if err := client.Get(ctx, types.NamespacedName{Name: "cluster"}, ingress); err != nil {
+		err := client.Get(ctx, types.NamespacedName{Name: "cluster"}, ingress)
+		if err == nil {
+			return fmt.Sprintf("%s-%s.%s", svcName, ns, ingress.Spec.Domain), nil
+		}
+		if !IsNamespaceScoped() || !apiErrors.IsForbidden(err) {
 			return "", err
 		}
-		return fmt.Sprintf("%s-%s.%s", svcName, ns, ingress.Spec.Domain), nil
 	}
 	return fmt.Sprintf(config.IngressHostTemplate, svcName, ns), nil
 }
@@ -112,11 +122,20 @@ func Create[T client.Object](ctx context.Context, cli client.Client, obj T, fn .
 	return cli.Create(ctx, obj)
 }
 
+// CreateOrUpdate creates obj or updates it with the ensure functions fn.
+// With a [DriftTracker] in ctx, corrections of a resource modified outside of the operator since its last
+// reconciliation are reported as [Drift].
 func CreateOrUpdate[T client.Object](ctx context.Context, cli client.Client, obj T, fn ...func(object T) error) (result controllerutil.OperationResult, err error) {
+	tracker, owner := driftTrackerFrom(ctx)
+	var (
+		drift *Drift
+		state appliedState
+	)
 	err = retry.OnError(retry.DefaultRetry, func(err error) bool {
 		return apiErrors.IsConflict(err) || apiErrors.IsAlreadyExists(err)
 	}, func() error {
 		var createUpdateError error
+		drift, state = nil, appliedState{}
 		result, createUpdateError = controllerutil.CreateOrUpdate(ctx, cli, obj, func() (fnError error) {
 			annoStr, find := obj.GetAnnotations()[annotations.PausedReconciliation]
 			if find {
@@ -125,12 +144,35 @@ func CreateOrUpdate[T client.Object](ctx context.Context, cli client.Client, obj
 					return
 				}
 			}
+			var live client.Object
+			if obj.GetResourceVersion() != "" {
+				live = obj.DeepCopyObject().(client.Object)
+			}
 			for _, f := range fn {
 				fnError = errors.Join(fnError, f(obj))
 			}
+			if fnError == nil && tracker != nil {
+				if live != nil {
+					drift, state, fnError = tracker.detectDrift(live, obj)
+				} else {
+					state.desired = obj.DeepCopyObject().(client.Object)
+				}
+			}
 			return
 		})
 		return createUpdateError
 	})
+	if err != nil || tracker == nil || state.desired == nil {
+		return
+	}
+	if IsDryRun(ctx) {
+		return
+	}
+	if drift != nil {
+		tracker.reportDrift(ctx, owner, obj, drift)
+	}
+	state.owner = owner.key()
+	state.version = appliedVersion(obj)
+	tracker.store(obj, state)
 	return
 }
@@ -10,8 +10,10 @@ import (
 	"github.com/securesign/operator/internal/config"
 	testAction "github.com/securesign/operator/internal/testing/action"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -140,6 +142,39 @@ func TestCalculateHostname_OpenShift_MissingIngress(t *testing.T) {
 	}
 }
 
+func TestCalculateHostname_OpenShift_Forbidden(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(configv1.AddToScheme(scheme))
+
+	origOpenshift, origNamespaces, origTemplate := config.Openshift, config.WatchNamespaces, config.IngressHostTemplate
+	t.Cleanup(func() {
+		config.Openshift, config.WatchNamespaces, config.IngressHostTemplate = origOpenshift, origNamespaces, origTemplate
+	})
+	config.Openshift = true
+	config.IngressHostTemplate = "%[1]s-%[2]s.apps.example.com"
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+			return apierrors.NewForbidden(schema.GroupResource{Group: "config.openshift.io", Resource: "ingresses"}, "cluster", fmt.Errorf("denied"))
+		},
+	}).Build()
+
+	config.WatchNamespaces = nil
+	_, err := CalculateHostname(context.Background(), cli, "rekor-server", "test-ns")
+	if !apierrors.IsForbidden(err) {
+		t.Fatalf("expected forbidden error for a cluster-scoped operator, got %v", err)
+	}
+
+	config.WatchNamespaces = []string{"test-ns"}
+	result, err := CalculateHostname(context.Background(), cli, "rekor-server", "test-ns")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != "rekor-server-test-ns.apps.example.com" {
+		t.Errorf("expected fallback to the host template, got %q", result)
+	}
+}
+
 func TestCreate(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
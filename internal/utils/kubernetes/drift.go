@@ -0,0 +1,327 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/securesign/operator/internal/annotations"
+	"github.com/securesign/operator/internal/metrics"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// maxDriftFieldsInEvent limits the field paths listed in a drift event message.
+const maxDriftFieldsInEvent = 5
+
+// Drift describes the fields of a managed resource corrected by [CreateOrUpdate] after an external modification.
+type Drift struct {
+	// Fields lists the paths of the changed fields, e.g. spec.template.spec.containers[0].image.
+	Fields []string
+	// Reverted is false when the resource is annotated with [annotations.DriftReportOnly].
+	Reverted bool
+}
+
+// DriftTracker remembers the state of the resources managed by a reconciler, so that [CreateOrUpdate] detects
+// resources modified by someone else since they were last handled. A reconciler owns one tracker and adds it to
+// the context of each reconciliation with [WithDriftTracker].
+type DriftTracker struct {
+	recorder events.EventRecorder
+	mu       sync.Mutex
+	applied  map[string]appliedState
+}
+
+// appliedState is the state of a managed resource after it was last written or observed by [CreateOrUpdate].
+type appliedState struct {
+	// owner identifies the reconciled custom resource, see [DriftTracker.Forget]
+	owner string
+	// version of the live resource, a different live version means the resource was modified by someone else
+	version string
+	// desired is the resource as computed by the ensure functions, before external modifications were kept
+	desired client.Object
+	// reported lists the drifted fields kept in report-only mode, they are reported once
+	reported string
+}
+
+// NewDriftTracker returns a tracker which emits the ConfigurationDrift events with recorder, events are not
+// emitted when it is nil.
+func NewDriftTracker(recorder events.EventRecorder) *DriftTracker {
+	return &DriftTracker{recorder: recorder, applied: map[string]appliedState{}}
+}
+
+type driftTrackerKey struct{}
+
+type driftTrackerValue struct {
+	tracker *DriftTracker
+	owner   driftOwner
+}
+
+// driftOwner identifies the custom resource a managed resource is reconciled for.
+type driftOwner struct {
+	kind, namespace, name string
+}
+
+func (o driftOwner) key() string {
+	return ownerKey(o.kind, o.namespace, o.name)
+}
+
+// WithDriftTracker enables drift detection in [CreateOrUpdate] for the resources managed on behalf of owner.
+func WithDriftTracker(ctx context.Context, tracker *DriftTracker, owner client.Object) context.Context {
+	return context.WithValue(ctx, driftTrackerKey{}, driftTrackerValue{
+		tracker: tracker,
+		owner:   driftOwner{kind: metrics.KindOf(owner), namespace: owner.GetNamespace(), name: owner.GetName()},
+	})
+}
+
+func driftTrackerFrom(ctx context.Context) (*DriftTracker, driftOwner) {
+	value, ok := ctx.Value(driftTrackerKey{}).(driftTrackerValue)
+	if !ok {
+		return nil, driftOwner{}
+	}
+	return value.tracker, value.owner
+}
+
+// Forget drops the state of the resources managed on behalf of a deleted custom resource.
+func (t *DriftTracker) Forget(kind, namespace, name string) {
+	owner := ownerKey(kind, namespace, name)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for key, state := range t.applied {
+		if state.owner == owner {
+			delete(t.applied, key)
+		}
+	}
+}
+
+func ownerKey(kind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s", kind, namespace, name)
+}
+
+func appliedVersionKey(obj client.Object) string {
+	return fmt.Sprintf("%s/%s/%s", metrics.KindOf(obj), obj.GetNamespace(), obj.GetName())
+}
+
+// appliedVersion identifies a revision of the desired state. The generation ignores status updates but not
+// metadata changes, so it is combined with the labels and annotations. Resources without generation (ConfigMaps,
+// Secrets) fall back to the resourceVersion.
+func appliedVersion(obj client.Object) string {
+	if obj.GetGeneration() == 0 {
+		return obj.GetResourceVersion()
+	}
+	hash := fnv.New64a()
+	for _, values := range []map[string]string{obj.GetLabels(), obj.GetAnnotations()} {
+		keys := slices.Sorted(maps.Keys(values))
+		for _, key := range keys {
+			fmt.Fprintf(hash, "%s=%s\x00", key, values[key])
+		}
+		hash.Write([]byte{0xff})
+	}
+	return fmt.Sprintf("%d/%x", obj.GetGeneration(), hash.Sum64())
+}
+
+func (t *DriftTracker) load(obj client.Object) (appliedState, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state, ok := t.applied[appliedVersionKey(obj)]
+	return state, ok
+}
+
+func (t *DriftTracker) store(obj client.Object, state appliedState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.applied[appliedVersionKey(obj)] = state
+}
+
+func isDriftReportOnly(obj client.Object) bool {
+	value, err := strconv.ParseBool(obj.GetAnnotations()[annotations.DriftReportOnly])
+	return err == nil && value
+}
+
+// DiffFields returns the paths of the fields that differ between two objects.
+func DiffFields(before, after runtime.Object) ([]string, error) {
+	b, err := runtime.DefaultUnstructuredConverter.ToUnstructured(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := runtime.DefaultUnstructuredConverter.ToUnstructured(after)
+	if err != nil {
+		return nil, err
+	}
+	var fields []string
+	diffValues("", b, a, &fields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func diffValues(path string, before, after any, fields *[]string) {
+	bm, bIsMap := before.(map[string]any)
+	am, aIsMap := after.(map[string]any)
+	if bIsMap && aIsMap {
+		keys := map[string]struct{}{}
+		for k := range bm {
+			keys[k] = struct{}{}
+		}
+		for k := range am {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			diffValues(child, bm[k], am[k], fields)
+		}
+		return
+	}
+
+	bl, bIsList := before.([]any)
+	al, aIsList := after.([]any)
+	if bIsList && aIsList && len(bl) == len(al) {
+		for i := range bl {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), bl[i], al[i], fields)
+		}
+		return
+	}
+
+	if !equality.Semantic.DeepEqual(before, after) {
+		*fields = append(*fields, path)
+	}
+}
+
+// mergeDesiredChanges applies the changes between the last and the current desired state to the live value,
+// values which are unchanged in the desired state keep their live value.
+func mergeDesiredChanges(last, desired, live any) any {
+	if equality.Semantic.DeepEqual(last, desired) {
+		return live
+	}
+
+	lm, lIsMap := last.(map[string]any)
+	dm, dIsMap := desired.(map[string]any)
+	vm, vIsMap := live.(map[string]any)
+	if lIsMap && dIsMap && vIsMap {
+		merged := make(map[string]any, len(vm))
+		for k, v := range vm {
+			merged[k] = v
+		}
+		keys := map[string]struct{}{}
+		for k := range lm {
+			keys[k] = struct{}{}
+		}
+		for k := range dm {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			if v := mergeDesiredChanges(lm[k], dm[k], vm[k]); v != nil {
+				merged[k] = v
+			} else {
+				delete(merged, k)
+			}
+		}
+		return merged
+	}
+
+	ll, lIsList := last.([]any)
+	dl, dIsList := desired.([]any)
+	vl, vIsList := live.([]any)
+	if lIsList && dIsList && vIsList && len(ll) == len(dl) && len(dl) == len(vl) {
+		merged := make([]any, len(vl))
+		for i := range vl {
+			merged[i] = mergeDesiredChanges(ll[i], dl[i], vl[i])
+		}
+		return merged
+	}
+	return desired
+}
+
+// keepDrift replaces obj with the live object updated by the changes of the desired state since last.
+func keepDrift(last, live, obj client.Object) error {
+	values := make([]map[string]any, 3)
+	for i, o := range []client.Object{last, obj, live} {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return err
+		}
+		values[i] = u
+	}
+	merged, _ := mergeDesiredChanges(values[0], values[1], values[2]).(map[string]any)
+	result := reflect.New(reflect.TypeOf(obj).Elem()).Interface()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(merged, result); err != nil {
+		return err
+	}
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(result).Elem())
+	return nil
+}
+
+// detectDrift compares the live object with the object mutated by the ensure functions and returns the state to
+// remember after the update.
+//
+// In report-only mode the external modifications are kept: only the fields changed in the desired state since the
+// last reconciliation are applied to the live object. The drift is reported once until the drifted fields change.
+func (t *DriftTracker) detectDrift(live, obj client.Object) (*Drift, appliedState, error) {
+	next := appliedState{desired: obj.DeepCopyObject().(client.Object)}
+	state, known := t.load(live)
+	if !known {
+		return nil, next, nil
+	}
+
+	if isDriftReportOnly(live) {
+		if err := keepDrift(state.desired, live, obj); err != nil {
+			return nil, next, err
+		}
+		fields, err := DiffFields(obj, next.desired)
+		if err != nil || len(fields) == 0 {
+			return nil, next, err
+		}
+		next.reported = strings.Join(fields, ",")
+		if next.reported == state.reported {
+			return nil, next, nil
+		}
+		return &Drift{Fields: fields}, next, nil
+	}
+
+	if state.version == appliedVersion(live) {
+		return nil, next, nil
+	}
+	fields, err := DiffFields(live, obj)
+	if err != nil || len(fields) == 0 {
+		return nil, next, err
+	}
+	return &Drift{Fields: fields, Reverted: true}, next, nil
+}
+
+// reportDrift logs the drifted fields, counts the drift for the owner and emits an event on the managed resource.
+func (t *DriftTracker) reportDrift(ctx context.Context, owner driftOwner, obj client.Object, drift *Drift) {
+	kind := metrics.KindOf(obj)
+	mode := "reverted"
+	if !drift.Reverted {
+		mode = "reported"
+	}
+	ctrllog.FromContext(ctx).Info("configuration drift of managed resource", "kind", kind, "namespace", obj.GetNamespace(),
+		"name", obj.GetName(), "mode", mode, "fields", drift.Fields)
+	metrics.IncConfigurationDrift(owner.kind, owner.namespace, owner.name, kind, mode)
+
+	if t.recorder == nil {
+		return
+	}
+	fields := drift.Fields
+	if len(fields) > maxDriftFieldsInEvent {
+		fields = append(fields[:maxDriftFieldsInEvent:maxDriftFieldsInEvent], fmt.Sprintf("and %d more", len(drift.Fields)-maxDriftFieldsInEvent))
+	}
+	action := "Reverted"
+	if !drift.Reverted {
+		action = "Detected"
+	}
+	t.recorder.Eventf(obj, nil, v1.EventTypeWarning, "ConfigurationDrift", action,
+		"%s %s was modified outside of the operator, %s fields: %s", kind, obj.GetName(), mode, strings.Join(fields, ", "))
+}
@@ -0,0 +1,189 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/securesign/operator/internal/annotations"
+	testAction "github.com/securesign/operator/internal/testing/action"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func ensureData(value string) func(*corev1.ConfigMap) error {
+	return func(cm *corev1.ConfigMap) error {
+		cm.Data = map[string]string{"key": value}
+		return nil
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	g := gomega.NewWithT(t)
+	before := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "a"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "server", Image: "server:1"},
+			{Name: "sidecar", Image: "sidecar:1"},
+		}},
+	}
+	after := before.DeepCopy()
+	after.Labels["extra"] = "b"
+	after.Spec.Containers[1].Image = "sidecar:2"
+	after.Spec.ServiceAccountName = "sa"
+
+	fields, err := DiffFields(before, after)
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(fields).To(gomega.Equal([]string{
+		"metadata.labels.extra",
+		"spec.containers[1].image",
+		"spec.serviceAccountName",
+	}))
+}
+
+func TestAppliedVersion(t *testing.T) {
+	g := gomega.NewWithT(t)
+	applied := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Generation:      2,
+		ResourceVersion: "10",
+		Labels:          map[string]string{"app": "a"},
+		Annotations:     map[string]string{"note": "a"},
+	}}
+
+	status := applied.DeepCopy()
+	status.ResourceVersion = "11"
+	status.Status.ReadyReplicas = 1
+	g.Expect(appliedVersion(status)).To(gomega.Equal(appliedVersion(applied)), "status updates are not drift")
+
+	label := applied.DeepCopy()
+	label.Labels["app"] = "b"
+	g.Expect(appliedVersion(label)).ToNot(gomega.Equal(appliedVersion(applied)))
+
+	annotation := applied.DeepCopy()
+	annotation.Annotations["extra"] = "b"
+	g.Expect(appliedVersion(annotation)).ToNot(gomega.Equal(appliedVersion(applied)))
+
+	spec := applied.DeepCopy()
+	spec.Generation = 3
+	g.Expect(appliedVersion(spec)).ToNot(gomega.Equal(appliedVersion(applied)))
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "10"}}
+	g.Expect(appliedVersion(configMap)).To(gomega.Equal("10"))
+}
+
+func TestCreateOrUpdate_Drift(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := t.Context()
+	recorder := events.NewFakeRecorder(10)
+	tracker := NewDriftTracker(recorder)
+	ctx = WithDriftTracker(ctx, tracker, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default"}})
+
+	c := testAction.FakeClientBuilder().Build()
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "drift", Namespace: "default"}}
+
+	result, err := CreateOrUpdate(ctx, c, cm, ensureData("desired"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(controllerutil.OperationResultCreated))
+
+	// a desired state change of the operator is not a drift
+	result, err = CreateOrUpdate(ctx, c, &corev1.ConfigMap{ObjectMeta: cm.ObjectMeta}, ensureData("changed"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(controllerutil.OperationResultUpdated))
+	g.Expect(recorder.Events).To(gomega.BeEmpty())
+
+	// external modification is reverted and reported
+	live := &corev1.ConfigMap{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), live)).To(gomega.Succeed())
+	live.Data["key"] = "external"
+	g.Expect(c.Update(ctx, live)).To(gomega.Succeed())
+
+	result, err = CreateOrUpdate(ctx, c, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "drift", Namespace: "default"}}, ensureData("changed"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(controllerutil.OperationResultUpdated))
+	g.Expect(recorder.Events).To(gomega.Receive(gomega.And(
+		gomega.ContainSubstring("ConfigurationDrift"),
+		gomega.ContainSubstring("reverted fields: data.key"),
+	)))
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), live)).To(gomega.Succeed())
+	g.Expect(live.Data["key"]).To(gomega.Equal("changed"))
+
+	// report-only keeps the external modification
+	live.Annotations = map[string]string{annotations.DriftReportOnly: "true"}
+	live.Data["key"] = "external"
+	g.Expect(c.Update(ctx, live)).To(gomega.Succeed())
+
+	result, err = CreateOrUpdate(ctx, c, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "drift", Namespace: "default"}}, ensureData("changed"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(result).To(gomega.Equal(controllerutil.OperationResultNone))
+	g.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring("reported fields: data.key")))
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), live)).To(gomega.Succeed())
+	g.Expect(live.Data["key"]).To(gomega.Equal("external"))
+
+	// the drift is reported once
+	_, err = CreateOrUpdate(ctx, c, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "drift", Namespace: "default"}}, ensureData("changed"))
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	g.Expect(recorder.Events).To(gomega.BeEmpty())
+
+	tracker.Forget("ConfigMap", "default", "owner")
+	g.Expect(tracker.applied).To(gomega.BeEmpty())
+}
+
+func ensureDeployment(image string, replicas int32) func(*appsv1.Deployment) error {
+	return func(d *appsv1.Deployment) error {
+		d.Spec.Replicas = &replicas
+		d.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "drift"}}
+		d.Spec.Template.Labels = map[string]string{"app": "drift"}
+		d.Spec.Template.Spec.Containers = []corev1.Container{{Name: "server", Image: image}}
+		return nil
+	}
+}
+
+func TestCreateOrUpdate_DriftReportOnly(t *testing.T) {
+	g := gomega.NewWithT(t)
+	recorder := events.NewFakeRecorder(10)
+	ctx := WithDriftTracker(t.Context(), NewDriftTracker(recorder), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default"}})
+	c := testAction.FakeClientBuilder().Build()
+	key := client.ObjectKey{Name: "drift", Namespace: "default"}
+	reconcile := func(image string) {
+		_, err := CreateOrUpdate(ctx, c, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}, ensureDeployment(image, 1))
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+	}
+	live := func() *appsv1.Deployment {
+		d := &appsv1.Deployment{}
+		g.Expect(c.Get(ctx, key, d)).To(gomega.Succeed())
+		return d
+	}
+
+	reconcile("server:1")
+	d := live()
+	d.Annotations = map[string]string{annotations.DriftReportOnly: "true"}
+	d.Spec.Replicas = ptr.To(int32(3))
+	g.Expect(c.Update(ctx, d)).To(gomega.Succeed())
+
+	reconcile("server:1")
+	g.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring("reported fields: spec.replicas")))
+	g.Expect(*live().Spec.Replicas).To(gomega.Equal(int32(3)))
+
+	// the drift is kept by the following reconciliations
+	reconcile("server:1")
+	g.Expect(recorder.Events).To(gomega.BeEmpty())
+	g.Expect(*live().Spec.Replicas).To(gomega.Equal(int32(3)))
+
+	// changes of the desired state are applied
+	reconcile("server:2")
+	g.Expect(recorder.Events).To(gomega.BeEmpty())
+	g.Expect(live().Spec.Template.Spec.Containers[0].Image).To(gomega.Equal("server:2"))
+	g.Expect(*live().Spec.Replicas).To(gomega.Equal(int32(3)))
+
+	// the drift is reverted without the annotation
+	d = live()
+	d.Annotations = nil
+	g.Expect(c.Update(ctx, d)).To(gomega.Succeed())
+	reconcile("server:2")
+	g.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring("reverted fields: spec.replicas")))
+	g.Expect(*live().Spec.Replicas).To(gomega.Equal(int32(1)))
+}
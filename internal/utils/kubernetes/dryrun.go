@@ -0,0 +1,17 @@
+package kubernetes
+
+import "context"
+
+type dryRunKey struct{}
+
+// WithDryRun marks ctx as a dry run. Writes made by a dry run are not persisted, so they do not update
+// the state kept for later reconciliations, e.g. the versions used for drift detection.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+// IsDryRun reports whether ctx belongs to a dry run.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return dryRun
+}
@@ -2,11 +2,13 @@ package deployment
 
 import (
 	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/utils"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	"github.com/securesign/operator/internal/utils/kubernetes/ensure"
 	tlsensure "github.com/securesign/operator/internal/utils/tls/ensure"
 	v1 "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 )
 
 func Proxy(noProxy ...string) func(*v1.Deployment) error {
@@ -41,8 +43,12 @@ func PodRequirements(requirements rhtasv1.PodRequirements, containerName string)
 		deployment.Spec.Replicas = requirements.Replicas
 
 		template := &deployment.Spec.Template
-		template.Spec.Affinity = requirements.Affinity
-		template.Spec.Tolerations = requirements.Tolerations
+		if err := ensure.PodScheduling(requirements)(&template.Spec); err != nil {
+			return err
+		}
+		if ptr.Deref(requirements.Replicas, 1) > 1 {
+			defaultZoneSpread(deployment)
+		}
 
 		container := kubernetes.FindContainerByNameOrCreate(&template.Spec, containerName)
 		if requirements.Resources != nil {
@@ -54,6 +60,39 @@ func PodRequirements(requirements rhtasv1.PodRequirements, containerName string)
 	}
 }
 
+// defaultZoneSpread spreads the deployment pods across zones unless the user configured
+// own topology spread constraints.
+func defaultZoneSpread(dp *v1.Deployment) {
+	if len(dp.Spec.Template.Spec.TopologySpreadConstraints) == 0 && dp.Spec.Selector != nil {
+		dp.Spec.Template.Spec.TopologySpreadConstraints = ensure.ZoneSpread(dp.Spec.Selector)
+	}
+}
+
+// Autoscaling applies the ensure functions and hands spec.replicas over to the
+// HorizontalPodAutoscaler when autoscaling is enabled. The replica count of an existing
+// Deployment is kept and a new Deployment starts with MinReplicas.
+func Autoscaling(autoscaling rhtasv1.Autoscaling, fns ...func(*v1.Deployment) error) func(*v1.Deployment) error {
+	return func(dp *v1.Deployment) error {
+		current := dp.Spec.Replicas
+		for _, fn := range fns {
+			if err := fn(dp); err != nil {
+				return err
+			}
+		}
+		if !utils.IsEnabled(autoscaling.Enabled) {
+			return nil
+		}
+		if autoscaling.MaxReplicas > 1 {
+			defaultZoneSpread(dp)
+		}
+		if current == nil {
+			current = ptr.To(ptr.Deref(autoscaling.MinReplicas, 1))
+		}
+		dp.Spec.Replicas = current
+		return nil
+	}
+}
+
 // PodExtensions applies user-defined init containers, volumes, and volume mounts
 // to a Deployment. It is a standalone ensure function so callers can compose it
 // independently from signer-specific logic.
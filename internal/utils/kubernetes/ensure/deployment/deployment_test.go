@@ -252,12 +252,66 @@ func TestPodRequirements(t *testing.T) {
 				g.Expect(deployment.Spec.Replicas).To(gomega.Equal(ptr.To(int32(10))))
 			},
 		},
+		{
+			name: "scheduling",
+			args: args{
+				requirements: rhtasv1.PodRequirements{
+					NodeSelector:      map[string]string{"node-role.kubernetes.io/tas": ""},
+					PriorityClassName: "high-priority",
+					RuntimeClassName:  ptr.To("kata"),
+				},
+			},
+			verify: func(g gomega.Gomega, deployment *v1.Deployment) {
+				g.Expect(deployment.Spec.Template.Spec.NodeSelector).To(gomega.HaveKey("node-role.kubernetes.io/tas"))
+				g.Expect(deployment.Spec.Template.Spec.PriorityClassName).To(gomega.Equal("high-priority"))
+				g.Expect(deployment.Spec.Template.Spec.RuntimeClassName).To(gomega.Equal(ptr.To("kata")))
+				g.Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints).To(gomega.BeEmpty())
+			},
+		},
+		{
+			name: "default zone spread for multiple replicas",
+			args: args{
+				requirements: rhtasv1.PodRequirements{
+					Replicas: ptr.To(int32(3)),
+				},
+			},
+			verify: func(g gomega.Gomega, deployment *v1.Deployment) {
+				g.Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints).To(gomega.HaveLen(1))
+				constraint := deployment.Spec.Template.Spec.TopologySpreadConstraints[0]
+				g.Expect(constraint.TopologyKey).To(gomega.Equal("topology.kubernetes.io/zone"))
+				g.Expect(constraint.WhenUnsatisfiable).To(gomega.Equal(core.ScheduleAnyway))
+				g.Expect(constraint.LabelSelector.MatchLabels).To(gomega.Equal(deployment.Spec.Selector.MatchLabels))
+			},
+		},
+		{
+			name: "custom topology spread constraints",
+			args: args{
+				requirements: rhtasv1.PodRequirements{
+					Replicas: ptr.To(int32(3)),
+					TopologySpreadConstraints: []core.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       "kubernetes.io/hostname",
+							WhenUnsatisfiable: core.DoNotSchedule,
+						},
+					},
+				},
+			},
+			verify: func(g gomega.Gomega, deployment *v1.Deployment) {
+				g.Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints).To(gomega.HaveLen(1))
+				g.Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints[0].TopologyKey).To(gomega.Equal("kubernetes.io/hostname"))
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			g := gomega.NewWithT(t)
-			dp := &v1.Deployment{}
+			dp := &v1.Deployment{
+				Spec: v1.DeploymentSpec{
+					Selector: &v2.LabelSelector{MatchLabels: map[string]string{"app": name}},
+				},
+			}
 			fn := PodRequirements(tt.args.requirements, tt.args.containerName)
 			if got := fn(dp); got != nil {
 				t.Errorf("PodRequirements() = %v", got)
@@ -266,3 +320,45 @@ func TestPodRequirements(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoscaling(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		autoscaling rhtasv1.Autoscaling
+		current     *int32
+		want        *int32
+	}{
+		{
+			name:    "disabled",
+			current: ptr.To(int32(5)),
+			want:    ptr.To(int32(2)),
+		},
+		{
+			name:        "enabled keeps replicas of existing deployment",
+			autoscaling: rhtasv1.Autoscaling{Enabled: ptr.To(true), MinReplicas: ptr.To(int32(3))},
+			current:     ptr.To(int32(5)),
+			want:        ptr.To(int32(5)),
+		},
+		{
+			name:        "enabled starts new deployment with min replicas",
+			autoscaling: rhtasv1.Autoscaling{Enabled: ptr.To(true), MinReplicas: ptr.To(int32(3))},
+			want:        ptr.To(int32(3)),
+		},
+		{
+			name:        "enabled without min replicas",
+			autoscaling: rhtasv1.Autoscaling{Enabled: ptr.To(true)},
+			want:        ptr.To(int32(1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			dp := &v1.Deployment{Spec: v1.DeploymentSpec{Replicas: tt.current}}
+			fn := Autoscaling(tt.autoscaling, PodRequirements(rhtasv1.PodRequirements{Replicas: ptr.To(int32(2))}, "container"))
+			g.Expect(fn(dp)).To(gomega.Succeed())
+			g.Expect(dp.Spec.Replicas).To(gomega.Equal(tt.want))
+		})
+	}
+}
@@ -4,12 +4,15 @@ import (
 	v1 "github.com/securesign/operator/api/v1"
 	"github.com/securesign/operator/internal/utils/kubernetes"
 	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
 const (
 	runAsUser  int64 = 1001
 	runAsGroup int64 = 1001
+
+	zoneTopologyKey = "topology.kubernetes.io/zone"
 )
 
 func PodSecurityContext(spec *core.PodSpec) error {
@@ -69,6 +72,32 @@ func ensureContainerSecurityContext(container *core.Container) {
 	}
 }
 
+// PodScheduling applies the user-defined scheduling constraints to a PodSpec.
+func PodScheduling(requirements v1.PodRequirements) func(*core.PodSpec) error {
+	return func(spec *core.PodSpec) error {
+		spec.Affinity = requirements.Affinity
+		spec.Tolerations = requirements.Tolerations
+		spec.TopologySpreadConstraints = requirements.TopologySpreadConstraints
+		spec.NodeSelector = requirements.NodeSelector
+		spec.PriorityClassName = requirements.PriorityClassName
+		spec.RuntimeClassName = requirements.RuntimeClassName
+		return nil
+	}
+}
+
+// ZoneSpread returns a best-effort constraint spreading the selected pods across zones.
+// Pods are still scheduled on clusters without zone labels.
+func ZoneSpread(selector *metav1.LabelSelector) []core.TopologySpreadConstraint {
+	return []core.TopologySpreadConstraint{
+		{
+			MaxSkew:           1,
+			TopologyKey:       zoneTopologyKey,
+			WhenUnsatisfiable: core.ScheduleAnyway,
+			LabelSelector:     selector.DeepCopy(),
+		},
+	}
+}
+
 // ReconcileUserPodResources applies user-defined init containers, volumes, and
 // volume mounts to a PodSpec and main container. This is the shared entry point
 // called by both Fulcio and CTLog deployment actions to avoid duplication.
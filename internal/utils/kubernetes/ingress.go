@@ -73,3 +73,19 @@ func EnsureIngressTermination(termination string) func(ingress *networkingv1.Ing
 		return nil
 	}
 }
+
+// EnsureGRPCIngress marks the Ingress backend as a gRPC (HTTP/2) service for the
+// NGINX ingress controller. On OpenShift gRPC cannot work with "edge" termination,
+// so the route is switched to "reencrypt" and the backend must serve TLS itself.
+func EnsureGRPCIngress() func(ingress *networkingv1.Ingress) error {
+	return func(ingress *networkingv1.Ingress) error {
+		if ingress.Annotations == nil {
+			ingress.Annotations = map[string]string{}
+		}
+		ingress.Annotations["nginx.ingress.kubernetes.io/backend-protocol"] = "GRPC"
+		if IsOpenShift() {
+			return EnsureIngressTermination("reencrypt")(ingress)
+		}
+		return nil
+	}
+}
@@ -138,3 +138,12 @@ func TestEnsureIngressTermination(t *testing.T) {
 	g.Expect(ingress.Annotations["route.openshift.io/termination"]).To(gomega.Equal("reencrypt"))
 	g.Expect(ingress.Spec.TLS).To(gomega.HaveLen(1))
 }
+
+func TestEnsureGRPCIngress(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ingress := &networkingv1.Ingress{}
+
+	g.Expect(EnsureGRPCIngress()(ingress)).To(gomega.Succeed())
+	g.Expect(ingress.Annotations).To(gomega.HaveKeyWithValue("nginx.ingress.kubernetes.io/backend-protocol", "GRPC"))
+	g.Expect(ingress.Annotations).ToNot(gomega.HaveKey("route.openshift.io/termination"))
+}
@@ -0,0 +1,27 @@
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NamespaceTerminating reports whether the namespace is marked for deletion or already gone.
+// Namespaces are cluster-scoped, a namespace-scoped operator is not allowed to read them and never reports
+// a terminating namespace.
+func NamespaceTerminating(ctx context.Context, cli client.Client, name string) (bool, error) {
+	if IsNamespaceScoped() {
+		return false, nil
+	}
+	namespace := &corev1.Namespace{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+		if apiErrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return !namespace.DeletionTimestamp.IsZero(), nil
+}
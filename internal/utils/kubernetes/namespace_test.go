@@ -0,0 +1,79 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/securesign/operator/internal/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNamespaceTerminating(t *testing.T) {
+	origNamespaces := config.WatchNamespaces
+	t.Cleanup(func() { config.WatchNamespaces = origNamespaces })
+
+	terminating := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:              "terminating",
+		DeletionTimestamp: &metav1.Time{Time: time.Now()},
+		Finalizers:        []string{"kubernetes"},
+	}}
+	active := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "active"}}
+
+	tests := []struct {
+		name      string
+		namespace string
+		scoped    bool
+		intercept interceptor.Funcs
+		want      bool
+		wantErr   bool
+	}{
+		{name: "active namespace", namespace: "active"},
+		{name: "terminating namespace", namespace: "terminating", want: true},
+		{name: "missing namespace", namespace: "missing", want: true},
+		{
+			name:      "read error",
+			namespace: "active",
+			intercept: interceptor.Funcs{
+				Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+					return fmt.Errorf("boom")
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:      "namespace-scoped operator does not read namespaces",
+			namespace: "terminating",
+			scoped:    true,
+			intercept: interceptor.Funcs{
+				Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+					return fmt.Errorf("namespaces should not be read")
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			config.WatchNamespaces = nil
+			if tt.scoped {
+				config.WatchNamespaces = []string{tt.namespace}
+			}
+			cli := fake.NewClientBuilder().WithObjects(terminating, active).WithInterceptorFuncs(tt.intercept).Build()
+
+			got, err := NamespaceTerminating(context.Background(), cli, tt.namespace)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(got).To(gomega.Equal(tt.want))
+		})
+	}
+}
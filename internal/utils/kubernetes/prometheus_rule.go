@@ -0,0 +1,61 @@
+package kubernetes
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func CreatePrometheusRule(namespace, name string) *unstructured.Unstructured {
+	rule := &unstructured.Unstructured{}
+	rule.SetKind("PrometheusRule")
+	rule.SetAPIVersion("monitoring.coreos.com/v1")
+	rule.SetName(name)
+	rule.SetNamespace(namespace)
+	return rule
+}
+
+// PrometheusAlertingRule is a single alerting rule of a PrometheusRule group.
+type PrometheusAlertingRule struct {
+	Alert       string
+	Expr        string
+	For         string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+func EnsurePrometheusRuleSpec(group string, rules ...PrometheusAlertingRule) func(*unstructured.Unstructured) error {
+	return func(obj *unstructured.Unstructured) error {
+		ruleInterfaces := make([]interface{}, 0, len(rules))
+		for _, r := range rules {
+			rule := map[string]interface{}{
+				"alert": r.Alert,
+				"expr":  r.Expr,
+			}
+			if r.For != "" {
+				rule["for"] = r.For
+			}
+			if len(r.Labels) > 0 {
+				rule["labels"] = toInterfaceMap(r.Labels)
+			}
+			if len(r.Annotations) > 0 {
+				rule["annotations"] = toInterfaceMap(r.Annotations)
+			}
+			ruleInterfaces = append(ruleInterfaces, rule)
+		}
+
+		groups := []interface{}{
+			map[string]interface{}{
+				"name":  group,
+				"rules": ruleInterfaces,
+			},
+		}
+		return unstructured.SetNestedSlice(obj.Object, groups, "spec", "groups")
+	}
+}
+
+func toInterfaceMap(m map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
@@ -0,0 +1,175 @@
+// Package trillian provides the client side of the Trillian admin and log gRPC API used by the operator.
+package trillian
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/trillian"
+	"github.com/google/trillian/types"
+	rhtasv1 "github.com/securesign/operator/api/v1"
+	"github.com/securesign/operator/internal/apis"
+	"github.com/securesign/operator/internal/constants"
+	"github.com/securesign/operator/internal/serviceresolver"
+	httputils "github.com/securesign/operator/internal/utils/http"
+	tlsutils "github.com/securesign/operator/internal/utils/tls"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Timeout bounds a single exchange with the Trillian log server.
+const Timeout = 10 * time.Second
+
+var (
+	// ErrNotReady is returned by [Resolve] when the referenced Trillian is not ready yet.
+	ErrNotReady = errors.New("trillian is not ready")
+	// ErrNotConnected is returned by [Connect] when the Trillian log server can not be reached.
+	ErrNotConnected = errors.New("could not connect to trillian")
+)
+
+var (
+	builderMu sync.RWMutex
+	builder   = DefaultConnBuilder
+)
+
+// DefaultConnBuilder opens a gRPC connection to the Trillian log server.
+func DefaultConnBuilder(target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return grpc.NewClient(target, opts...)
+}
+
+// SetConnBuilder replaces the gRPC connection builder (used by tests).
+func SetConnBuilder(fn func(string, ...grpc.DialOption) (*grpc.ClientConn, error)) {
+	builderMu.Lock()
+	defer builderMu.Unlock()
+	builder = fn
+}
+
+// ResetConnBuilder restores the default gRPC connection builder.
+func ResetConnBuilder() {
+	builderMu.Lock()
+	defer builderMu.Unlock()
+	builder = DefaultConnBuilder
+}
+
+// Resolve returns the address of the Trillian log server referenced by ref.
+func Resolve(ctx context.Context, cli client.Client, ref rhtasv1.ServiceReference, namespace string) (string, error) {
+	instance := &rhtasv1.Trillian{}
+	host, port, err := serviceresolver.ResolveInternalGrpcService(ctx, cli, ref, namespace, instance)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve trillian service: %w", err)
+	}
+	// a direct URL does not load the Trillian resource
+	if instance.GetName() != "" && !meta.IsStatusConditionTrue(instance.GetConditions(), constants.ReadyCondition) {
+		return "", fmt.Errorf("%w: %s/%s", ErrNotReady, instance.GetNamespace(), instance.GetName())
+	}
+	return fmt.Sprintf("%s:%s", host, port), nil
+}
+
+// Dial opens a connection to the Trillian log server at address. TLS is used on OpenShift or when the instance
+// has a TrustedCA, trusting the service CA and the CA bundle of the TrustedCA ConfigMap.
+func Dial(ctx context.Context, cli client.Client, instance interface {
+	client.Object
+	apis.TlsClient
+}, address string) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if tlsutils.UseTlsClient(instance) {
+		cas, err := httputils.LoadTrustedCAs(ctx, cli, instance)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(&tls.Config{
+			RootCAs:    httputils.CertPool(cas...),
+			MinVersion: tls.VersionTLS12,
+		})
+	}
+
+	builderMu.RLock()
+	defer builderMu.RUnlock()
+	conn, err := builder(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to trillian %s: %w", address, err)
+	}
+	return conn, nil
+}
+
+// Connect waits until the connection to the Trillian log server is established. It returns an error wrapping
+// [ErrNotConnected] when the server can not be reached; no request has been sent over conn in that case.
+func Connect(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		switch s := conn.GetState(); s {
+		case connectivity.Ready:
+			return nil
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return fmt.Errorf("%w: connection is in %s state", ErrNotConnected, s)
+		default:
+			if !conn.WaitForStateChange(ctx, s) {
+				return fmt.Errorf("%w: %w", ErrNotConnected, ctx.Err())
+			}
+		}
+	}
+}
+
+// CreateTree creates a new tree and initialises it, the same way as the createtree command does.
+// The tree is deleted again when it can not be initialised.
+func CreateTree(ctx context.Context, conn grpc.ClientConnInterface, tree *trillian.Tree) (*trillian.Tree, error) {
+	adminClient := trillian.NewTrillianAdminClient(conn)
+	created, err := adminClient.CreateTree(ctx, &trillian.CreateTreeRequest{Tree: tree})
+	if err != nil {
+		return nil, fmt.Errorf("could not create tree: %w", err)
+	}
+	if err = InitLog(ctx, conn, created.TreeId); err != nil {
+		// do not leave an uninitialised tree behind
+		deleteCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), Timeout)
+		defer cancel()
+		_, _ = adminClient.DeleteTree(deleteCtx, &trillian.DeleteTreeRequest{TreeId: created.TreeId})
+		return nil, err
+	}
+	return created, nil
+}
+
+// InitLog initialises the log of the tree. A tree that is already initialised is left as is.
+func InitLog(ctx context.Context, conn grpc.ClientConnInterface, treeID int64) error {
+	_, err := trillian.NewTrillianLogClient(conn).InitLog(ctx, &trillian.InitLogRequest{LogId: treeID})
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		return fmt.Errorf("could not initialise tree %d: %w", treeID, err)
+	}
+	return nil
+}
+
+// FindTree returns the first tree that is not deleted and matches, nil when there is none.
+func FindTree(ctx context.Context, conn grpc.ClientConnInterface, match func(*trillian.Tree) bool) (*trillian.Tree, error) {
+	resp, err := trillian.NewTrillianAdminClient(conn).ListTrees(ctx, &trillian.ListTreesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list trees: %w", err)
+	}
+	for _, tree := range resp.GetTree() {
+		if !tree.GetDeleted() && match(tree) {
+			return tree, nil
+		}
+	}
+	return nil, nil
+}
+
+// LatestRoot returns the latest signed log root of the tree.
+func LatestRoot(ctx context.Context, conn grpc.ClientConnInterface, treeID int64) (*types.LogRootV1, error) {
+	resp, err := trillian.NewTrillianLogClient(conn).GetLatestSignedLogRoot(ctx, &trillian.GetLatestSignedLogRootRequest{LogId: treeID})
+	if err != nil {
+		return nil, fmt.Errorf("could not get latest log root of tree %d: %w", treeID, err)
+	}
+	root := &types.LogRootV1{}
+	if err = root.UnmarshalBinary(resp.GetSignedLogRoot().GetLogRoot()); err != nil {
+		return nil, fmt.Errorf("could not parse log root of tree %d: %w", treeID, err)
+	}
+	return root, nil
+}